@@ -33,7 +33,17 @@ import (
 // @scope.email User email information
 // @scope.api:read Read access to API
 // @scope.api:write Write access to API
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description API ключ серверного клиента, выпускается через /api/admin/api-keys
 func main() {
+	// Управление схемой базы: main migrate up|down|status|to N
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:]))
+	}
+
 	persistence.Connect(persistence.NewDatabaseConfig())
 
 	r := router.SetupRouter()
@@ -24,25 +24,25 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/faqs": {
+        "/api/admin/api-keys": {
             "get": {
                 "security": [
                     {
                         "OAuth2AccessCode": []
                     }
                 ],
-                "description": "Возвращает список FAQ с пагинацией и фильтрацией",
+                "description": "Возвращает ключи без секретов, новые первыми; по умолчанию без отозванных",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FAQ"
+                    "API Keys"
                 ],
-                "summary": "Получить список FAQ",
+                "summary": "Получить список API ключей",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 10,
+                        "default": 50,
                         "description": "Лимит записей",
                         "name": "_limit",
                         "in": "query"
@@ -54,35 +54,11 @@ const docTemplate = `{
                         "name": "_offset",
                         "in": "query"
                     },
-                    {
-                        "type": "string",
-                        "default": "createdAt",
-                        "description": "Поле сортировки",
-                        "name": "_sort",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "default": "desc",
-                        "description": "Порядок сортировки",
-                        "name": "_order",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Фильтр по категории",
-                        "name": "category",
-                        "in": "query"
-                    },
                     {
                         "type": "boolean",
-                        "default": true,
-                        "description": "Фильтр по активности",
-                        "name": "isActive",
+                        "default": false,
+                        "description": "Включить отозванные ключи",
+                        "name": "includeRevoked",
                         "in": "query"
                     }
                 ],
@@ -90,7 +66,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.PaginatedFAQResponse"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.PaginatedAPIKeyResponse"
                         }
                     },
                     "400": {
@@ -99,6 +75,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -108,7 +96,12 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Создает новую запись FAQ",
+                "security": [
+                    {
+                        "OAuth2AccessCode": []
+                    }
+                ],
+                "description": "Выпускает ключ для серверного клиента (импорт из CMS, SSR сайта). Клиент передает ключ в заголовке X-API-Key.\nПолный ключ возвращается только в ответе на этот запрос, в базе хранится его хеш.\nДопустимые scopes: api:read, api:write.",
                 "consumes": [
                     "application/json"
                 ],
@@ -116,17 +109,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "FAQ"
+                    "API Keys"
                 ],
-                "summary": "Создать FAQ",
+                "summary": "Выпустить API ключ",
                 "parameters": [
                     {
-                        "description": "Данные для создания FAQ",
-                        "name": "faq",
+                        "description": "Имя, scopes и срок действия ключа",
+                        "name": "key",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CreateFAQRequest"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CreateAPIKeyRequest"
                         }
                     }
                 ],
@@ -134,7 +127,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CreateAPIKeyResult"
                         }
                     },
                     "400": {
@@ -143,6 +136,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -152,42 +157,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/faqs/batch": {
-            "post": {
-                "description": "Возвращает FAQ по списку ID (batch запрос)",
-                "consumes": [
-                    "application/json"
+        "/api/admin/api-keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": []
+                    }
                 ],
+                "description": "Ключ перестает приниматься сразу; запись остается в списке с includeRevoked=true",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FAQ"
+                    "API Keys"
                 ],
-                "summary": "Получить FAQ по списку ID",
+                "summary": "Отозвать API ключ",
                 "parameters": [
                     {
-                        "description": "Список ID",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.GetFAQsByIDsRequest"
-                        }
+                        "type": "string",
+                        "description": "ID ключа",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQResponse"
-                            }
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
@@ -201,35 +215,107 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/faqs/bulk-delete": {
-            "delete": {
-                "description": "Удаляет несколько FAQ по списку ID",
-                "consumes": [
-                    "application/json"
+        "/api/audit": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": []
+                    }
                 ],
+                "description": "Возвращает изменения FAQ и отзывов, новые первыми: автор, действие, снимки до и после, ID запроса и IP клиента.\nПериод задается в формате RFC3339 и включает from, но не включает to.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "FAQ"
+                    "Audit"
                 ],
-                "summary": "Массовое удаление FAQ",
+                "summary": "Получить журнал аудита",
                 "parameters": [
                     {
-                        "description": "Список ID для удаления",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.BulkDeleteFAQRequest"
-                        }
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Лимит записей",
+                        "name": "_limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Автор изменения",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created",
+                            "updated",
+                            "activated",
+                            "deactivated",
+                            "deleted",
+                            "restored",
+                            "approved",
+                            "review_started",
+                            "rejected",
+                            "archived",
+                            "email_verified",
+                            "file_uploaded",
+                            "file_removed",
+                            "trash_purged"
+                        ],
+                        "type": "string",
+                        "description": "Действие",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "faq",
+                            "testimonial",
+                            "feature"
+                        ],
+                        "type": "string",
+                        "description": "Тип сущности",
+                        "name": "entityType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID сущности",
+                        "name": "entityId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID запроса (заголовок X-Request-ID)",
+                        "name": "requestId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2023-12-01T00:00:00Z",
+                        "description": "Начало периода, RFC3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-01T00:00:00Z",
+                        "description": "Конец периода, RFC3339",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.BatchCommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.PaginatedAuditRecordResponse"
                         }
                     },
                     "400": {
@@ -238,6 +324,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -247,54 +345,75 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/faqs/categories": {
+        "/api/audit/export": {
             "get": {
-                "description": "Возвращает список уникальных категорий FAQ с опциональными счетчиками",
+                "security": [
+                    {
+                        "OAuth2AccessCode": []
+                    }
+                ],
+                "description": "Выгружает все записи по фильтрам в хронологическом порядке. Снимки before и after передаются JSON строками.\nФильтры те же, что у GET /api/audit.",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "FAQ"
+                    "Audit"
                 ],
-                "summary": "Получить список категорий FAQ",
+                "summary": "Выгрузить журнал аудита в CSV",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "Включить количество FAQ в каждой категории",
-                        "name": "withCounts",
+                        "type": "string",
+                        "description": "Автор изменения",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Действие",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "faq",
+                            "testimonial",
+                            "feature"
+                        ],
+                        "type": "string",
+                        "description": "Тип сущности",
+                        "name": "entityType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID сущности",
+                        "name": "entityId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID запроса (заголовок X-Request-ID)",
+                        "name": "requestId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало периода, RFC3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец периода, RFC3339",
+                        "name": "to",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "CSV файл",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "categories": {
-                                    "type": "array",
-                                    "items": {
-                                        "type": "string"
-                                    }
-                                },
-                                "categoryCounts": {
-                                    "type": "object",
-                                    "additionalProperties": {
-                                        "type": "integer",
-                                        "format": "int64"
-                                    }
-                                },
-                                "message": {
-                                    "type": "string"
-                                },
-                                "success": {
-                                    "type": "boolean"
-                                },
-                                "timestamp": {
-                                    "type": "string"
-                                }
-                            }
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -303,8 +422,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
@@ -312,74 +437,121 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/faqs/count": {
+        "/api/faqs": {
             "get": {
-                "description": "Возвращает общее количество FAQ",
+                "security": [
+                    {
+                        "OAuth2AccessCode": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает список FAQ с пагинацией и фильтрацией. При передаче q выполняется полнотекстовый поиск,\nрезультаты упорядочены по релевантности и содержат rank и highlights.\nДополнительные условия задаются как field[op]=value (AND) и _or[n][field][op]=value (OR между подгруппами n),\nоператоры: eq, ne, gt, gte, lt, lte, in, nin, like, ilike, between, null; для in/nin/between значения через запятую.\nПример: priority[gte]=50\u0026createdAt[between]=2024-01-01,2024-12-31\u0026_or[0][category][eq]=налоги\u0026_or[1][question][ilike]=вычет\nБез прав api:read доступны поля question, answer, category, priority, createdAt, updatedAt; прочие поля - ошибка 400.\nПоддерживает условные запросы: при неизменных данных ответ 304 без тела.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "FAQ"
                 ],
-                "summary": "Получить количество FAQ",
+                "summary": "Получить список FAQ",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Фильтр по категории",
-                        "name": "category",
+                        "description": "Поисковый запрос (минимум 3 символа)",
+                        "name": "q",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Фильтр по активности",
+                        "type": "string",
+                        "description": "Курсор keyset-пагинации; пустое значение - первая страница; _sort только createdAt, updatedAt, priority, category или question. Ответ: items, nextCursor, prevCursor, hasNext, hasPrev, limit",
+                        "name": "_cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Лимит записей",
+                        "name": "_limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "createdAt",
+                        "description": "Поле сортировки",
+                        "name": "_sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Порядок сортировки",
+                        "name": "_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Фильтр по категории",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Фильтр по активности; без прав api:read всегда true",
                         "name": "isActive",
                         "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CountResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/faqs/{id}": {
-            "get": {
-                "description": "Возвращает FAQ по указанному ID",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "FAQ"
-                ],
-                "summary": "Получить FAQ по ID",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "ID FAQ",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag из предыдущего ответа",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified из предыдущего ответа",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQResponse"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.PaginatedFAQResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "public, max-age=HTTP_CACHE_FAQ_MAX_AGE для анонимных клиентов"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия списка"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Время последнего изменения FAQ"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "304": {
+                        "description": "Список не изменился"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
@@ -392,8 +564,18 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Обновляет существующую FAQ",
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Создает новую запись FAQ",
                 "consumes": [
                     "application/json"
                 ],
@@ -403,28 +585,21 @@ const docTemplate = `{
                 "tags": [
                     "FAQ"
                 ],
-                "summary": "Обновить FAQ",
+                "summary": "Создать FAQ",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID FAQ",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Данные для обновления",
+                        "description": "Данные для создания FAQ",
                         "name": "faq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.UpdateFAQRequest"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CreateFAQRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
                         }
@@ -435,8 +610,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
@@ -448,34 +629,44 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Удаляет FAQ по ID",
+            }
+        },
+        "/api/faqs/batch": {
+            "post": {
+                "description": "Возвращает FAQ по списку ID (batch запрос); анонимным клиентам только активные",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "FAQ"
                 ],
-                "summary": "Удалить FAQ",
+                "summary": "Получить FAQ по списку ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID FAQ",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Список ID",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.GetFAQsByIDsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQResponse"
+                            }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
@@ -489,30 +680,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/faqs/{id}/activate": {
-            "patch": {
-                "description": "Активирует FAQ по ID",
+        "/api/faqs/bulk-delete": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет несколько FAQ по списку ID. С atomic=true удаляет все или ни одного",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "FAQ"
                 ],
-                "summary": "Активировать FAQ",
+                "summary": "Массовое удаление FAQ",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID FAQ",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Список ID для удаления",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.BulkDeleteFAQRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.BatchCommandResult"
                         }
                     },
                     "400": {
@@ -521,12 +727,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.BatchCommandResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -536,44 +754,91 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/faqs/{id}/deactivate": {
-            "patch": {
-                "description": "Деактивирует FAQ по ID",
+        "/api/faqs/categories": {
+            "get": {
+                "description": "Возвращает список уникальных категорий FAQ с опциональными счетчиками.\nПоддерживает условные запросы: при неизменных данных ответ 304 без тела.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "FAQ"
                 ],
-                "summary": "Деактивировать FAQ",
+                "summary": "Получить список категорий FAQ",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Включить количество FAQ в каждой категории",
+                        "name": "withCounts",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "ID FAQ",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag из предыдущего ответа",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified из предыдущего ответа",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                            "type": "object",
+                            "properties": {
+                                "categories": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "string"
+                                    }
+                                },
+                                "categoryCounts": {
+                                    "type": "object",
+                                    "additionalProperties": {
+                                        "type": "integer",
+                                        "format": "int64"
+                                    }
+                                },
+                                "message": {
+                                    "type": "string"
+                                },
+                                "success": {
+                                    "type": "boolean"
+                                },
+                                "timestamp": {
+                                    "type": "string"
+                                }
+                            }
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "public, max-age=HTTP_CACHE_FAQ_CATEGORIES_MAX_AGE для анонимных клиентов"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия списка категорий"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Время последнего изменения FAQ"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Категории не изменились"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -583,54 +848,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/faqs/{id}/priority": {
-            "patch": {
-                "description": "Обновляет приоритет FAQ по ID",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/faqs/count": {
+            "get": {
+                "description": "Возвращает общее количество FAQ",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "FAQ"
                 ],
-                "summary": "Обновить приоритет FAQ",
+                "summary": "Получить количество FAQ",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID FAQ",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Фильтр по категории",
+                        "name": "category",
+                        "in": "query"
                     },
                     {
-                        "description": "Новый приоритет",
-                        "name": "priority",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.UpdateFAQPriorityRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Фильтр по активности; без прав api:read всегда true",
+                        "name": "isActive",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CountResponse"
                         }
                     },
                     "500": {
@@ -642,39 +888,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/testimonials": {
+        "/api/faqs/trash": {
             "get": {
-                "description": "Получает список отзывов с пагинацией и фильтрацией",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:read"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Возвращает удаленные FAQ с временем удаления deletedAt, по умолчанию недавно удаленные первыми",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "testimonials"
+                    "FAQ"
                 ],
-                "summary": "Получить список отзывов",
+                "summary": "Получить корзину FAQ",
                 "parameters": [
                     {
                         "type": "integer",
                         "default": 10,
                         "description": "Лимит записей",
-                        "name": "limit",
+                        "name": "_limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
                         "default": 0,
                         "description": "Смещение",
-                        "name": "offset",
+                        "name": "_offset",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "\"createdAt\"",
-                        "description": "Поле для сортировки",
-                        "name": "sortBy",
+                        "default": "deletedAt",
+                        "description": "Поле сортировки",
+                        "name": "_sort",
                         "in": "query"
                     },
                     {
@@ -683,21 +936,9 @@ const docTemplate = `{
                             "desc"
                         ],
                         "type": "string",
-                        "default": "\"desc\"",
+                        "default": "desc",
                         "description": "Порядок сортировки",
-                        "name": "sortOrder",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Фильтр по статусу одобрения",
-                        "name": "approved",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Фильтр по рейтингу",
-                        "name": "rating",
+                        "name": "_order",
                         "in": "query"
                     }
                 ],
@@ -705,122 +946,50 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.PaginatedFAQResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "Создает новый отзыв с возможностью загрузки файла",
-                "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "testimonials"
-                ],
-                "summary": "Создать отзыв",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Содержание отзыва",
-                        "name": "content",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Автор отзыва",
-                        "name": "author",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Email автора",
-                        "name": "authorEmail",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Рейтинг (1-5)",
-                        "name": "rating",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Компания",
-                        "name": "company",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Должность",
-                        "name": "position",
-                        "in": "formData"
                     },
-                    {
-                        "type": "file",
-                        "description": "Файл (PDF или изображение)",
-                        "name": "file",
-                        "in": "formData"
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/testimonials/{id}": {
+        "/api/faqs/{id}": {
             "get": {
-                "description": "Получает отзыв по указанному ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Возвращает FAQ по указанному ID; неактивный FAQ доступен только с правами api:read",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "testimonials"
+                    "FAQ"
                 ],
-                "summary": "Получить отзыв по ID",
+                "summary": "Получить FAQ по ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID отзыва",
+                        "description": "ID FAQ",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -830,25 +999,41 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия FAQ для If-Match"
+                            }
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Обновляет существующий отзыв",
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Обновляет существующую FAQ",
                 "consumes": [
                     "application/json"
                 ],
@@ -856,24 +1041,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "testimonials"
+                    "FAQ"
                 ],
-                "summary": "Обновить отзыв",
+                "summary": "Обновить FAQ",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID отзыва",
+                        "description": "ID FAQ",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /api/faqs/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
                     {
                         "description": "Данные для обновления",
-                        "name": "testimonial",
+                        "name": "faq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.UpdateTestimonialCommand"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.UpdateFAQRequest"
                         }
                     }
                 ],
@@ -881,45 +1073,82 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия FAQ"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "FAQ изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Удаляет отзыв по ID",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Перемещает FAQ в корзину. Его можно восстановить через POST /api/faqs/{id}/restore,\nпока он не удален окончательно по истечении срока хранения (TRASH_RETENTION_DAYS).",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "testimonials"
+                    "FAQ"
                 ],
-                "summary": "Удалить отзыв",
+                "summary": "Удалить FAQ",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID отзыва",
+                        "description": "ID FAQ",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -929,104 +1158,237 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/testimonials/{id}/approve": {
+        "/api/faqs/{id}/activate": {
             "patch": {
-                "description": "Одобряет отзыв для публикации",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Активирует FAQ по ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "testimonials"
+                    "FAQ"
                 ],
-                "summary": "Одобрить отзыв",
+                "summary": "Активировать FAQ",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID отзыва",
+                        "description": "ID FAQ",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Данные для одобрения",
-                        "name": "approveData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.ApproveTestimonialCommand"
-                        }
+                        "type": "string",
+                        "description": "ETag из GET /api/faqs/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия FAQ"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "FAQ изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/ws": {
-            "get": {
-                "description": "Устанавливает WebSocket соединение для получения уведомлений в реальном времени",
+        "/api/faqs/{id}/deactivate": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Деактивирует FAQ по ID",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "WebSocket"
+                    "FAQ"
                 ],
-                "summary": "WebSocket подключение",
+                "summary": "Деактивировать FAQ",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID клиента (если не указан, будет сгенерирован)",
-                        "name": "clientId",
-                        "in": "query"
+                        "description": "ID FAQ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /api/faqs/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
                     }
                 ],
-                "responses": {}
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия FAQ"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "FAQ изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
             }
         },
-        "/ws/broadcast": {
-            "post": {
-                "description": "Отправляет сообщение всем подключенным WebSocket клиентам",
+        "/api/faqs/{id}/priority": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Обновляет приоритет FAQ по ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1034,17 +1396,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WebSocket"
+                    "FAQ"
                 ],
-                "summary": "Широковещательное сообщение",
+                "summary": "Обновить приоритет FAQ",
                 "parameters": [
                     {
-                        "description": "Сообщение для отправки",
-                        "name": "message",
+                        "type": "string",
+                        "description": "ID FAQ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /api/faqs/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый приоритет",
+                        "name": "priority",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/src_presentation_handlers.BroadcastMessageRequest"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.UpdateFAQPriorityRequest"
                         }
                     }
                 ],
@@ -1052,487 +1428,4097 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/gin.H"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия FAQ"
+                            }
                         }
-                    }
-                }
-            }
-        },
-        "/ws/info": {
-            "get": {
-                "description": "Возвращает подробную информацию о WebSocket подключениях",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "WebSocket"
-                ],
-                "summary": "Информация о подключениях",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/src_presentation_handlers.ConnectionInfoResponse"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "FAQ изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/ws/stats": {
-            "get": {
-                "description": "Возвращает статистику WebSocket подключений и подписок",
+        "/api/faqs/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает удаленный FAQ в общий список",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WebSocket"
+                    "FAQ"
+                ],
+                "summary": "Восстановить FAQ из корзины",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID FAQ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Статистика WebSocket",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/ws/test": {
-            "post": {
-                "description": "Отправляет тестовое уведомление всем подключенным клиентам",
+        "/api/faqs/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:read"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает ревизии FAQ от новых к старым",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WebSocket"
+                    "FAQ"
+                ],
+                "summary": "Получить историю изменений FAQ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID FAQ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит записей",
+                        "name": "_limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "_offset",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Тестовое уведомление",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/gin.H"
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.PaginatedFAQRevisionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/faqs/{id}/revisions/diff": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:read"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает поля, которые отличаются в состоянии FAQ после ревизий from и to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQ"
+                ],
+                "summary": "Сравнить ревизии FAQ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID FAQ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер исходной ревизии",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер целевой ревизии",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQRevisionDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/faqs/{id}/revisions/{revision}": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:read"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает состояние FAQ до и после указанной ревизии",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQ"
+                ],
+                "summary": "Получить ревизию FAQ",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID FAQ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер ревизии",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQRevisionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/faqs/{id}/revisions/{revision}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает вопрос, ответ, категорию и приоритет FAQ к состоянию после указанной ревизии.\nВосстановление создает новую ревизию с action=restored.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "FAQ"
+                ],
+                "summary": "Восстановить FAQ из ревизии",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID FAQ",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер ревизии",
+                        "name": "revision",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "gin.H": {
-            "type": "object",
-            "additionalProperties": {}
         },
-        "src_presentation_handlers.BroadcastMessageRequest": {
+        "/api/features": {
+            "get": {
+                "description": "Возвращает преимущества для блока «Почему выбирают нас», по умолчанию в порядке отображения (sortOrder).\nДополнительные условия задаются как field[op]=value, см. GET /api/faqs.\nБез прав api:read доступны поля name, description, icon, sortOrder, createdAt, updatedAt; прочие поля - ошибка 400.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Получить список Feature",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Лимит записей",
+                        "name": "_limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "_offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "sortOrder",
+                        "description": "Поле сортировки",
+                        "name": "_sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Порядок сортировки",
+                        "name": "_order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Фильтр по активности; без прав api:read всегда true",
+                        "name": "isActive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.PaginatedFeatureResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Создает преимущество; новая Feature сразу активна",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Создать Feature",
+                "parameters": [
+                    {
+                        "description": "Данные для создания Feature",
+                        "name": "feature",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CreateFeatureRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/features/order": {
+            "put": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Перечисленные Feature получают позиции 0..N-1 в указанном порядке, остальные сохраняют взаимный порядок и идут следом",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Изменить порядок Feature",
+                "parameters": [
+                    {
+                        "description": "ID Feature в желаемом порядке",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ReorderFeaturesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/features/{id}": {
+            "get": {
+                "description": "Возвращает преимущество по указанному ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Получить Feature по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Feature",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.FeatureResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Обновляет название, описание, иконку и позицию Feature",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Обновить Feature",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Feature",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные для обновления",
+                        "name": "feature",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.UpdateFeatureRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Перемещает Feature в корзину. Ее можно восстановить через POST /api/features/{id}/restore,\nпока она не удалена окончательно по истечении срока хранения (TRASH_RETENTION_DAYS).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Удалить Feature",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Feature",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/features/{id}/activate": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Активирует Feature по ID, она начинает отображаться на сайте",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Активировать Feature",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Feature",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/features/{id}/deactivate": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Деактивирует Feature по ID, она скрывается с сайта",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Деактивировать Feature",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Feature",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/features/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает удаленную Feature в общий список",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Features"
+                ],
+                "summary": "Восстановить Feature из корзины",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID Feature",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/files/{key}": {
+            "get": {
+                "description": "Отдает файл локального хранилища по ссылке, выданной API. Ссылка действует ограниченное время,\nпараметры ссылки защищены подписью. Поддерживает Range запросы.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "files"
+                ],
+                "summary": "Скачать файл по подписанной ссылке",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ключ файла",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Срок действия ссылки (unix time)",
+                        "name": "X-Expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Подпись ссылки",
+                        "name": "X-Signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials": {
+            "get": {
+                "description": "Получает список отзывов с пагинацией и фильтрацией.\nДополнительные условия задаются как field[op]=value (AND) и _or[n][field][op]=value (OR между подгруппами n),\nоператоры: eq, ne, gt, gte, lt, lte, in, nin, like, ilike, between, null; для in/nin/between значения через запятую.\nПример: rating[gte]=4\u0026createdAt[between]=2024-01-01,2024-12-31\u0026_or[0][company][ilike]=банк\u0026_or[1][isApproved][eq]=true\nБез прав api:read доступны поля author, company, position, rating, approvedAt, createdAt; прочие поля - ошибка 400.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Получить список отзывов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Лимит записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "\"createdAt\"",
+                        "description": "Поле для сортировки",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "\"desc\"",
+                        "description": "Порядок сортировки",
+                        "name": "sortOrder",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Фильтр по статусу одобрения; без прав api:read возвращаются только одобренные",
+                        "name": "approved",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "submitted",
+                            "in_review",
+                            "approved",
+                            "rejected",
+                            "archived",
+                            "suspected_spam"
+                        ],
+                        "type": "string",
+                        "description": "Фильтр по статусу модерации; без прав api:read игнорируется",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Фильтр по рейтингу",
+                        "name": "rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Поиск по автору (подстрока, без учета регистра)",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Курсор keyset-пагинации; пустое значение - первая страница, результат в поле cursorPaginated; sortBy только createdAt, updatedAt, rating или author",
+                        "name": "_cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создает новый отзыв с возможностью загрузки файла. Тип файла определяется по содержимому,\nдопустимы PDF, JPEG, PNG и GIF размером до UPLOAD_MAX_FILE_SIZE_MB.\nАвтору отзыва с сайта после сохранения отправляется письмо со ссылкой подтверждения email (GET /testimonials/verify);\nподозрительным на спам отзывам письмо отправляет модератор (POST /testimonials/{id}/verification).\nБез проверки на спам и подтверждения email отзывы добавляют только модераторы и редакторы контента\n(роли moderator, content-editor или scope api:write).",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Создать отзыв",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Содержание отзыва",
+                        "name": "content",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Автор отзыва",
+                        "name": "author",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Email автора",
+                        "name": "authorEmail",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Рейтинг (1-5)",
+                        "name": "rating",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Компания",
+                        "name": "company",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Должность",
+                        "name": "position",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл (PDF или изображение)",
+                        "name": "file",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Скрытое поле-ловушка для ботов; форма на сайте оставляет его пустым",
+                        "name": "website",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/bulk/activate": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Активирует отзывы: одобренные снова показываются на сайте.\nНе больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Массовая активация отзывов",
+                "parameters": [
+                    {
+                        "description": "Список ID",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BulkActivateTestimonialsCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой пакет или больше 100 ID",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/bulk/approve": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Одобряет отзывы в статусе in_review с подтвержденным email автора; остальные отзывы получают ошибку в results.\nНе больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Массовое одобрение отзывов",
+                "parameters": [
+                    {
+                        "description": "Список ID",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BulkApproveTestimonialsCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой пакет или больше 100 ID",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/bulk/deactivate": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Скрывает отзывы с сайта без смены статуса модерации.\nНе больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Массовая деактивация отзывов",
+                "parameters": [
+                    {
+                        "description": "Список ID",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BulkDeactivateTestimonialsCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой пакет или больше 100 ID",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/bulk/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Переносит отзывы в корзину; файлы удаляются при очистке корзины.\nНе больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Массовое удаление отзывов",
+                "parameters": [
+                    {
+                        "description": "Список ID",
+                        "name": "ids",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BulkDeleteTestimonialsCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Пустой пакет или больше 100 ID",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchCommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/moderation-queue": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает отзывы в статусах submitted и in_review: дольше всех ожидающие решения идут первыми",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Очередь модерации отзывов",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Лимит записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "submitted",
+                            "in_review",
+                            "suspected_spam"
+                        ],
+                        "type": "string",
+                        "description": "Только отзывы в этом статусе; suspected_spam - очередь подозрительных на спам",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/verify": {
+            "get": {
+                "description": "Подтверждает email автора по ссылке из письма, отправленного при создании отзыва.\nТолько отзыв с подтвержденным email можно одобрить. Повторный переход по ссылке не ошибка.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Подтвердить email автора",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Токен из письма",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Токен поврежден или выдан для другого email",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "410": {
+                        "description": "Срок действия ссылки истек",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}": {
+            "get": {
+                "description": "Получает отзыв по указанному ID; отзыв на модерации доступен только с правами api:read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Получить отзыв по ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия отзыва для If-Match"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Обновляет существующий отзыв",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Обновить отзыв",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные для обновления",
+                        "name": "testimonial",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.UpdateTestimonialCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Удаляет отзыв по ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Удалить отзыв",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/activate": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Активирует отзыв: одобренный отзыв снова показывается на сайте",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Активировать отзыв",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/approve": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Одобряет отзыв в статусе in_review с подтвержденным email автора. Одобривший модератор (approvedBy)\nберется из токена, тело запроса не нужно.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Одобрить отзыв",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Отзыв не на проверке или email автора не подтвержден",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/archive": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Переносит одобренный или отклоненный отзыв в архив; архивный отзыв не публикуется и не меняет статус",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Перенести отзыв в архив",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Переход из текущего статуса не разрешен",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/deactivate": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Скрывает отзыв с сайта без смены статуса модерации",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Деактивировать отзыв",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/file": {
+            "get": {
+                "description": "Отдает прикрепленный к отзыву файл с исходным именем; поддерживает Range запросы.\nОригиналы JPEG и PNG хранятся без EXIF, XMP и IPTC; у JPEG сохраняется только тег ориентации.\nДля изображений параметр variant возвращает уменьшенную копию: thumb (128px) или preview (512px).",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Скачать файл отзыва",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "thumb",
+                            "preview"
+                        ],
+                        "type": "string",
+                        "description": "Уменьшенная копия изображения",
+                        "name": "variant",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Прикрепляет файл к отзыву, ранее прикрепленный файл удаляется. Тип файла определяется по содержимому,\nдопустимы PDF, JPEG, PNG и GIF размером до UPLOAD_MAX_FILE_SIZE_MB.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Загрузить файл отзыва",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл (PDF или изображение)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Открепляет файл от отзыва и удаляет его из хранилища",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Удалить файл отзыва",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/file/url": {
+            "get": {
+                "description": "Возвращает подписанную ссылку, по которой файл можно скачать без авторизации в течение 15 минут.\nДля S3 хранилища ссылка ведет напрямую в бакет.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Получить ссылку на файл отзыва",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "thumb",
+                            "preview"
+                        ],
+                        "type": "string",
+                        "description": "Уменьшенная копия изображения",
+                        "name": "variant",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.QueryResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/reject": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отклоняет отзыв в статусе in_review или suspected_spam с указанием причины. Для причины other нужны заметки модератора (notes).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Отклонить отзыв",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Причина отклонения и заметки модератора",
+                        "name": "rejection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.RejectTestimonialCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неизвестная причина отклонения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Отзыв не на проверке или email автора не подтвержден",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/review": {
+            "patch": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Переводит отзыв в статус in_review из submitted или suspected_spam, а также возвращает на повторную проверку\nодобренный или отклоненный отзыв (одобрение и причина отклонения снимаются). Тело запроса необязательно.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Взять отзыв на проверку",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag из GET /testimonials/{id}; * - без проверки версии",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Заметки модератора",
+                        "name": "review",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.StartTestimonialReviewCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Новая версия отзыва"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Переход из текущего статуса не разрешен",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "412": {
+                        "description": "Отзыв изменен после чтения",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "428": {
+                        "description": "Нет If-Match",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/testimonials/{id}/verification": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Ставит в очередь письмо со ссылкой подтверждения на текущий email автора: если ссылка истекла,\nписьмо не дошло или отзыв был подозрительным на спам и письмо при отправке не отправлялось.\nПисьмо отправляется после ответа, ошибки SMTP повторяются.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "testimonials"
+                ],
+                "summary": "Повторить письмо подтверждения email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID отзыва",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_presentation_models.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Email автора уже подтвержден",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.CommandResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "Устанавливает WebSocket соединение для получения уведомлений в реальном времени",
+                "tags": [
+                    "WebSocket"
+                ],
+                "summary": "WebSocket подключение",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID клиента (если не указан, будет сгенерирован)",
+                        "name": "clientId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/ws/broadcast": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отправляет сообщение всем подключенным WebSocket клиентам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebSocket"
+                ],
+                "summary": "Широковещательное сообщение",
+                "parameters": [
+                    {
+                        "description": "Сообщение для отправки",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/src_presentation_handlers.BroadcastMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws/info": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:read"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает подробную информацию о WebSocket подключениях",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebSocket"
+                ],
+                "summary": "Информация о подключениях",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/src_presentation_handlers.ConnectionInfoResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws/stats": {
+            "get": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:read"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает статистику WebSocket подключений и подписок",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebSocket"
+                ],
+                "summary": "Статистика WebSocket",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/ws/test": {
+            "post": {
+                "security": [
+                    {
+                        "OAuth2AccessCode": [
+                            "api:write"
+                        ]
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отправляет тестовое уведомление всем подключенным клиентам",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WebSocket"
+                ],
+                "summary": "Тестовое уведомление",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "gin.H": {
+            "type": "object",
+            "additionalProperties": {}
+        },
+        "src_presentation_handlers.BroadcastMessageRequest": {
+            "type": "object",
+            "required": [
+                "event"
+            ],
+            "properties": {
+                "data": {},
+                "event": {
+                    "type": "string"
+                }
+            }
+        },
+        "src_presentation_handlers.ConnectionInfoResponse": {
+            "type": "object",
+            "properties": {
+                "availableEvents": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "faqSubscribers": {
+                    "type": "integer"
+                },
+                "featureSubscribers": {
+                    "type": "integer"
+                },
+                "serverTime": {
+                    "type": "string"
+                },
+                "subscriptionTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "testimonialSubscribers": {
+                    "type": "integer"
+                },
+                "totalClients": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tax-priority-api_src_application_models.CursorPaginatedResult-tax-priority-api_src_domain_entities_Testimonial": {
+            "type": "object",
+            "properties": {
+                "hasNext": {
+                    "type": "boolean"
+                },
+                "hasPrev": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_domain_entities.Testimonial"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "type": "string"
+                },
+                "prevCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "tax-priority-api_src_application_models.PaginatedResult-tax-priority-api_src_domain_entities_Testimonial": {
+            "type": "object",
+            "properties": {
+                "hasNext": {
+                    "type": "boolean"
+                },
+                "hasPrev": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_domain_entities.Testimonial"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalPages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.BatchCommandResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "failureCount": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.BatchItemResult"
+                    }
+                },
+                "successCount": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.BatchItemResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "version": {
+                    "description": "Version - новая версия отзыва для If-Match после успешного изменения",
+                    "type": "integer"
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.BulkActivateTestimonialsCommand": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "atomic": {
+                    "description": "Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет активирован",
+                    "type": "boolean"
+                },
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.BulkApproveTestimonialsCommand": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "atomic": {
+                    "description": "Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет одобрен",
+                    "type": "boolean"
+                },
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.BulkDeactivateTestimonialsCommand": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "atomic": {
+                    "description": "Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет деактивирован",
+                    "type": "boolean"
+                },
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.BulkDeleteTestimonialsCommand": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "atomic": {
+                    "description": "Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет удален",
+                    "type": "boolean"
+                },
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.CommandResult": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.QueryResult": {
+            "type": "object",
+            "properties": {
+                "cursorPaginated": {
+                    "$ref": "#/definitions/tax-priority-api_src_application_models.CursorPaginatedResult-tax-priority-api_src_domain_entities_Testimonial"
+                },
+                "data": {
+                    "$ref": "#/definitions/tax-priority-api_src_domain_entities.Testimonial"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "fileUrl": {
+                    "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.TestimonialFileURL"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "paginated": {
+                    "$ref": "#/definitions/tax-priority-api_src_application_models.PaginatedResult-tax-priority-api_src_domain_entities_Testimonial"
+                },
+                "stats": {
+                    "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.TestimonialStats"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.RejectTestimonialCommand": {
+            "type": "object",
+            "required": [
+                "id",
+                "reason"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes - заметки модератора; для причины other обязательны, если у отзыва еще нет заметок",
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "reason": {
+                    "description": "Reason - причина отклонения: spam, offensive, off_topic, duplicate, personal_data, low_quality, other",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/tax-priority-api_src_domain_entities.RejectionReason"
+                        }
+                    ]
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.StartTestimonialReviewCommand": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "description": "Notes - заметки модератора; пустая строка оставляет прежние",
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.TestimonialFileURL": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.TestimonialStats": {
+            "type": "object",
+            "properties": {
+                "approvedCount": {
+                    "type": "integer"
+                },
+                "averageRating": {
+                    "type": "number"
+                },
+                "pendingCount": {
+                    "type": "integer"
+                },
+                "ratingDistribution": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "recentCount": {
+                    "description": "За последние 30 дней",
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "withFilesCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "tax-priority-api_src_application_testimonial_dtos.UpdateTestimonialCommand": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "author": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "authorEmail": {
+                    "type": "string"
+                },
+                "company": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 10
+                },
+                "id": {
+                    "type": "string"
+                },
+                "moderatorNotes": {
+                    "description": "ModeratorNotes - внутренние заметки модератора; nil - без изменений, пустая строка удаляет заметки",
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "position": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                }
+            }
+        },
+        "tax-priority-api_src_domain_entities.RejectionReason": {
+            "type": "string",
+            "enum": [
+                "spam",
+                "offensive",
+                "off_topic",
+                "duplicate",
+                "personal_data",
+                "low_quality",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "RejectionReasonSpam",
+                "RejectionReasonOffensive",
+                "RejectionReasonOffTopic",
+                "RejectionReasonDuplicate",
+                "RejectionReasonPersonalData",
+                "RejectionReasonLowQuality",
+                "RejectionReasonOther"
+            ]
+        },
+        "tax-priority-api_src_domain_entities.Testimonial": {
             "type": "object",
             "required": [
-                "event"
+                "author",
+                "authorEmail",
+                "content",
+                "rating"
             ],
             "properties": {
-                "data": {},
-                "event": {
+                "approvedAt": {
+                    "type": "string"
+                },
+                "approvedBy": {
+                    "type": "string"
+                },
+                "author": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "authorEmail": {
+                    "type": "string"
+                },
+                "company": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 10
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "emailVerifiedAt": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "filePath": {
+                    "type": "string"
+                },
+                "fileSize": {
+                    "type": "integer"
+                },
+                "fileType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "default": true
+                },
+                "isApproved": {
+                    "type": "boolean",
+                    "default": false
+                },
+                "moderatorNotes": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                },
+                "rejectionReason": {
+                    "$ref": "#/definitions/tax-priority-api_src_domain_entities.RejectionReason"
+                },
+                "reviewedBy": {
+                    "type": "string"
+                },
+                "spamScore": {
+                    "type": "integer"
+                },
+                "spamSignals": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/tax-priority-api_src_domain_entities.TestimonialStatus"
+                },
+                "statusChangedAt": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "updatedBy": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "src_presentation_handlers.ConnectionInfoResponse": {
+        "tax-priority-api_src_domain_entities.TestimonialStatus": {
+            "type": "string",
+            "enum": [
+                "submitted",
+                "in_review",
+                "approved",
+                "rejected",
+                "archived",
+                "suspected_spam"
+            ],
+            "x-enum-varnames": [
+                "TestimonialStatusSubmitted",
+                "TestimonialStatusInReview",
+                "TestimonialStatusApproved",
+                "TestimonialStatusRejected",
+                "TestimonialStatusArchived",
+                "TestimonialStatusSuspectedSpam"
+            ]
+        },
+        "tax-priority-api_src_presentation_models.APIKeyResponse": {
             "type": "object",
             "properties": {
-                "availableEvents": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
+                },
+                "createdBy": {
+                    "type": "string",
+                    "example": "admin"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "lastUsedAt": {
+                    "type": "string",
+                    "example": "2023-12-02T08:30:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "cms-import"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "3f9a1c2b7d4e"
+                },
+                "revokedAt": {
+                    "type": "string"
+                },
+                "scopes": {
                     "type": "array",
                     "items": {
                         "type": "string"
-                    }
+                    },
+                    "example": [
+                        "api:read",
+                        "api:write"
+                    ]
                 },
-                "enabled": {
-                    "type": "boolean"
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
+                }
+            }
+        },
+        "tax-priority-api_src_presentation_models.AuditRecordResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "example": "updated"
                 },
-                "faqSubscribers": {
-                    "type": "integer"
+                "actor": {
+                    "type": "string",
+                    "example": "admin"
                 },
-                "serverTime": {
-                    "type": "string"
+                "after": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "subscriptionTypes": {
+                "before": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "clientIp": {
+                    "type": "string",
+                    "example": "203.0.113.10"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
+                },
+                "entityId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440001"
+                },
+                "entityType": {
+                    "type": "string",
+                    "example": "faq"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "5f1c2d3e-4b5a-6978-8a9b-0c1d2e3f4a5b"
+                }
+            }
+        },
+        "tax-priority-api_src_presentation_models.BatchCommandResult": {
+            "type": "object",
+            "properties": {
+                "errors": {
                     "type": "array",
                     "items": {
                         "type": "string"
+                    },
+                    "example": [
+                        "[\"Validation failed for item 1\"]"
+                    ]
+                },
+                "failureCount": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
                     }
                 },
-                "totalClients": {
-                    "type": "integer"
+                "successCount": {
+                    "type": "integer",
+                    "example": 8
                 }
             }
         },
-        "tax-priority-api_src_application_models.PaginatedResult-tax-priority-api_src_domain_entities_Testimonial": {
+        "tax-priority-api_src_presentation_models.BulkDeleteFAQRequest": {
             "type": "object",
+            "required": [
+                "ids"
+            ],
             "properties": {
-                "hasNext": {
-                    "type": "boolean"
-                },
-                "hasPrev": {
-                    "type": "boolean"
+                "atomic": {
+                    "description": "Atomic - все или ничего: если часть FAQ не найдена, ни один не удаляется (409)",
+                    "type": "boolean",
+                    "example": false
                 },
-                "items": {
+                "ids": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/tax-priority-api_src_domain_entities.Testimonial"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"uuid1\"",
+                        " \"uuid2\"]"
+                    ]
+                }
+            }
+        },
+        "tax-priority-api_src_presentation_models.CommandResult": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
                 },
-                "limit": {
-                    "type": "integer"
+                "error": {
+                    "type": "string",
+                    "example": "Validation failed"
                 },
-                "offset": {
-                    "type": "integer"
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "FAQ created successfully"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "total": {
-                    "type": "integer"
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
                 },
-                "totalPages": {
-                    "type": "integer"
+                "version": {
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "tax-priority-api_src_application_testimonial_dtos.ApproveTestimonialCommand": {
+        "tax-priority-api_src_presentation_models.CountResponse": {
             "type": "object",
-            "required": [
-                "approvedBy",
-                "id"
-            ],
             "properties": {
-                "approvedBy": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "count": {
+                    "type": "integer",
+                    "example": 42
                 }
             }
         },
-        "tax-priority-api_src_application_testimonial_dtos.CommandResult": {
+        "tax-priority-api_src_presentation_models.CreateAPIKeyRequest": {
             "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
             "properties": {
-                "data": {},
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
+                "expiresAt": {
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
                 },
-                "success": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "cms-import"
                 },
-                "timestamp": {
-                    "type": "string"
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "api:read",
+                        "api:write"
+                    ]
                 }
             }
         },
-        "tax-priority-api_src_application_testimonial_dtos.QueryResult": {
+        "tax-priority-api_src_presentation_models.CreateAPIKeyResult": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/tax-priority-api_src_domain_entities.Testimonial"
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
                 },
-                "error": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "message": {
-                    "type": "string"
+                "key": {
+                    "description": "Key полный ключ, показывается только в этом ответе",
+                    "type": "string",
+                    "example": "tp_3f9a1c2b7d4e_J8kq...Xw"
                 },
-                "paginated": {
-                    "$ref": "#/definitions/tax-priority-api_src_application_models.PaginatedResult-tax-priority-api_src_domain_entities_Testimonial"
+                "message": {
+                    "type": "string",
+                    "example": "API key created successfully, store it now: it cannot be shown again"
                 },
-                "stats": {
-                    "$ref": "#/definitions/tax-priority-api_src_application_testimonial_dtos.TestimonialStats"
+                "prefix": {
+                    "type": "string",
+                    "example": "3f9a1c2b7d4e"
                 },
                 "success": {
-                    "type": "boolean"
-                },
-                "timestamp": {
-                    "type": "string"
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "tax-priority-api_src_application_testimonial_dtos.TestimonialStats": {
+        "tax-priority-api_src_presentation_models.CreateFAQRequest": {
             "type": "object",
+            "required": [
+                "answer",
+                "category",
+                "question"
+            ],
             "properties": {
-                "approvedCount": {
-                    "type": "integer"
-                },
-                "averageRating": {
-                    "type": "number"
-                },
-                "pendingCount": {
-                    "type": "integer"
-                },
-                "ratingDistribution": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer",
-                        "format": "int64"
-                    }
+                "answer": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "minLength": 10,
+                    "example": "Для подачи налоговой декларации необходимо..."
                 },
-                "recentCount": {
-                    "description": "За последние 30 дней",
-                    "type": "integer"
+                "category": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "налоги"
                 },
-                "totalCount": {
-                    "type": "integer"
+                "priority": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 50
                 },
-                "withFilesCount": {
-                    "type": "integer"
+                "question": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 10,
+                    "example": "Как подать налоговую декларацию?"
                 }
             }
         },
-        "tax-priority-api_src_application_testimonial_dtos.UpdateTestimonialCommand": {
+        "tax-priority-api_src_presentation_models.CreateFeatureRequest": {
             "type": "object",
             "required": [
-                "id"
+                "name"
             ],
             "properties": {
-                "author": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
-                },
-                "authorEmail": {
-                    "type": "string"
-                },
-                "company": {
-                    "type": "string",
-                    "maxLength": 255
-                },
-                "content": {
+                "description": {
                     "type": "string",
                     "maxLength": 1000,
-                    "minLength": 10
+                    "example": "Сопровождаем бизнес и частных клиентов с 2012 года"
                 },
-                "id": {
-                    "type": "string"
+                "icon": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "award"
                 },
-                "position": {
+                "name": {
                     "type": "string",
-                    "maxLength": 255
+                    "maxLength": 200,
+                    "minLength": 3,
+                    "example": "Опыт более 10 лет"
                 },
-                "rating": {
+                "sortOrder": {
                     "type": "integer",
-                    "maximum": 5,
-                    "minimum": 1
+                    "minimum": 0,
+                    "example": 0
                 }
             }
         },
-        "tax-priority-api_src_domain_entities.Testimonial": {
+        "tax-priority-api_src_presentation_models.ErrorResponse": {
             "type": "object",
-            "required": [
-                "author",
-                "authorEmail",
-                "content",
-                "rating"
-            ],
             "properties": {
-                "approvedAt": {
-                    "type": "string"
-                },
-                "approvedBy": {
-                    "type": "string"
-                },
-                "author": {
+                "error": {
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2
-                },
-                "authorEmail": {
-                    "type": "string"
+                    "example": "Validation failed"
+                }
+            }
+        },
+        "tax-priority-api_src_presentation_models.FAQFieldChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "answer"
                 },
-                "company": {
-                    "type": "string"
+                "from": {},
+                "to": {}
+            }
+        },
+        "tax-priority-api_src_presentation_models.FAQResponse": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string",
+                    "example": "Для подачи налоговой декларации необходимо..."
                 },
-                "content": {
+                "category": {
                     "type": "string",
-                    "maxLength": 1000,
-                    "minLength": 10
+                    "example": "налоги"
                 },
                 "createdAt": {
-                    "type": "string"
-                },
-                "fileName": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
                 },
-                "filePath": {
-                    "type": "string"
+                "createdBy": {
+                    "type": "string",
+                    "example": "editor"
                 },
-                "fileSize": {
-                    "type": "integer"
+                "deletedAt": {
+                    "description": "DeletedAt время перемещения в корзину, только для /api/faqs/trash",
+                    "type": "string",
+                    "example": "2023-12-05T10:00:00Z"
                 },
-                "fileType": {
-                    "type": "string"
+                "highlights": {
+                    "description": "Highlights фрагменты с подсветкой совпадений (\u003cmark\u003e), только для поиска по q",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
                 "id": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
                 "isActive": {
                     "type": "boolean",
-                    "default": true
+                    "example": true
                 },
-                "isApproved": {
-                    "type": "boolean",
-                    "default": false
+                "priority": {
+                    "type": "integer",
+                    "example": 50
                 },
-                "position": {
-                    "type": "string"
+                "question": {
+                    "type": "string",
+                    "example": "Как подать налоговую декларацию?"
                 },
-                "rating": {
-                    "type": "integer",
-                    "maximum": 5,
-                    "minimum": 1
+                "rank": {
+                    "description": "Rank релевантность, только для поиска по q",
+                    "type": "number",
+                    "example": 0.42
                 },
                 "updatedAt": {
-                    "type": "string"
-                }
-            }
-        },
-        "tax-priority-api_src_presentation_models.BatchCommandResult": {
-            "type": "object",
-            "properties": {
-                "errors": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"Validation failed for item 1\"]"
-                    ]
-                },
-                "failureCount": {
-                    "type": "integer",
-                    "example": 2
+                    "type": "string",
+                    "example": "2023-12-01T10:00:00Z"
                 },
-                "results": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/tax-priority-api_src_presentation_models.CommandResult"
-                    }
+                "updatedBy": {
+                    "type": "string",
+                    "example": "editor"
                 },
-                "successCount": {
+                "version": {
                     "type": "integer",
-                    "example": 8
+                    "example": 3
                 }
             }
         },
-        "tax-priority-api_src_presentation_models.BulkDeleteFAQRequest": {
+        "tax-priority-api_src_presentation_models.FAQRevisionDiffResponse": {
             "type": "object",
-            "required": [
-                "ids"
-            ],
             "properties": {
-                "ids": {
+                "changes": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "[\"uuid1\"",
-                        " \"uuid2\"]"
-                    ]
+                        "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQFieldChange"
+                    }
+                },
+                "faqId": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                },
+                "from": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "to": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "tax-priority-api_src_presentation_models.CommandResult": {
+        "tax-priority-api_src_presentation_models.FAQRevisionResponse": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "updated",
+                        "activated",
+                        "deactivated",
+                        "deleted",
+                        "restored"
+                    ],
+                    "example": "updated"
+                },
+                "after": {
+                    "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQSnapshot"
+                },
+                "before": {
+                    "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQSnapshot"
+                },
+                "changedAt": {
                     "type": "string",
                     "example": "2023-12-01T10:00:00Z"
                 },
-                "error": {
+                "changedBy": {
                     "type": "string",
-                    "example": "Validation failed"
+                    "example": "editor"
                 },
-                "id": {
+                "faqId": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
-                "message": {
+                "id": {
                     "type": "string",
-                    "example": "FAQ created successfully"
+                    "example": "7c9e6679-7425-40de-944b-e07fc1f90ae7"
                 },
-                "success": {
-                    "type": "boolean",
-                    "example": true
+                "restoredFrom": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "updatedAt": {
-                    "type": "string",
-                    "example": "2023-12-01T10:00:00Z"
-                }
-            }
-        },
-        "tax-priority-api_src_presentation_models.CountResponse": {
-            "type": "object",
-            "properties": {
-                "count": {
+                "revision": {
                     "type": "integer",
-                    "example": 42
+                    "example": 3
                 }
             }
         },
-        "tax-priority-api_src_presentation_models.CreateFAQRequest": {
+        "tax-priority-api_src_presentation_models.FAQSnapshot": {
             "type": "object",
-            "required": [
-                "answer",
-                "category",
-                "question"
-            ],
             "properties": {
                 "answer": {
                     "type": "string",
-                    "maxLength": 2000,
-                    "minLength": 10,
                     "example": "Для подачи налоговой декларации необходимо..."
                 },
                 "category": {
                     "type": "string",
-                    "maxLength": 100,
                     "example": "налоги"
                 },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "priority": {
                     "type": "integer",
-                    "maximum": 100,
-                    "minimum": 0,
                     "example": 50
                 },
                 "question": {
                     "type": "string",
-                    "maxLength": 500,
-                    "minLength": 10,
                     "example": "Как подать налоговую декларацию?"
                 }
             }
         },
-        "tax-priority-api_src_presentation_models.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Validation failed"
-                }
-            }
-        },
-        "tax-priority-api_src_presentation_models.FAQResponse": {
+        "tax-priority-api_src_presentation_models.FeatureResponse": {
             "type": "object",
             "properties": {
-                "answer": {
+                "createdAt": {
                     "type": "string",
-                    "example": "Для подачи налоговой декларации необходимо..."
+                    "example": "2023-12-01T10:00:00Z"
                 },
-                "category": {
+                "description": {
                     "type": "string",
-                    "example": "налоги"
+                    "example": "Сопровождаем бизнес и частных клиентов с 2012 года"
                 },
-                "createdAt": {
+                "icon": {
                     "type": "string",
-                    "example": "2023-12-01T10:00:00Z"
+                    "example": "award"
                 },
                 "id": {
                     "type": "string",
@@ -1542,13 +5528,13 @@ const docTemplate = `{
                     "type": "boolean",
                     "example": true
                 },
-                "priority": {
-                    "type": "integer",
-                    "example": 50
-                },
-                "question": {
+                "name": {
                     "type": "string",
-                    "example": "Как подать налоговую декларацию?"
+                    "example": "Опыт более 10 лет"
+                },
+                "sortOrder": {
+                    "type": "integer",
+                    "example": 0
                 },
                 "updatedAt": {
                     "type": "string",
@@ -1575,6 +5561,76 @@ const docTemplate = `{
                 }
             }
         },
+        "tax-priority-api_src_presentation_models.PaginatedAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "hasNext": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "hasPrev": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_presentation_models.APIKeyResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "totalPages": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "tax-priority-api_src_presentation_models.PaginatedAuditRecordResponse": {
+            "type": "object",
+            "properties": {
+                "hasNext": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "hasPrev": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_presentation_models.AuditRecordResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "totalPages": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
         "tax-priority-api_src_presentation_models.PaginatedFAQResponse": {
             "type": "object",
             "properties": {
@@ -1610,6 +5666,96 @@ const docTemplate = `{
                 }
             }
         },
+        "tax-priority-api_src_presentation_models.PaginatedFAQRevisionResponse": {
+            "type": "object",
+            "properties": {
+                "hasNext": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "hasPrev": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_presentation_models.FAQRevisionResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "totalPages": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "tax-priority-api_src_presentation_models.PaginatedFeatureResponse": {
+            "type": "object",
+            "properties": {
+                "hasNext": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "hasPrev": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tax-priority-api_src_presentation_models.FeatureResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "totalPages": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "tax-priority-api_src_presentation_models.ReorderFeaturesRequest": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "description": "IDs Feature в желаемом порядке; не перечисленные Feature идут следом",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[\"uuid1\"",
+                        " \"uuid2\"]"
+                    ]
+                }
+            }
+        },
         "tax-priority-api_src_presentation_models.UpdateFAQPriorityRequest": {
             "type": "object",
             "properties": {
@@ -1640,6 +5786,10 @@ const docTemplate = `{
                     "maxLength": 100,
                     "example": "налоги"
                 },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "priority": {
                     "type": "integer",
                     "maximum": 100,
@@ -1653,9 +5803,44 @@ const docTemplate = `{
                     "example": "Как подать налоговую декларацию?"
                 }
             }
+        },
+        "tax-priority-api_src_presentation_models.UpdateFeatureRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Сопровождаем бизнес и частных клиентов с 2012 года"
+                },
+                "icon": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "award"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 3,
+                    "example": "Опыт более 10 лет"
+                },
+                "sortOrder": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                }
+            }
         }
     },
     "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API ключ серверного клиента, выпускается через /api/admin/api-keys",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "OAuth2AccessCode": {
             "type": "oauth2",
             "flow": "accessCode",
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/apikeys"
+	"tax-priority-api/src/application/apikeys/dtos"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateAPIKeyCommand struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type CreateAPIKeyCommandHandler struct {
+	repo repositories.APIKeyRepository
+}
+
+func NewCreateAPIKeyCommandHandler(repo repositories.APIKeyRepository) *CreateAPIKeyCommandHandler {
+	return &CreateAPIKeyCommandHandler{repo: repo}
+}
+
+// HandleCreateAPIKey выпускает ключ; в базе сохраняется только его хеш, полный ключ возвращается один раз
+func (h *CreateAPIKeyCommandHandler) HandleCreateAPIKey(ctx context.Context, cmd CreateAPIKeyCommand) (*dtos.CommandResult, error) {
+	plaintext, prefix, err := apikeys.GenerateKey()
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	key, err := entities.NewAPIKey(cmd.Name, prefix, apikeys.HashKey(plaintext), cmd.Scopes, cmd.ExpiresAt, identity.Actor(ctx))
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to create API key: %v", err),
+		}, err
+	}
+
+	key.SetID(uuid.New().String())
+
+	if err = h.repo.Create(ctx, key); err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to create API key: %v", err),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:        key.ID,
+		Success:   true,
+		Message:   "API key created successfully, store it now: it cannot be shown again",
+		Key:       plaintext,
+		Prefix:    key.Prefix,
+		CreatedAt: key.CreatedAt,
+		UpdatedAt: key.UpdatedAt,
+	}, nil
+}
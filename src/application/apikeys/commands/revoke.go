@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/apikeys/dtos"
+	"tax-priority-api/src/application/repositories"
+)
+
+type RevokeAPIKeyCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type RevokeAPIKeyCommandHandler struct {
+	repo repositories.APIKeyRepository
+}
+
+func NewRevokeAPIKeyCommandHandler(repo repositories.APIKeyRepository) *RevokeAPIKeyCommandHandler {
+	return &RevokeAPIKeyCommandHandler{repo: repo}
+}
+
+// HandleRevokeAPIKey отзывает ключ; запись остается для истории, ключ перестает приниматься сразу
+func (h *RevokeAPIKeyCommandHandler) HandleRevokeAPIKey(ctx context.Context, cmd RevokeAPIKeyCommand) (*dtos.CommandResult, error) {
+	key, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("API key not found: %v", err),
+		}, err
+	}
+
+	if key.IsRevoked() {
+		return &dtos.CommandResult{
+			ID:        key.ID,
+			Success:   true,
+			Message:   "API key is already revoked",
+			CreatedAt: key.CreatedAt,
+			UpdatedAt: key.UpdatedAt,
+		}, nil
+	}
+
+	key.Revoke()
+	err = h.repo.UpdateFields(ctx, key.ID, map[string]interface{}{
+		"revoked_at": key.RevokedAt,
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to revoke API key: %v", err),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:        key.ID,
+		Success:   true,
+		Message:   "API key revoked successfully",
+		CreatedAt: key.CreatedAt,
+		UpdatedAt: key.UpdatedAt,
+	}, nil
+}
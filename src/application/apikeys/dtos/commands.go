@@ -0,0 +1,15 @@
+package dtos
+
+import "time"
+
+type CommandResult struct {
+	ID      string `json:"id,omitempty"`
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+	// Key полный ключ; возвращается только при создании, сохранить его повторно нельзя
+	Key       string    `json:"key,omitempty"`
+	Prefix    string    `json:"prefix,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
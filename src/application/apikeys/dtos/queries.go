@@ -0,0 +1,73 @@
+package dtos
+
+import (
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type QueryResult struct {
+	Paginated *models.PaginatedResult[*entities.APIKey] `json:"paginated,omitempty"`
+	Success   bool                                      `json:"success"`
+	Message   string                                    `json:"message,omitempty"`
+	Error     string                                    `json:"error,omitempty"`
+	Timestamp time.Time                                 `json:"timestamp"`
+}
+
+type APIKeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedBy  string     `json:"createdBy,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+type PaginatedAPIKeyResponse struct {
+	Items      []APIKeyResponse `json:"items"`
+	Total      int64            `json:"total"`
+	Offset     int              `json:"offset"`
+	Limit      int              `json:"limit"`
+	HasNext    bool             `json:"hasNext"`
+	HasPrev    bool             `json:"hasPrev"`
+	TotalPages int              `json:"totalPages"`
+}
+
+func ToAPIKeyResponse(key *entities.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  key.CreatedAt,
+		UpdatedAt:  key.UpdatedAt,
+	}
+}
+
+func ToAPIKeyResponses(keys []*entities.APIKey) []APIKeyResponse {
+	responses := make([]APIKeyResponse, len(keys))
+	for i, key := range keys {
+		responses[i] = ToAPIKeyResponse(key)
+	}
+	return responses
+}
+
+func ToPaginatedAPIKeyResponse(paginated *models.PaginatedResult[*entities.APIKey]) PaginatedAPIKeyResponse {
+	return PaginatedAPIKeyResponse{
+		Items:      ToAPIKeyResponses(paginated.Items),
+		Total:      paginated.Total,
+		Offset:     paginated.Offset,
+		Limit:      paginated.Limit,
+		HasNext:    paginated.HasNext,
+		HasPrev:    paginated.HasPrev,
+		TotalPages: paginated.TotalPages,
+	}
+}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"tax-priority-api/src/application/apikeys/commands"
+	"tax-priority-api/src/application/repositories"
+)
+
+type APIKeyCommandHandlers struct {
+	Create *commands.CreateAPIKeyCommandHandler
+	Revoke *commands.RevokeAPIKeyCommandHandler
+}
+
+func NewAPIKeyCommandHandlers(repo repositories.APIKeyRepository) *APIKeyCommandHandlers {
+	return &APIKeyCommandHandlers{
+		Create: commands.NewCreateAPIKeyCommandHandler(repo),
+		Revoke: commands.NewRevokeAPIKeyCommandHandler(repo),
+	}
+}
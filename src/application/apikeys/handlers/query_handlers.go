@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"tax-priority-api/src/application/apikeys/queries"
+	"tax-priority-api/src/application/repositories"
+)
+
+type APIKeyQueryHandlers struct {
+	GetMany      *queries.GetAPIKeysQueryHandler
+	Authenticate *queries.AuthenticateAPIKeyQueryHandler
+}
+
+func NewAPIKeyQueryHandlers(repo repositories.APIKeyRepository) *APIKeyQueryHandlers {
+	return &APIKeyQueryHandlers{
+		GetMany:      queries.NewGetAPIKeysQueryHandler(repo),
+		Authenticate: queries.NewAuthenticateAPIKeyQueryHandler(repo),
+	}
+}
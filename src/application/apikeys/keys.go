@@ -0,0 +1,57 @@
+package apikeys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// keyScheme начало каждого ключа; по нему ключ легко найти в логах и сканерах секретов
+	keyScheme = "tp"
+	// prefixBytes длина открытой части ключа, по которой ключ ищется в базе
+	prefixBytes = 6
+	// secretBytes длина секретной части ключа
+	secretBytes = 32
+)
+
+// ErrInvalidAPIKey ключ не найден, не совпадает, отозван или истек.
+// Причина намеренно не уточняется, чтобы ответ не помогал подбирать ключи.
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// GenerateKey создает ключ вида tp_<prefix>_<secret> и возвращает его открытую часть
+func GenerateKey() (key, prefix string, err error) {
+	prefixRaw := make([]byte, prefixBytes)
+	secretRaw := make([]byte, secretBytes)
+	if _, err := rand.Read(prefixRaw); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	if _, err := rand.Read(secretRaw); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	prefix = hex.EncodeToString(prefixRaw)
+	key = keyScheme + "_" + prefix + "_" + base64.RawURLEncoding.EncodeToString(secretRaw)
+	return key, prefix, nil
+}
+
+// ParsePrefix возвращает открытую часть ключа
+func ParsePrefix(key string) (string, error) {
+	// Секретная часть в base64url может содержать "_"
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != keyScheme || len(parts[1]) != prefixBytes*2 || parts[2] == "" {
+		return "", ErrInvalidAPIKey
+	}
+	return parts[1], nil
+}
+
+// HashKey возвращает SHA-256 ключа в hex. Медленный хеш не нужен:
+// ключ случайный, 256 бит секрета не подбираются перебором.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
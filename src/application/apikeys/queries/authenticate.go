@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+	"tax-priority-api/src/application/apikeys"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// lastUsedPrecision точность времени последнего использования: чаще ключ в базу не пишется
+const lastUsedPrecision = time.Minute
+
+type AuthenticateAPIKeyQueryHandler struct {
+	repo repositories.APIKeyRepository
+}
+
+func NewAuthenticateAPIKeyQueryHandler(repo repositories.APIKeyRepository) *AuthenticateAPIKeyQueryHandler {
+	return &AuthenticateAPIKeyQueryHandler{repo: repo}
+}
+
+// Authenticate проверяет предъявленный ключ и возвращает его запись.
+// Для неизвестного, отозванного и истекшего ключа возвращается apikeys.ErrInvalidAPIKey.
+func (h *AuthenticateAPIKeyQueryHandler) Authenticate(ctx context.Context, plaintext string) (*entities.APIKey, error) {
+	prefix, err := apikeys.ParsePrefix(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := h.repo.FindByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, apikeys.ErrInvalidAPIKey
+	}
+
+	hash := apikeys.HashKey(plaintext)
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(key.KeyHash)) != 1 {
+		return nil, apikeys.ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if !key.IsUsable(now) {
+		return nil, apikeys.ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedPrecision {
+		// Ошибка записи не мешает аутентификации
+		if err := h.repo.TouchLastUsed(ctx, key.ID, now, now.Add(-lastUsedPrecision)); err != nil {
+			log.Printf("Failed to update last use of API key %s: %v", key.Prefix, err)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return key, nil
+}
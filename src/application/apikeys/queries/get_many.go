@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/apikeys/dtos"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"time"
+)
+
+type GetAPIKeysQuery struct {
+	Limit  int `json:"limit" validate:"min=1,max=100"`
+	Offset int `json:"offset" validate:"min=0"`
+	// IncludeRevoked добавляет в список отозванные ключи
+	IncludeRevoked bool `json:"includeRevoked"`
+}
+
+type GetAPIKeysQueryHandler struct {
+	repo repositories.APIKeyRepository
+}
+
+func NewGetAPIKeysQueryHandler(repo repositories.APIKeyRepository) *GetAPIKeysQueryHandler {
+	return &GetAPIKeysQueryHandler{repo: repo}
+}
+
+// HandleGetAPIKeys возвращает ключи, новые первыми; секреты и хеши в ответ не попадают
+func (h *GetAPIKeysQueryHandler) HandleGetAPIKeys(ctx context.Context, query GetAPIKeysQuery) (*dtos.QueryResult, error) {
+	if query.Limit == 0 {
+		query.Limit = 50
+	}
+
+	opts := &models.QueryOptions{
+		Pagination: &models.PaginationParams{
+			Offset: query.Offset,
+			Limit:  query.Limit,
+		},
+		SortBy: []models.SortBy{{Field: "createdAt", Order: models.DESC}},
+	}
+	if !query.IncludeRevoked {
+		opts.Where = models.NewFilterGroup(models.FilterAnd)
+		opts.Where.Add("revokedAt", models.FilterIsNull, true)
+	}
+
+	paginated, err := h.repo.FindWithPagination(ctx, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find API keys: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Paginated: paginated,
+		Success:   true,
+		Message:   "API keys retrieved successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
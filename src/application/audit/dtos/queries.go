@@ -0,0 +1,74 @@
+package dtos
+
+import (
+	"encoding/json"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type QueryResult struct {
+	Paginated *models.PaginatedResult[*entities.AuditRecord] `json:"paginated,omitempty"`
+	Success   bool                                           `json:"success"`
+	Message   string                                         `json:"message,omitempty"`
+	Error     string                                         `json:"error,omitempty"`
+	Timestamp time.Time                                      `json:"timestamp"`
+}
+
+type AuditRecordResponse struct {
+	ID         string          `json:"id"`
+	Actor      string          `json:"actor,omitempty"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entityType"`
+	EntityID   string          `json:"entityId,omitempty"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	RequestID  string          `json:"requestId,omitempty"`
+	ClientIP   string          `json:"clientIp,omitempty"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+type PaginatedAuditRecordResponse struct {
+	Items      []AuditRecordResponse `json:"items"`
+	Total      int64                 `json:"total"`
+	Offset     int                   `json:"offset"`
+	Limit      int                   `json:"limit"`
+	HasNext    bool                  `json:"hasNext"`
+	HasPrev    bool                  `json:"hasPrev"`
+	TotalPages int                   `json:"totalPages"`
+}
+
+func ToAuditRecordResponse(record *entities.AuditRecord) AuditRecordResponse {
+	return AuditRecordResponse{
+		ID:         record.ID,
+		Actor:      record.Actor,
+		Action:     string(record.Action),
+		EntityType: string(record.EntityType),
+		EntityID:   record.EntityID,
+		Before:     record.Before,
+		After:      record.After,
+		RequestID:  record.RequestID,
+		ClientIP:   record.ClientIP,
+		CreatedAt:  record.CreatedAt,
+	}
+}
+
+func ToAuditRecordResponses(records []*entities.AuditRecord) []AuditRecordResponse {
+	responses := make([]AuditRecordResponse, len(records))
+	for i, record := range records {
+		responses[i] = ToAuditRecordResponse(record)
+	}
+	return responses
+}
+
+func ToPaginatedAuditRecordResponse(paginated *models.PaginatedResult[*entities.AuditRecord]) PaginatedAuditRecordResponse {
+	return PaginatedAuditRecordResponse{
+		Items:      ToAuditRecordResponses(paginated.Items),
+		Total:      paginated.Total,
+		Offset:     paginated.Offset,
+		Limit:      paginated.Limit,
+		HasNext:    paginated.HasNext,
+		HasPrev:    paginated.HasPrev,
+		TotalPages: paginated.TotalPages,
+	}
+}
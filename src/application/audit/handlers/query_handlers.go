@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"tax-priority-api/src/application/audit/queries"
+	"tax-priority-api/src/application/repositories"
+)
+
+type AuditQueryHandlers struct {
+	GetMany *queries.GetAuditRecordsQueryHandler
+	Export  *queries.ExportAuditRecordsQueryHandler
+}
+
+func NewAuditQueryHandlers(repo repositories.AuditRepository) *AuditQueryHandlers {
+	return &AuditQueryHandlers{
+		GetMany: queries.NewGetAuditRecordsQueryHandler(repo),
+		Export:  queries.NewExportAuditRecordsQueryHandler(repo),
+	}
+}
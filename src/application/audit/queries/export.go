@@ -0,0 +1,55 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+// exportPageSize количество записей, читаемых из базы за один запрос при выгрузке
+const exportPageSize = 500
+
+type ExportAuditRecordsQuery struct {
+	AuditFilter
+}
+
+// ExportAuditRecordsQueryHandler выгружает журнал постранично, не загружая его в память целиком
+type ExportAuditRecordsQueryHandler struct {
+	repo repositories.AuditRepository
+}
+
+func NewExportAuditRecordsQueryHandler(repo repositories.AuditRepository) *ExportAuditRecordsQueryHandler {
+	return &ExportAuditRecordsQueryHandler{repo: repo}
+}
+
+// HandleExportAuditRecords передает в write записи по фильтрам в хронологическом порядке.
+// Новые записи добавляются в конец выборки, поэтому постраничное чтение не пропускает записи.
+func (h *ExportAuditRecordsQueryHandler) HandleExportAuditRecords(ctx context.Context, query ExportAuditRecordsQuery, write func(*entities.AuditRecord) error) error {
+	where := query.where()
+
+	for offset := 0; ; offset += exportPageSize {
+		records, err := h.repo.FindAll(ctx, &models.QueryOptions{
+			Pagination: &models.PaginationParams{Offset: offset, Limit: exportPageSize},
+			SortBy: []models.SortBy{
+				{Field: "createdAt", Order: models.SortOrder("asc")},
+				{Field: "id", Order: models.SortOrder("asc")},
+			},
+			Where: where,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to find audit records: %w", err)
+		}
+
+		for _, record := range records {
+			if err := write(record); err != nil {
+				return err
+			}
+		}
+
+		if len(records) < exportPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package queries
+
+import (
+	"tax-priority-api/src/application/models"
+	"time"
+)
+
+// AuditFilter фильтры журнала аудита; пустые поля не ограничивают выборку
+type AuditFilter struct {
+	Actor      string     `json:"actor,omitempty"`
+	Action     string     `json:"action,omitempty"`
+	EntityType string     `json:"entityType,omitempty"`
+	EntityID   string     `json:"entityId,omitempty"`
+	RequestID  string     `json:"requestId,omitempty"`
+	From       *time.Time `json:"from,omitempty"`
+	To         *time.Time `json:"to,omitempty"`
+}
+
+// where строит условия выборки; период включает From и не включает To
+func (f AuditFilter) where() *models.FilterGroup {
+	where := models.NewFilterGroup(models.FilterAnd)
+	equals := []struct{ column, value string }{
+		{"actor", f.Actor},
+		{"action", f.Action},
+		{"entity_type", f.EntityType},
+		{"entity_id", f.EntityID},
+		{"request_id", f.RequestID},
+	}
+	for _, condition := range equals {
+		if condition.value != "" {
+			where.Add(condition.column, models.FilterEq, condition.value)
+		}
+	}
+	if f.From != nil {
+		where.Add("created_at", models.FilterGte, *f.From)
+	}
+	if f.To != nil {
+		where.Add("created_at", models.FilterLt, *f.To)
+	}
+	return where
+}
@@ -0,0 +1,59 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit/dtos"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"time"
+)
+
+type GetAuditRecordsQuery struct {
+	AuditFilter
+	Limit  int `json:"limit" validate:"min=1,max=100"`
+	Offset int `json:"offset" validate:"min=0"`
+}
+
+type GetAuditRecordsQueryHandler struct {
+	repo repositories.AuditRepository
+}
+
+func NewGetAuditRecordsQueryHandler(repo repositories.AuditRepository) *GetAuditRecordsQueryHandler {
+	return &GetAuditRecordsQueryHandler{repo: repo}
+}
+
+// HandleGetAuditRecords возвращает записи журнала по фильтрам, новые первыми
+func (h *GetAuditRecordsQueryHandler) HandleGetAuditRecords(ctx context.Context, query GetAuditRecordsQuery) (*dtos.QueryResult, error) {
+	if query.Limit == 0 {
+		query.Limit = 50
+	}
+
+	opts := &models.QueryOptions{
+		Pagination: &models.PaginationParams{
+			Offset: query.Offset,
+			Limit:  query.Limit,
+		},
+		SortBy: []models.SortBy{
+			{Field: "createdAt", Order: models.DESC},
+			{Field: "id", Order: models.DESC},
+		},
+		Where: query.where(),
+	}
+
+	paginated, err := h.repo.FindWithPagination(ctx, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find audit records: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Paginated: paginated,
+		Success:   true,
+		Message:   "Audit records retrieved successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+// Recorder записывает изменения сущностей в журнал аудита от имени клиента запроса
+type Recorder struct {
+	repo repositories.AuditRepository
+}
+
+func NewRecorder(repo repositories.AuditRepository) *Recorder {
+	return &Recorder{repo: repo}
+}
+
+// Record добавляет запись с автором, ID запроса и IP клиента из ctx. Вызывается в транзакции
+// изменения: ошибка записи откатывает изменение, и в журнале не бывает пропусков.
+func (r *Recorder) Record(ctx context.Context, action entities.AuditAction, entityType entities.AuditEntityType, entityID string, before, after any) error {
+	record, err := entities.NewAuditRecord(identity.Actor(ctx), action, entityType, entityID, before, after)
+	if err != nil {
+		return fmt.Errorf("failed to build audit record: %w", err)
+	}
+
+	request := identity.RequestInfoFromContext(ctx)
+	record.RequestID = request.ID
+	record.ClientIP = request.ClientIP
+
+	if err := r.repo.Append(ctx, record); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	return nil
+}
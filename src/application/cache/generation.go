@@ -0,0 +1,24 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// GenerationScopeFAQ область поколений FAQ: списки, поиск и категории
+const GenerationScopeFAQ = "faq"
+
+// Generation поколение данных области: растет при каждом изменении ее записей.
+// По поколению строятся ETag и Last-Modified списков без обращения к базе.
+type Generation struct {
+	Value      int64
+	ModifiedAt time.Time
+}
+
+// GenerationStore хранит счетчики поколений, общие для всех реплик
+type GenerationStore interface {
+	// Current - текущее поколение области; для новой области счетчик создается
+	Current(ctx context.Context, scope string) (Generation, error)
+	// Bump - отмечает изменение данных области
+	Bump(ctx context.Context, scope string) error
+}
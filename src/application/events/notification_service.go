@@ -6,33 +6,57 @@ import (
 	"tax-priority-api/src/domain/entities"
 )
 
-// NotificationService интерфейс для отправки уведомлений
+// NotificationService интерфейс для отправки уведомлений.
+// Notify методы сохраняют событие в outbox: вызываются в транзакции изменения сущности,
+// и ошибка должна откатывать изменение. Получателям событие доставляет relay после коммита.
 type NotificationService interface {
 	// FAQ события
 
 	// NotifyFAQCreated - создание FAQ
-	NotifyFAQCreated(ctx context.Context, faq *entities.FAQ)
+	NotifyFAQCreated(ctx context.Context, faq *entities.FAQ) error
 	// NotifyFAQUpdated - обновление FAQ
-	NotifyFAQUpdated(ctx context.Context, faq *entities.FAQ)
+	NotifyFAQUpdated(ctx context.Context, faq *entities.FAQ) error
 	// NotifyFAQDeleted - удаление FAQ
-	NotifyFAQDeleted(ctx context.Context, faqID string)
+	NotifyFAQDeleted(ctx context.Context, faqID string) error
 	// NotifyFAQActivated - активация FAQ
-	NotifyFAQActivated(ctx context.Context, faq *entities.FAQ)
+	NotifyFAQActivated(ctx context.Context, faq *entities.FAQ) error
 	// NotifyFAQDeactivated - деактивация FAQ
-	NotifyFAQDeactivated(ctx context.Context, faq *entities.FAQ)
+	NotifyFAQDeactivated(ctx context.Context, faq *entities.FAQ) error
 	// NotifyFAQPriorityChanged - изменение приоритета FAQ
-	NotifyFAQPriorityChanged(ctx context.Context, faq *entities.FAQ, oldPriority int)
+	NotifyFAQPriorityChanged(ctx context.Context, faq *entities.FAQ, oldPriority int) error
 	// NotifyFAQCategoryChanged - изменение категории FAQ
-	NotifyFAQCategoryChanged(ctx context.Context, faq *entities.FAQ, oldCategory string)
+	NotifyFAQCategoryChanged(ctx context.Context, faq *entities.FAQ, oldCategory string) error
 	// NotifyFAQBatchCreated - создание пачки FAQ
-	NotifyFAQBatchCreated(ctx context.Context, faqs []*entities.FAQ)
+	NotifyFAQBatchCreated(ctx context.Context, faqs []*entities.FAQ) error
 	// NotifyFAQBatchDeleted - удаление пачки FAQ
-	NotifyFAQBatchDeleted(ctx context.Context, faqIDs []string)
+	NotifyFAQBatchDeleted(ctx context.Context, faqIDs []string) error
+
+	// Feature события
+
+	// NotifyFeatureCreated - создание Feature
+	NotifyFeatureCreated(ctx context.Context, feature *entities.Feature) error
+	// NotifyFeatureUpdated - обновление Feature
+	NotifyFeatureUpdated(ctx context.Context, feature *entities.Feature) error
+	// NotifyFeatureDeleted - удаление Feature
+	NotifyFeatureDeleted(ctx context.Context, featureID string) error
+	// NotifyFeatureActivated - активация Feature
+	NotifyFeatureActivated(ctx context.Context, feature *entities.Feature) error
+	// NotifyFeatureDeactivated - деактивация Feature
+	NotifyFeatureDeactivated(ctx context.Context, feature *entities.Feature) error
+	// NotifyFeaturesReordered - изменение порядка Feature
+	NotifyFeaturesReordered(ctx context.Context, featureIDs []string) error
+
+	// Testimonial события
+
+	// NotifyTestimonialStatusChanged - переход отзыва в новый статус модерации; previous пуст для нового отзыва
+	NotifyTestimonialStatusChanged(ctx context.Context, testimonial *entities.Testimonial, previous entities.TestimonialStatus) error
+	// NotifyTestimonialVerificationRequested - письмо автору со ссылкой подтверждения email; в WebSocket не рассылается
+	NotifyTestimonialVerificationRequested(ctx context.Context, testimonial *entities.Testimonial) error
 
 	// Системные события
 
 	// NotifySystemEvent - системное событие
-	NotifySystemEvent(ctx context.Context, event string, data interface{})
+	NotifySystemEvent(ctx context.Context, event string, data interface{}) error
 
 	// Статистика и состояние
 
@@ -3,23 +3,34 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
 )
 
 type ActivateFAQCommand struct {
 	ID string `json:"id" validate:"required"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
 }
 
 type ActivateFAQCommandHandler struct {
 	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewActivateFAQCommandHandler(repo repositories.FAQRepository, notificationService events.NotificationService) *ActivateFAQCommandHandler {
+func NewActivateFAQCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *ActivateFAQCommandHandler {
 	return &ActivateFAQCommandHandler{
 		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
@@ -34,18 +45,34 @@ func (h *ActivateFAQCommandHandler) HandleActivateFAQ(ctx context.Context, cmd A
 		}, err
 	}
 
-	faq.Activate()
-
-	if err := h.repo.Update(ctx, faq); err != nil {
+	if err := entities.CheckVersion(faq, cmd.ExpectedVersion); err != nil {
 		return &dtos.CommandResult{
 			Success: false,
-			Error:   fmt.Sprintf("failed to activate FAQ: %v", err),
+			Error:   err.Error(),
 		}, err
 	}
 
-	// Отправляем уведомление об активации FAQ
-	if h.notificationService != nil {
-		h.notificationService.NotifyFAQActivated(ctx, faq)
+	before := *faq
+
+	faq.Activate()
+	faq.SetUpdatedBy(identity.Actor(ctx))
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, faq); err != nil {
+			return fmt.Errorf("failed to activate FAQ: %w", err)
+		}
+		if err := recordChange(ctx, h.revisions, h.auditLog, entities.FAQRevisionActivated, &before, faq); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление об активации FAQ
+		return h.notificationService.NotifyFAQActivated(ctx, faq)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
 	}
 
 	return &dtos.CommandResult{
@@ -53,5 +80,6 @@ func (h *ActivateFAQCommandHandler) HandleActivateFAQ(ctx context.Context, cmd A
 		Success:   true,
 		Message:   "FAQ activated successfully",
 		UpdatedAt: faq.UpdatedAt,
+		Version:   faq.Version,
 	}, nil
 }
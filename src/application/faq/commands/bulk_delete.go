@@ -3,45 +3,107 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/models"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
 )
 
 type BulkDeleteFAQCommand struct {
 	IDs []string `json:"ids" validate:"required,min=1"`
+	// Atomic - удалить все FAQ или ни одного: если часть ID не найдена, пакет отменяется
+	Atomic bool `json:"atomic"`
 }
 
 type BulkDeleteFAQCommandHandler struct {
 	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewBulkDeleteFAQCommandHandler(repo repositories.FAQRepository, notificationService events.NotificationService) *BulkDeleteFAQCommandHandler {
+func NewBulkDeleteFAQCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *BulkDeleteFAQCommandHandler {
 	return &BulkDeleteFAQCommandHandler{
 		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
 
+// HandleBulkDeleteFAQ переносит найденные FAQ в корзину. Чтение, удаление, ревизии, записи аудита и событие
+// выполняются в одной транзакции и касаются только удаленных FAQ; не найденные ID попадают в ошибки результата.
 func (h *BulkDeleteFAQCommandHandler) HandleBulkDeleteFAQ(ctx context.Context, cmd BulkDeleteFAQCommand) (*dtos.BatchCommandResult, error) {
-	result, err := h.repo.DeleteBatch(ctx, cmd.IDs)
+	var result *models.BulkOperationResult
+	err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Сохраняем последнее состояние удаляемых FAQ для истории
+		faqs, err := h.repo.FindByIDs(ctx, cmd.IDs)
+		if err != nil {
+			return fmt.Errorf("failed to find FAQs: %w", err)
+		}
+
+		found := make(map[string]bool, len(faqs))
+		ids := make([]string, 0, len(faqs))
+		for _, faq := range faqs {
+			found[faq.ID] = true
+			ids = append(ids, faq.ID)
+		}
+
+		result = &models.BulkOperationResult{SuccessCount: len(ids)}
+		for _, id := range cmd.IDs {
+			if !found[id] {
+				found[id] = true
+				result.FailureCount++
+				result.Errors = append(result.Errors, fmt.Errorf("FAQ with ID %s not found", id))
+			}
+		}
+		if cmd.Atomic {
+			if err := result.RequireComplete(); err != nil {
+				return err
+			}
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		deleted, err := h.repo.DeleteBatch(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to delete FAQs: %w", err)
+		}
+		// FAQ найдены в этой же транзакции: расхождение значит, что их удалили параллельно
+		if err := deleted.RequireComplete(); err != nil {
+			return err
+		}
+
+		for _, faq := range faqs {
+			if err := recordChange(ctx, h.revisions, h.auditLog, entities.FAQRevisionDeleted, faq, nil); err != nil {
+				return fmt.Errorf("%s: %w", faq.ID, err)
+			}
+		}
+
+		// Отправляем уведомление о массовом удалении FAQ
+		return h.notificationService.NotifyFAQBatchDeleted(ctx, ids)
+	})
 	if err != nil {
 		return &dtos.BatchCommandResult{
 			SuccessCount: 0,
 			FailureCount: len(cmd.IDs),
-			Errors:       []string{fmt.Sprintf("failed to delete FAQs: %v", err)},
+			Errors:       []string{err.Error()},
 		}, err
 	}
 
-	// Отправляем уведомление о массовом удалении FAQ
-	if h.notificationService != nil {
-		h.notificationService.NotifyFAQBatchDeleted(ctx, cmd.IDs)
+	errs := make([]string, 0, len(result.Errors))
+	for _, resultErr := range result.Errors {
+		errs = append(errs, resultErr.Error())
 	}
 
 	return &dtos.BatchCommandResult{
 		SuccessCount: result.SuccessCount,
 		FailureCount: result.FailureCount,
-		Errors:       make([]string, len(result.Errors)),
+		Errors:       errs,
 	}, nil
 }
@@ -3,8 +3,10 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
 
@@ -20,12 +22,18 @@ type CreateFAQCommand struct {
 
 type CreateFAQCommandHandler struct {
 	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewCreateFAQCommandHandler(repo repositories.FAQRepository, notificationService events.NotificationService) *CreateFAQCommandHandler {
+func NewCreateFAQCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *CreateFAQCommandHandler {
 	return &CreateFAQCommandHandler{
 		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
@@ -46,23 +54,31 @@ func (h *CreateFAQCommandHandler) HandleCreateFAQ(ctx context.Context, cmd Creat
 	}
 
 	faq.SetID(uuid.New().String())
+	faq.SetCreatedBy(identity.Actor(ctx))
 
-	if err = h.repo.Create(ctx, faq); err != nil {
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Create(ctx, faq); err != nil {
+			return fmt.Errorf("failed to create FAQ: %w", err)
+		}
+		if err := recordChange(ctx, h.revisions, h.auditLog, entities.FAQRevisionCreated, nil, faq); err != nil {
+			return err
+		}
+
+		return h.notificationService.NotifyFAQCreated(ctx, faq)
+	})
+	if err != nil {
 		return &dtos.CommandResult{
 			Success: false,
-			Error:   fmt.Sprintf("failed to create FAQ: %v", err),
+			Error:   err.Error(),
 		}, err
 	}
 
-	if h.notificationService != nil {
-		h.notificationService.NotifyFAQCreated(ctx, faq)
-	}
-
 	return &dtos.CommandResult{
 		ID:        faq.ID,
 		Success:   true,
 		Message:   "FAQ created successfully",
 		CreatedAt: faq.CreatedAt,
 		UpdatedAt: faq.UpdatedAt,
+		Version:   faq.Version,
 	}, nil
 }
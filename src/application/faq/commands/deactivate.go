@@ -3,23 +3,34 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
 )
 
 type DeactivateFAQCommand struct {
 	ID string `json:"id" validate:"required"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
 }
 
 type DeactivateFAQCommandHandler struct {
 	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewDeactivateFAQCommandHandler(repo repositories.FAQRepository, notificationService events.NotificationService) *DeactivateFAQCommandHandler {
+func NewDeactivateFAQCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *DeactivateFAQCommandHandler {
 	return &DeactivateFAQCommandHandler{
 		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
@@ -34,18 +45,34 @@ func (h *DeactivateFAQCommandHandler) HandleDeactivateFAQ(ctx context.Context, c
 		}, err
 	}
 
-	faq.Deactivate()
-
-	if err := h.repo.Update(ctx, faq); err != nil {
+	if err := entities.CheckVersion(faq, cmd.ExpectedVersion); err != nil {
 		return &dtos.CommandResult{
 			Success: false,
-			Error:   fmt.Sprintf("failed to deactivate FAQ: %v", err),
+			Error:   err.Error(),
 		}, err
 	}
 
-	// Отправляем уведомление о деактивации FAQ
-	if h.notificationService != nil {
-		h.notificationService.NotifyFAQDeactivated(ctx, faq)
+	before := *faq
+
+	faq.Deactivate()
+	faq.SetUpdatedBy(identity.Actor(ctx))
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, faq); err != nil {
+			return fmt.Errorf("failed to deactivate FAQ: %w", err)
+		}
+		if err := recordChange(ctx, h.revisions, h.auditLog, entities.FAQRevisionDeactivated, &before, faq); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление о деактивации FAQ
+		return h.notificationService.NotifyFAQDeactivated(ctx, faq)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
 	}
 
 	return &dtos.CommandResult{
@@ -53,5 +80,6 @@ func (h *DeactivateFAQCommandHandler) HandleDeactivateFAQ(ctx context.Context, c
 		Success:   true,
 		Message:   "FAQ deactivated successfully",
 		UpdatedAt: faq.UpdatedAt,
+		Version:   faq.Version,
 	}, nil
 }
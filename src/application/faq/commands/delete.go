@@ -3,9 +3,11 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/dtos"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
 )
 
 type DeleteFAQCommand struct {
@@ -14,45 +16,52 @@ type DeleteFAQCommand struct {
 
 type DeleteFAQCommandHandler struct {
 	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewDeleteFAQCommandHandler(repo repositories.FAQRepository, notificationService events.NotificationService) *DeleteFAQCommandHandler {
+func NewDeleteFAQCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *DeleteFAQCommandHandler {
 	return &DeleteFAQCommandHandler{
 		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
 
 func (h *DeleteFAQCommandHandler) HandleDeleteFAQ(ctx context.Context, cmd DeleteFAQCommand) (*dtos.CommandResult, error) {
 
-	exists, err := h.repo.Exists(ctx, cmd.ID)
+	// Загружаем FAQ, чтобы сохранить его последнее состояние в истории
+	faq, err := h.repo.FindByID(ctx, cmd.ID)
 	if err != nil {
-		return &dtos.CommandResult{
-			Success: false,
-			Error:   fmt.Sprintf("failed to check if FAQ exists: %v", err),
-		}, err
-	}
-
-	if !exists {
 		return &dtos.CommandResult{
 			Success: false,
 			Error:   "FAQ not found",
-		}, fmt.Errorf("FAQ with ID %s not found", cmd.ID)
+		}, fmt.Errorf("FAQ with ID %s not found: %w", cmd.ID, err)
 	}
 
-	if err := h.repo.Delete(ctx, cmd.ID); err != nil {
+	// FAQ перемещается в корзину и может быть восстановлен до очистки
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.SoftDelete(ctx, cmd.ID); err != nil {
+			return fmt.Errorf("failed to delete FAQ: %w", err)
+		}
+		if err := recordChange(ctx, h.revisions, h.auditLog, entities.FAQRevisionDeleted, faq, nil); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление об удалении FAQ
+		return h.notificationService.NotifyFAQDeleted(ctx, cmd.ID)
+	})
+	if err != nil {
 		return &dtos.CommandResult{
 			Success: false,
-			Error:   fmt.Sprintf("failed to delete FAQ: %v", err),
+			Error:   err.Error(),
 		}, err
 	}
 
-	// Отправляем уведомление об удалении FAQ
-	if h.notificationService != nil {
-		h.notificationService.NotifyFAQDeleted(ctx, cmd.ID)
-	}
-
 	return &dtos.CommandResult{
 		ID:      cmd.ID,
 		Success: true,
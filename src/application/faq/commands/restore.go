@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+type RestoreFAQCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// RestoreFAQCommandHandler возвращает FAQ из корзины
+type RestoreFAQCommandHandler struct {
+	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+func NewRestoreFAQCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *RestoreFAQCommandHandler {
+	return &RestoreFAQCommandHandler{
+		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+	}
+}
+
+func (h *RestoreFAQCommandHandler) HandleRestoreFAQ(ctx context.Context, cmd RestoreFAQCommand) (*dtos.CommandResult, error) {
+
+	var faq *entities.FAQ
+	err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Restore(ctx, cmd.ID); err != nil {
+			return fmt.Errorf("failed to restore FAQ: %w", err)
+		}
+
+		restored, err := h.repo.FindByID(ctx, cmd.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find FAQ: %w", err)
+		}
+		faq = restored
+
+		if err := recordChange(ctx, h.revisions, h.auditLog, entities.FAQRevisionRestored, nil, faq); err != nil {
+			return err
+		}
+
+		// Для клиентов восстановленный FAQ появляется заново
+		return h.notificationService.NotifyFAQCreated(ctx, faq)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:        faq.ID,
+		Success:   true,
+		Message:   "FAQ restored successfully",
+		UpdatedAt: faq.UpdatedAt,
+		Version:   faq.Version,
+	}, nil
+}
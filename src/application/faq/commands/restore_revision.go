@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/repositories"
+)
+
+type RestoreFAQRevisionCommand struct {
+	ID       string `json:"id" validate:"required"`
+	Revision int    `json:"revision" validate:"required,min=1"`
+}
+
+// RestoreFAQRevisionCommandHandler восстанавливает содержимое FAQ (вопрос, ответ, категорию, приоритет)
+// из ревизии. Восстановление проходит через обычное обновление: валидацию, новую ревизию и уведомление.
+type RestoreFAQRevisionCommandHandler struct {
+	revisions     repositories.FAQRevisionRepository
+	updateHandler *UpdateFAQCommandHandler
+}
+
+func NewRestoreFAQRevisionCommandHandler(revisions repositories.FAQRevisionRepository, updateHandler *UpdateFAQCommandHandler) *RestoreFAQRevisionCommandHandler {
+	return &RestoreFAQRevisionCommandHandler{
+		revisions:     revisions,
+		updateHandler: updateHandler,
+	}
+}
+
+func (h *RestoreFAQRevisionCommandHandler) HandleRestoreFAQRevision(ctx context.Context, cmd RestoreFAQRevisionCommand) (*dtos.CommandResult, error) {
+	revision, err := h.revisions.FindByNumber(ctx, cmd.ID, cmd.Revision)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to find revision: %v", err),
+		}, err
+	}
+
+	state := revision.State()
+	if state == nil {
+		err := errors.New("revision has no stored state")
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	restoredFrom := revision.Revision
+	return h.updateHandler.update(ctx, UpdateFAQCommand{
+		ID:       cmd.ID,
+		Question: state.Question,
+		Answer:   state.Answer,
+		Category: state.Category,
+		Priority: state.Priority,
+	}, &restoredFrom)
+}
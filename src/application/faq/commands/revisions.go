@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+// recordChange сохраняет ревизию FAQ и запись журнала аудита; вызывается в транзакции изменения.
+// before и after - состояние FAQ до и после изменения (nil при создании и удалении);
+// действия ревизий и аудита называются одинаково.
+func recordChange(
+	ctx context.Context,
+	revisions repositories.FAQRevisionRepository,
+	auditLog *audit.Recorder,
+	action entities.FAQRevisionAction,
+	before, after *entities.FAQ,
+) error {
+	faq := after
+	if faq == nil {
+		faq = before
+	}
+	faqID := faq.ID
+
+	if _, err := recordRevision(ctx, revisions, faqID, action, entities.NewFAQSnapshot(before), entities.NewFAQSnapshot(after)); err != nil {
+		return err
+	}
+
+	return auditLog.Record(ctx, entities.AuditAction(action), entities.AuditEntityFAQ, faqID, before, after)
+}
+
+// recordRevision сохраняет ревизию изменения FAQ от имени клиента запроса; без репозитория ревизий ничего не делает
+func recordRevision(
+	ctx context.Context,
+	revisions repositories.FAQRevisionRepository,
+	faqID string,
+	action entities.FAQRevisionAction,
+	before, after *entities.FAQSnapshot,
+) (*entities.FAQRevision, error) {
+	if revisions == nil {
+		return nil, nil
+	}
+
+	revision, err := entities.NewFAQRevision(faqID, action, before, after, identity.Actor(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build revision: %w", err)
+	}
+
+	return revision, appendRevision(ctx, revisions, revision)
+}
+
+// appendRevision сохраняет подготовленную ревизию
+func appendRevision(ctx context.Context, revisions repositories.FAQRevisionRepository, revision *entities.FAQRevision) error {
+	if revisions == nil {
+		return nil
+	}
+
+	if err := revisions.Append(ctx, revision); err != nil {
+		return fmt.Errorf("failed to record revision: %w", err)
+	}
+
+	return nil
+}
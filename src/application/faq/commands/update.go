@@ -3,9 +3,12 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
 )
 
 type UpdateFAQCommand struct {
@@ -14,21 +17,34 @@ type UpdateFAQCommand struct {
 	Answer   string `json:"answer" validate:"required,min=10,max=2000"`
 	Category string `json:"category" validate:"required,max=100"`
 	Priority int    `json:"priority" validate:"min=0,max=100"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
 }
 
 type UpdateFAQCommandHandler struct {
 	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewUpdateFAQCommandHandler(repo repositories.FAQRepository, notificationService events.NotificationService) *UpdateFAQCommandHandler {
+func NewUpdateFAQCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *UpdateFAQCommandHandler {
 	return &UpdateFAQCommandHandler{
 		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
 
 func (h *UpdateFAQCommandHandler) HandleUpdateFAQ(ctx context.Context, cmd UpdateFAQCommand) (*dtos.CommandResult, error) {
+	return h.update(ctx, cmd, nil)
+}
+
+// update применяет изменения через валидацию сущности; restoredFrom задается при откате к ревизии
+func (h *UpdateFAQCommandHandler) update(ctx context.Context, cmd UpdateFAQCommand, restoredFrom *int) (*dtos.CommandResult, error) {
 
 	faq, err := h.repo.FindByID(ctx, cmd.ID)
 	if err != nil {
@@ -38,6 +54,15 @@ func (h *UpdateFAQCommandHandler) HandleUpdateFAQ(ctx context.Context, cmd Updat
 		}, err
 	}
 
+	if err := entities.CheckVersion(faq, cmd.ExpectedVersion); err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	before := *faq
+
 	if err := faq.UpdateQuestion(cmd.Question); err != nil {
 		return &dtos.CommandResult{
 			Success: false,
@@ -66,23 +91,51 @@ func (h *UpdateFAQCommandHandler) HandleUpdateFAQ(ctx context.Context, cmd Updat
 		}, err
 	}
 
-	// Сохраняем изменения
-	if err := h.repo.Update(ctx, faq); err != nil {
+	faq.SetUpdatedBy(identity.Actor(ctx))
+
+	action := entities.FAQRevisionUpdated
+	if restoredFrom != nil {
+		action = entities.FAQRevisionRestored
+	}
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, faq); err != nil {
+			return fmt.Errorf("failed to update FAQ: %w", err)
+		}
+
+		revision, err := entities.NewFAQRevision(faq.ID, action, entities.NewFAQSnapshot(&before), entities.NewFAQSnapshot(faq), identity.Actor(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to build revision: %w", err)
+		}
+		revision.RestoredFrom = restoredFrom
+		if err := appendRevision(ctx, h.revisions, revision); err != nil {
+			return err
+		}
+
+		if err := h.auditLog.Record(ctx, entities.AuditAction(action), entities.AuditEntityFAQ, faq.ID, &before, faq); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление об обновлении FAQ
+		return h.notificationService.NotifyFAQUpdated(ctx, faq)
+	})
+	if err != nil {
 		return &dtos.CommandResult{
 			Success: false,
-			Error:   fmt.Sprintf("failed to update FAQ: %v", err),
+			Error:   err.Error(),
 		}, err
 	}
 
-	// Отправляем уведомление об обновлении FAQ
-	if h.notificationService != nil {
-		h.notificationService.NotifyFAQUpdated(ctx, faq)
+	message := "FAQ updated successfully"
+	if restoredFrom != nil {
+		message = fmt.Sprintf("FAQ restored from revision %d", *restoredFrom)
 	}
 
 	return &dtos.CommandResult{
 		ID:        faq.ID,
 		Success:   true,
-		Message:   "FAQ updated successfully",
+		Message:   message,
 		UpdatedAt: faq.UpdatedAt,
+		Version:   faq.Version,
 	}, nil
 }
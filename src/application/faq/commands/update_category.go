@@ -3,24 +3,35 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
 )
 
 type UpdateFAQCategoryCommand struct {
 	ID       string `json:"id" validate:"required"`
 	Category string `json:"category" validate:"required,max=100"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
 }
 
 type UpdateFAQCategoryCommandHandler struct {
 	repo                repositories.FAQRepository
+	revisions           repositories.FAQRevisionRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewUpdateFAQCategoryCommandHandler(repo repositories.FAQRepository, notificationService events.NotificationService) *UpdateFAQCategoryCommandHandler {
+func NewUpdateFAQCategoryCommandHandler(repo repositories.FAQRepository, revisions repositories.FAQRevisionRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *UpdateFAQCategoryCommandHandler {
 	return &UpdateFAQCategoryCommandHandler{
 		repo:                repo,
+		revisions:           revisions,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
@@ -35,6 +46,15 @@ func (h *UpdateFAQCategoryCommandHandler) HandleUpdateFAQCategory(ctx context.Co
 		}, err
 	}
 
+	if err := entities.CheckVersion(faq, cmd.ExpectedVersion); err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	before := *faq
+
 	oldCategory := faq.Category
 
 	if err := faq.UpdateCategory(cmd.Category); err != nil {
@@ -44,22 +64,31 @@ func (h *UpdateFAQCategoryCommandHandler) HandleUpdateFAQCategory(ctx context.Co
 		}, err
 	}
 
-	if err := h.repo.Update(ctx, faq); err != nil {
+	faq.SetUpdatedBy(identity.Actor(ctx))
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, faq); err != nil {
+			return fmt.Errorf("failed to update FAQ category: %w", err)
+		}
+		if err := recordChange(ctx, h.revisions, h.auditLog, entities.FAQRevisionUpdated, &before, faq); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление об изменении категории FAQ
+		return h.notificationService.NotifyFAQCategoryChanged(ctx, faq, oldCategory)
+	})
+	if err != nil {
 		return &dtos.CommandResult{
 			Success: false,
-			Error:   fmt.Sprintf("failed to update FAQ category: %v", err),
+			Error:   err.Error(),
 		}, err
 	}
 
-	// Отправляем уведомление об изменении категории FAQ
-	if h.notificationService != nil {
-		h.notificationService.NotifyFAQCategoryChanged(ctx, faq, oldCategory)
-	}
-
 	return &dtos.CommandResult{
 		ID:        faq.ID,
 		Success:   true,
 		Message:   "FAQ category updated successfully",
 		UpdatedAt: faq.UpdatedAt,
+		Version:   faq.Version,
 	}, nil
 }
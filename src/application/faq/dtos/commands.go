@@ -9,6 +9,7 @@ type CommandResult struct {
 	Error     string    `json:"error,omitempty"`
 	CreatedAt time.Time `json:"createdAt,omitempty"`
 	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	Version   int       `json:"version,omitempty"`
 }
 
 type BatchCommandResult struct {
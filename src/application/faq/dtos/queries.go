@@ -7,16 +7,21 @@ import (
 )
 
 type QueryResult struct {
-	FAQ            *entities.FAQ                          `json:"faq,omitempty"`
-	FAQs           []*entities.FAQ                        `json:"faqs,omitempty"`
-	Paginated      *models.PaginatedResult[*entities.FAQ] `json:"paginated,omitempty"`
-	Count          int64                                  `json:"count,omitempty"`
-	Categories     []string                               `json:"categories,omitempty"`
-	CategoryCounts map[string]int64                       `json:"categoryCounts,omitempty"`
-	Success        bool                                   `json:"success"`
-	Message        string                                 `json:"message,omitempty"`
-	Error          string                                 `json:"error,omitempty"`
-	Timestamp      time.Time                              `json:"timestamp"`
+	FAQ             *entities.FAQ                                            `json:"faq,omitempty"`
+	FAQs            []*entities.FAQ                                          `json:"faqs,omitempty"`
+	Paginated       *models.PaginatedResult[*entities.FAQ]                   `json:"paginated,omitempty"`
+	SearchResults   *models.PaginatedResult[models.SearchHit[*entities.FAQ]] `json:"searchResults,omitempty"`
+	CursorPaginated *models.CursorPaginatedResult[*entities.FAQ]             `json:"cursorPaginated,omitempty"`
+	Revision        *entities.FAQRevision                                    `json:"revision,omitempty"`
+	Revisions       *models.PaginatedResult[*entities.FAQRevision]           `json:"revisions,omitempty"`
+	Diff            *FAQRevisionDiff                                         `json:"diff,omitempty"`
+	Count           int64                                                    `json:"count,omitempty"`
+	Categories      []string                                                 `json:"categories,omitempty"`
+	CategoryCounts  map[string]int64                                         `json:"categoryCounts,omitempty"`
+	Success         bool                                                     `json:"success"`
+	Message         string                                                   `json:"message,omitempty"`
+	Error           string                                                   `json:"error,omitempty"`
+	Timestamp       time.Time                                                `json:"timestamp"`
 }
 
 type FAQResponse struct {
@@ -26,8 +31,16 @@ type FAQResponse struct {
 	Category  string    `json:"category"`
 	IsActive  bool      `json:"isActive"`
 	Priority  int       `json:"priority"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	UpdatedBy string    `json:"updatedBy,omitempty"`
+	Version   int       `json:"version"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// DeletedAt заполняется только для FAQ в корзине
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	// Rank и Highlights заполняются только для результатов поиска
+	Rank       *float64          `json:"rank,omitempty"`
+	Highlights map[string]string `json:"highlights,omitempty"`
 }
 
 type PaginatedFAQResponse struct {
@@ -40,6 +53,23 @@ type PaginatedFAQResponse struct {
 	TotalPages int           `json:"totalPages"`
 }
 
+type CursorPaginatedFAQResponse struct {
+	Items      []FAQResponse `json:"items"`
+	NextCursor string        `json:"nextCursor,omitempty"`
+	PrevCursor string        `json:"prevCursor,omitempty"`
+	HasNext    bool          `json:"hasNext"`
+	HasPrev    bool          `json:"hasPrev"`
+	Limit      int           `json:"limit"`
+}
+
+// FAQRevisionDiff различия между состояниями FAQ после двух ревизий
+type FAQRevisionDiff struct {
+	FAQID   string                    `json:"faqId"`
+	From    int                       `json:"from"`
+	To      int                       `json:"to"`
+	Changes []entities.FAQFieldChange `json:"changes"`
+}
+
 type CategoryResponse struct {
 	Name  string `json:"name"`
 	Count int64  `json:"count,omitempty"`
@@ -60,8 +90,12 @@ func ToFAQResponse(faq *entities.FAQ) FAQResponse {
 		Category:  faq.Category,
 		IsActive:  faq.IsActive,
 		Priority:  faq.Priority,
+		CreatedBy: faq.CreatedBy,
+		UpdatedBy: faq.UpdatedBy,
+		Version:   faq.Version,
 		CreatedAt: faq.CreatedAt,
 		UpdatedAt: faq.UpdatedAt,
+		DeletedAt: faq.DeletedAt,
 	}
 }
 
@@ -84,3 +118,34 @@ func ToPaginatedFAQResponse(paginated *models.PaginatedResult[*entities.FAQ]) Pa
 		TotalPages: paginated.TotalPages,
 	}
 }
+
+func ToPaginatedFAQSearchResponse(results *models.PaginatedResult[models.SearchHit[*entities.FAQ]]) PaginatedFAQResponse {
+	items := make([]FAQResponse, len(results.Items))
+	for i, hit := range results.Items {
+		rank := hit.Rank
+		items[i] = ToFAQResponse(hit.Item)
+		items[i].Rank = &rank
+		items[i].Highlights = hit.Highlights
+	}
+
+	return PaginatedFAQResponse{
+		Items:      items,
+		Total:      results.Total,
+		Offset:     results.Offset,
+		Limit:      results.Limit,
+		HasNext:    results.HasNext,
+		HasPrev:    results.HasPrev,
+		TotalPages: results.TotalPages,
+	}
+}
+
+func ToCursorPaginatedFAQResponse(page *models.CursorPaginatedResult[*entities.FAQ]) CursorPaginatedFAQResponse {
+	return CursorPaginatedFAQResponse{
+		Items:      ToFAQResponses(page.Items),
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+		HasNext:    page.HasNext,
+		HasPrev:    page.HasPrev,
+		Limit:      page.Limit,
+	}
+}
@@ -1,31 +1,44 @@
 package handlers
 
 import (
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/faq/commands"
 	"tax-priority-api/src/application/repositories"
 )
 
 type FAQCommandHandlers struct {
-	Activate       *commands.ActivateFAQCommandHandler
-	BulkDelete     *commands.BulkDeleteFAQCommandHandler
-	Deactivate     *commands.DeactivateFAQCommandHandler
-	Delete         *commands.DeleteFAQCommandHandler
-	Create         *commands.CreateFAQCommandHandler
-	Update         *commands.UpdateFAQCommandHandler
-	UpdateCategory *commands.UpdateFAQCategoryCommandHandler
-	UpdatePriority *commands.UpdateFAQPriorityCommandHandler
+	Activate        *commands.ActivateFAQCommandHandler
+	BulkDelete      *commands.BulkDeleteFAQCommandHandler
+	Deactivate      *commands.DeactivateFAQCommandHandler
+	Delete          *commands.DeleteFAQCommandHandler
+	Create          *commands.CreateFAQCommandHandler
+	Update          *commands.UpdateFAQCommandHandler
+	UpdateCategory  *commands.UpdateFAQCategoryCommandHandler
+	UpdatePriority  *commands.UpdateFAQPriorityCommandHandler
+	RestoreRevision *commands.RestoreFAQRevisionCommandHandler
+	Restore         *commands.RestoreFAQCommandHandler
 }
 
-func NewFAQCommandHandlers(repo repositories.CachedFAQRepository, notificationService events.NotificationService) *FAQCommandHandlers {
+func NewFAQCommandHandlers(
+	repo repositories.CachedFAQRepository,
+	revisions repositories.FAQRevisionRepository,
+	transactor repositories.Transactor,
+	auditLog *audit.Recorder,
+	notificationService events.NotificationService,
+) *FAQCommandHandlers {
+	update := commands.NewUpdateFAQCommandHandler(repo, revisions, transactor, auditLog, notificationService)
+
 	return &FAQCommandHandlers{
-		Activate:       commands.NewActivateFAQCommandHandler(repo, notificationService),
-		BulkDelete:     commands.NewBulkDeleteFAQCommandHandler(repo, notificationService),
-		Deactivate:     commands.NewDeactivateFAQCommandHandler(repo, notificationService),
-		Delete:         commands.NewDeleteFAQCommandHandler(repo, notificationService),
-		Create:         commands.NewCreateFAQCommandHandler(repo, notificationService),
-		Update:         commands.NewUpdateFAQCommandHandler(repo, notificationService),
-		UpdateCategory: commands.NewUpdateFAQCategoryCommandHandler(repo, notificationService),
-		UpdatePriority: commands.NewUpdateFAQPriorityCommandHandler(repo, notificationService),
+		Activate:        commands.NewActivateFAQCommandHandler(repo, revisions, transactor, auditLog, notificationService),
+		BulkDelete:      commands.NewBulkDeleteFAQCommandHandler(repo, revisions, transactor, auditLog, notificationService),
+		Deactivate:      commands.NewDeactivateFAQCommandHandler(repo, revisions, transactor, auditLog, notificationService),
+		Delete:          commands.NewDeleteFAQCommandHandler(repo, revisions, transactor, auditLog, notificationService),
+		Create:          commands.NewCreateFAQCommandHandler(repo, revisions, transactor, auditLog, notificationService),
+		Update:          update,
+		UpdateCategory:  commands.NewUpdateFAQCategoryCommandHandler(repo, revisions, transactor, auditLog, notificationService),
+		UpdatePriority:  commands.NewUpdateFAQPriorityCommandHandler(repo, revisions, transactor, auditLog, notificationService),
+		RestoreRevision: commands.NewRestoreFAQRevisionCommandHandler(revisions, update),
+		Restore:         commands.NewRestoreFAQCommandHandler(repo, revisions, transactor, auditLog, notificationService),
 	}
 }
@@ -11,14 +11,20 @@ type FAQQueryHandlers struct {
 	GetCount      *queries.GetFAQCountQueryHandler
 	GetMany       *queries.GetFAQsQueryHandler
 	GetCategories *queries.GetFAQCategoriesQueryHandler
+	Search        *queries.SearchFAQsQueryHandler
+	Revisions     *queries.FAQRevisionsQueryHandler
+	Trash         *queries.GetFAQTrashQueryHandler
 }
 
-func NewFAQQueryHandlers(repo repositories.CachedFAQRepository) *FAQQueryHandlers {
+func NewFAQQueryHandlers(repo repositories.CachedFAQRepository, revisions repositories.FAQRevisionRepository) *FAQQueryHandlers {
 	return &FAQQueryHandlers{
 		GetByID:       queries.NewGetFAQByIDQueryHandler(repo),
 		GetByIDs:      queries.NewGetFAQsByIDsQueryHandler(repo),
 		GetCount:      queries.NewGetFAQCountQueryHandler(repo),
 		GetMany:       queries.NewGetFAQsQueryHandler(repo),
 		GetCategories: queries.NewGetFAQCategoriesQueryHandler(repo),
+		Search:        queries.NewSearchFAQsQueryHandler(repo),
+		Revisions:     queries.NewFAQRevisionsQueryHandler(revisions),
+		Trash:         queries.NewGetFAQTrashQueryHandler(repo),
 	}
 }
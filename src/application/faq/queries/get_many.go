@@ -15,6 +15,9 @@ type GetFAQsQuery struct {
 	SortBy    string                 `json:"sortBy"`
 	SortOrder string                 `json:"sortOrder" validate:"oneof=asc desc"`
 	Filters   map[string]interface{} `json:"filters"`
+	Where     *models.FilterGroup    `json:"where,omitempty"`
+	// Cursor включает keyset-пагинацию вместо Offset; пустая строка - первая страница
+	Cursor *string `json:"cursor,omitempty"`
 }
 
 type GetFAQsQueryHandler struct {
@@ -48,6 +51,11 @@ func (h *GetFAQsQueryHandler) HandleGetFAQs(ctx context.Context, query GetFAQsQu
 			},
 		},
 		Filters: query.Filters,
+		Where:   query.Where,
+	}
+
+	if query.Cursor != nil {
+		return h.handleCursor(ctx, *query.Cursor, query.Limit, opts)
 	}
 
 	paginated, err := h.faqRepo.FindWithPagination(ctx, opts)
@@ -66,3 +74,24 @@ func (h *GetFAQsQueryHandler) HandleGetFAQs(ctx context.Context, query GetFAQsQu
 		Timestamp: time.Now(),
 	}, nil
 }
+
+func (h *GetFAQsQueryHandler) handleCursor(ctx context.Context, cursor string, limit int, opts *models.QueryOptions) (*dtos.QueryResult, error) {
+	opts.Pagination = nil
+	opts.Cursor = &models.CursorParams{Cursor: cursor, Limit: limit}
+
+	page, err := h.faqRepo.FindWithCursor(ctx, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find FAQs: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		CursorPaginated: page,
+		Success:         true,
+		Message:         "FAQs retrieved successfully",
+		Timestamp:       time.Now(),
+	}, nil
+}
@@ -0,0 +1,115 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"time"
+)
+
+type GetFAQRevisionsQuery struct {
+	FAQID  string `json:"faqId" validate:"required"`
+	Limit  int    `json:"limit" validate:"min=1,max=100"`
+	Offset int    `json:"offset" validate:"min=0"`
+}
+
+type GetFAQRevisionQuery struct {
+	FAQID    string `json:"faqId" validate:"required"`
+	Revision int    `json:"revision" validate:"required,min=1"`
+}
+
+type DiffFAQRevisionsQuery struct {
+	FAQID string `json:"faqId" validate:"required"`
+	From  int    `json:"from" validate:"required,min=1"`
+	To    int    `json:"to" validate:"required,min=1"`
+}
+
+type FAQRevisionsQueryHandler struct {
+	revisions repositories.FAQRevisionRepository
+}
+
+func NewFAQRevisionsQueryHandler(revisions repositories.FAQRevisionRepository) *FAQRevisionsQueryHandler {
+	return &FAQRevisionsQueryHandler{revisions: revisions}
+}
+
+func (h *FAQRevisionsQueryHandler) HandleGetFAQRevisions(ctx context.Context, query GetFAQRevisionsQuery) (*dtos.QueryResult, error) {
+	if query.Limit == 0 {
+		query.Limit = 20
+	}
+
+	opts := &models.QueryOptions{
+		Pagination: &models.PaginationParams{
+			Offset: query.Offset,
+			Limit:  query.Limit,
+		},
+	}
+
+	revisions, err := h.revisions.FindByFAQ(ctx, query.FAQID, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find revisions: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Revisions: revisions,
+		Success:   true,
+		Message:   "Revisions retrieved successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func (h *FAQRevisionsQueryHandler) HandleGetFAQRevision(ctx context.Context, query GetFAQRevisionQuery) (*dtos.QueryResult, error) {
+	revision, err := h.revisions.FindByNumber(ctx, query.FAQID, query.Revision)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find revision: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Revision:  revision,
+		Success:   true,
+		Message:   "Revision retrieved successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// HandleDiffFAQRevisions сравнивает состояния FAQ после двух ревизий
+func (h *FAQRevisionsQueryHandler) HandleDiffFAQRevisions(ctx context.Context, query DiffFAQRevisionsQuery) (*dtos.QueryResult, error) {
+	from, err := h.revisions.FindByNumber(ctx, query.FAQID, query.From)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find revision %d: %v", query.From, err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	to, err := h.revisions.FindByNumber(ctx, query.FAQID, query.To)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find revision %d: %v", query.To, err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Diff: &dtos.FAQRevisionDiff{
+			FAQID:   query.FAQID,
+			From:    from.Revision,
+			To:      to.Revision,
+			Changes: from.State().Diff(to.State()),
+		},
+		Success:   true,
+		Message:   "Revisions compared successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"time"
+)
+
+type SearchFAQsQuery struct {
+	Query     string                 `json:"query" validate:"required,min=3"`
+	Limit     int                    `json:"limit" validate:"min=1,max=100"`
+	Offset    int                    `json:"offset" validate:"min=0"`
+	SortBy    string                 `json:"sortBy"`
+	SortOrder string                 `json:"sortOrder" validate:"oneof=asc desc"`
+	Filters   map[string]interface{} `json:"filters"`
+	Where     *models.FilterGroup    `json:"where,omitempty"`
+}
+
+type SearchFAQsQueryHandler struct {
+	faqRepo repositories.FAQRepository
+}
+
+func NewSearchFAQsQueryHandler(repo repositories.FAQRepository) *SearchFAQsQueryHandler {
+	return &SearchFAQsQueryHandler{faqRepo: repo}
+}
+
+// HandleSearchFAQs ищет FAQ по тексту; при равной релевантности применяется сортировка из запроса
+func (h *SearchFAQsQueryHandler) HandleSearchFAQs(ctx context.Context, query SearchFAQsQuery) (*dtos.QueryResult, error) {
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+
+	opts := &models.QueryOptions{
+		Pagination: &models.PaginationParams{
+			Offset: query.Offset,
+			Limit:  query.Limit,
+		},
+		Filters: query.Filters,
+		Where:   query.Where,
+	}
+	if query.SortBy != "" {
+		if query.SortOrder == "" {
+			query.SortOrder = "desc"
+		}
+		opts.SortBy = []models.SortBy{
+			{
+				Field: query.SortBy,
+				Order: models.SortOrder(query.SortOrder),
+			},
+		}
+	}
+
+	results, err := h.faqRepo.Search(ctx, query.Query, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to search FAQs: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		SearchResults: results,
+		Success:       true,
+		Message:       "FAQs found successfully",
+		Timestamp:     time.Now(),
+	}, nil
+}
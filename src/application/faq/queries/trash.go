@@ -0,0 +1,67 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"time"
+)
+
+type GetFAQTrashQuery struct {
+	Limit     int    `json:"limit" validate:"min=1,max=100"`
+	Offset    int    `json:"offset" validate:"min=0"`
+	SortBy    string `json:"sortBy"`
+	SortOrder string `json:"sortOrder" validate:"oneof=asc desc"`
+}
+
+type GetFAQTrashQueryHandler struct {
+	faqRepo repositories.FAQRepository
+}
+
+func NewGetFAQTrashQueryHandler(repo repositories.FAQRepository) *GetFAQTrashQueryHandler {
+	return &GetFAQTrashQueryHandler{faqRepo: repo}
+}
+
+// HandleGetFAQTrash возвращает FAQ в корзине, по умолчанию недавно удаленные первыми
+func (h *GetFAQTrashQueryHandler) HandleGetFAQTrash(ctx context.Context, query GetFAQTrashQuery) (*dtos.QueryResult, error) {
+	if query.Limit == 0 {
+		query.Limit = 10
+	}
+	if query.SortBy == "" {
+		query.SortBy = "deletedAt"
+	}
+	if query.SortOrder == "" {
+		query.SortOrder = "desc"
+	}
+
+	opts := &models.QueryOptions{
+		Pagination: &models.PaginationParams{
+			Offset: query.Offset,
+			Limit:  query.Limit,
+		},
+		SortBy: []models.SortBy{
+			{
+				Field: query.SortBy,
+				Order: models.SortOrder(query.SortOrder),
+			},
+		},
+	}
+
+	paginated, err := h.faqRepo.FindDeleted(ctx, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find deleted FAQs: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Paginated: paginated,
+		Success:   true,
+		Message:   "Deleted FAQs retrieved successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+type ActivateFeatureCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type ActivateFeatureCommandHandler struct {
+	repo                repositories.FeatureRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+func NewActivateFeatureCommandHandler(repo repositories.FeatureRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *ActivateFeatureCommandHandler {
+	return &ActivateFeatureCommandHandler{
+		repo:                repo,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+	}
+}
+
+func (h *ActivateFeatureCommandHandler) HandleActivateFeature(ctx context.Context, cmd ActivateFeatureCommand) (*dtos.CommandResult, error) {
+
+	feature, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to find Feature: %v", err),
+		}, err
+	}
+
+	before := *feature
+
+	feature.Activate()
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, feature); err != nil {
+			return fmt.Errorf("failed to activate Feature: %w", err)
+		}
+		if err := recordChange(ctx, h.auditLog, entities.AuditActionActivated, &before, feature); err != nil {
+			return err
+		}
+
+		return h.notificationService.NotifyFeatureActivated(ctx, feature)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:        feature.ID,
+		Success:   true,
+		Message:   "Feature activated successfully",
+		UpdatedAt: feature.UpdatedAt,
+	}, nil
+}
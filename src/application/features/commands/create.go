@@ -3,30 +3,45 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/events"
-	"tax-priority-api/src/application/faq/dtos"
+	"tax-priority-api/src/application/features/dtos"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
+
+	"github.com/google/uuid"
 )
 
 type CreateFeatureCommand struct {
-	Name string
+	Name        string `json:"name" validate:"required,min=3,max=200"`
+	Description string `json:"description" validate:"max=1000"`
+	Icon        string `json:"icon" validate:"max=100"`
+	SortOrder   int    `json:"sortOrder" validate:"min=0"`
 }
 
 type CreateFeatureCommandHandler struct {
 	repo                repositories.FeatureRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
 	notificationService events.NotificationService
 }
 
-func NewCreateFeatureCommandHandler(repo repositories.FeatureRepository, notificationService events.NotificationService) *CreateFeatureCommandHandler {
+func NewCreateFeatureCommandHandler(repo repositories.FeatureRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *CreateFeatureCommandHandler {
 	return &CreateFeatureCommandHandler{
 		repo:                repo,
+		transactor:          transactor,
+		auditLog:            auditLog,
 		notificationService: notificationService,
 	}
 }
 
 func (h *CreateFeatureCommandHandler) HandleCreateFeature(ctx context.Context, cmd CreateFeatureCommand) (*dtos.CommandResult, error) {
-	feature, err := entities.NewFeature(cmd.Name)
+	feature, err := entities.NewFeature(
+		cmd.Name,
+		cmd.Description,
+		cmd.Icon,
+		cmd.SortOrder,
+	)
 
 	if err != nil {
 		return &dtos.CommandResult{
@@ -35,10 +50,22 @@ func (h *CreateFeatureCommandHandler) HandleCreateFeature(ctx context.Context, c
 		}, err
 	}
 
-	if err = h.repo.Create(ctx, feature); err != nil {
+	feature.SetID(uuid.New().String())
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Create(ctx, feature); err != nil {
+			return fmt.Errorf("failed to create Feature: %w", err)
+		}
+		if err := recordChange(ctx, h.auditLog, entities.AuditActionCreated, nil, feature); err != nil {
+			return err
+		}
+
+		return h.notificationService.NotifyFeatureCreated(ctx, feature)
+	})
+	if err != nil {
 		return &dtos.CommandResult{
 			Success: false,
-			Error:   fmt.Sprintf("failed to create Feature: %v", err),
+			Error:   err.Error(),
 		}, err
 	}
 
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+type DeactivateFeatureCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type DeactivateFeatureCommandHandler struct {
+	repo                repositories.FeatureRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+func NewDeactivateFeatureCommandHandler(repo repositories.FeatureRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *DeactivateFeatureCommandHandler {
+	return &DeactivateFeatureCommandHandler{
+		repo:                repo,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+	}
+}
+
+func (h *DeactivateFeatureCommandHandler) HandleDeactivateFeature(ctx context.Context, cmd DeactivateFeatureCommand) (*dtos.CommandResult, error) {
+
+	feature, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to find Feature: %v", err),
+		}, err
+	}
+
+	before := *feature
+
+	feature.Deactivate()
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, feature); err != nil {
+			return fmt.Errorf("failed to deactivate Feature: %w", err)
+		}
+		if err := recordChange(ctx, h.auditLog, entities.AuditActionDeactivated, &before, feature); err != nil {
+			return err
+		}
+
+		return h.notificationService.NotifyFeatureDeactivated(ctx, feature)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:        feature.ID,
+		Success:   true,
+		Message:   "Feature deactivated successfully",
+		UpdatedAt: feature.UpdatedAt,
+	}, nil
+}
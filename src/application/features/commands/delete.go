@@ -1 +1,68 @@
 package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+type DeleteFeatureCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type DeleteFeatureCommandHandler struct {
+	repo                repositories.FeatureRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+func NewDeleteFeatureCommandHandler(repo repositories.FeatureRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *DeleteFeatureCommandHandler {
+	return &DeleteFeatureCommandHandler{
+		repo:                repo,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+	}
+}
+
+func (h *DeleteFeatureCommandHandler) HandleDeleteFeature(ctx context.Context, cmd DeleteFeatureCommand) (*dtos.CommandResult, error) {
+
+	// Загружаем Feature, чтобы сохранить ее последнее состояние в журнале аудита
+	feature, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   "Feature not found",
+		}, fmt.Errorf("Feature with ID %s not found: %w", cmd.ID, err)
+	}
+
+	// Feature перемещается в корзину и может быть восстановлена до очистки
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.SoftDelete(ctx, cmd.ID); err != nil {
+			return fmt.Errorf("failed to delete Feature: %w", err)
+		}
+		if err := recordChange(ctx, h.auditLog, entities.AuditActionDeleted, feature, nil); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление об удалении Feature
+		return h.notificationService.NotifyFeatureDeleted(ctx, cmd.ID)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:      cmd.ID,
+		Success: true,
+		Message: "Feature deleted successfully",
+	}, nil
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+type ReorderFeaturesCommand struct {
+	// IDs Feature в желаемом порядке; не перечисленные Feature идут следом
+	IDs []string `json:"ids" validate:"required,min=1,dive,required"`
+}
+
+// featureOrder снимок порядка Feature для журнала аудита
+type featureOrder struct {
+	IDs []string `json:"ids"`
+}
+
+type ReorderFeaturesCommandHandler struct {
+	repo                repositories.FeatureRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+func NewReorderFeaturesCommandHandler(repo repositories.FeatureRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *ReorderFeaturesCommandHandler {
+	return &ReorderFeaturesCommandHandler{
+		repo:                repo,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+	}
+}
+
+func (h *ReorderFeaturesCommandHandler) HandleReorderFeatures(ctx context.Context, cmd ReorderFeaturesCommand) (*dtos.CommandResult, error) {
+
+	err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Reorder(ctx, cmd.IDs); err != nil {
+			return fmt.Errorf("failed to reorder Features: %w", err)
+		}
+		// Перестановка затрагивает несколько Feature и записывается одной записью без ID сущности
+		if err := h.auditLog.Record(ctx, entities.AuditActionReordered, entities.AuditEntityFeature, "", nil, featureOrder{IDs: cmd.IDs}); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление о новом порядке Feature
+		return h.notificationService.NotifyFeaturesReordered(ctx, cmd.IDs)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		Success: true,
+		Message: "Features reordered successfully",
+	}, nil
+}
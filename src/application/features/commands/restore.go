@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+type RestoreFeatureCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// RestoreFeatureCommandHandler возвращает Feature из корзины
+type RestoreFeatureCommandHandler struct {
+	repo                repositories.FeatureRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+func NewRestoreFeatureCommandHandler(repo repositories.FeatureRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *RestoreFeatureCommandHandler {
+	return &RestoreFeatureCommandHandler{
+		repo:                repo,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+	}
+}
+
+func (h *RestoreFeatureCommandHandler) HandleRestoreFeature(ctx context.Context, cmd RestoreFeatureCommand) (*dtos.CommandResult, error) {
+
+	var feature *entities.Feature
+	err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Restore(ctx, cmd.ID); err != nil {
+			return fmt.Errorf("failed to restore Feature: %w", err)
+		}
+
+		restored, err := h.repo.FindByID(ctx, cmd.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find Feature: %w", err)
+		}
+		feature = restored
+
+		if err := recordChange(ctx, h.auditLog, entities.AuditActionRestored, nil, feature); err != nil {
+			return err
+		}
+
+		// Для клиентов восстановленная Feature появляется заново
+		return h.notificationService.NotifyFeatureCreated(ctx, feature)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:        feature.ID,
+		Success:   true,
+		Message:   "Feature restored successfully",
+		UpdatedAt: feature.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+)
+
+type UpdateFeatureCommand struct {
+	ID          string `json:"id" validate:"required"`
+	Name        string `json:"name" validate:"required,min=3,max=200"`
+	Description string `json:"description" validate:"max=1000"`
+	Icon        string `json:"icon" validate:"max=100"`
+	SortOrder   int    `json:"sortOrder" validate:"min=0"`
+}
+
+type UpdateFeatureCommandHandler struct {
+	repo                repositories.FeatureRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+func NewUpdateFeatureCommandHandler(repo repositories.FeatureRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *UpdateFeatureCommandHandler {
+	return &UpdateFeatureCommandHandler{
+		repo:                repo,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+	}
+}
+
+func (h *UpdateFeatureCommandHandler) HandleUpdateFeature(ctx context.Context, cmd UpdateFeatureCommand) (*dtos.CommandResult, error) {
+
+	feature, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to find Feature: %v", err),
+		}, err
+	}
+
+	before := *feature
+
+	if err := feature.Update(cmd.Name, cmd.Description, cmd.Icon); err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to update Feature: %v", err),
+		}, err
+	}
+
+	if err := feature.SetSortOrder(cmd.SortOrder); err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   fmt.Sprintf("failed to set sort order: %v", err),
+		}, err
+	}
+
+	// Сохраняем изменения
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.repo.Update(ctx, feature); err != nil {
+			return fmt.Errorf("failed to update Feature: %w", err)
+		}
+		if err := recordChange(ctx, h.auditLog, entities.AuditActionUpdated, &before, feature); err != nil {
+			return err
+		}
+
+		// Отправляем уведомление об обновлении Feature
+		return h.notificationService.NotifyFeatureUpdated(ctx, feature)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success: false,
+			Error:   err.Error(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		ID:        feature.ID,
+		Success:   true,
+		Message:   "Feature updated successfully",
+		UpdatedAt: feature.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,12 @@
+package dtos
+
+import "time"
+
+type CommandResult struct {
+	ID        string    `json:"id,omitempty"`
+	Success   bool      `json:"success"`
+	Message   string    `json:"message,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+}
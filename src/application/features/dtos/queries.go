@@ -0,0 +1,73 @@
+package dtos
+
+import (
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type QueryResult struct {
+	Feature   *entities.Feature                          `json:"feature,omitempty"`
+	Paginated *models.PaginatedResult[*entities.Feature] `json:"paginated,omitempty"`
+	Success   bool                                       `json:"success"`
+	Message   string                                     `json:"message,omitempty"`
+	Error     string                                     `json:"error,omitempty"`
+	Timestamp time.Time                                  `json:"timestamp"`
+}
+
+type FeatureResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Icon        string    `json:"icon"`
+	SortOrder   int       `json:"sortOrder"`
+	IsActive    bool      `json:"isActive"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	// DeletedAt заполняется только для Feature в корзине
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+}
+
+type PaginatedFeatureResponse struct {
+	Items      []FeatureResponse `json:"items"`
+	Total      int64             `json:"total"`
+	Offset     int               `json:"offset"`
+	Limit      int               `json:"limit"`
+	HasNext    bool              `json:"hasNext"`
+	HasPrev    bool              `json:"hasPrev"`
+	TotalPages int               `json:"totalPages"`
+}
+
+func ToFeatureResponse(feature *entities.Feature) FeatureResponse {
+	return FeatureResponse{
+		ID:          feature.ID,
+		Name:        feature.Name,
+		Description: feature.Description,
+		Icon:        feature.Icon,
+		SortOrder:   feature.SortOrder,
+		IsActive:    feature.IsActive,
+		CreatedAt:   feature.CreatedAt,
+		UpdatedAt:   feature.UpdatedAt,
+		DeletedAt:   feature.DeletedAt,
+	}
+}
+
+func ToFeatureResponses(features []*entities.Feature) []FeatureResponse {
+	responses := make([]FeatureResponse, len(features))
+	for i, feature := range features {
+		responses[i] = ToFeatureResponse(feature)
+	}
+	return responses
+}
+
+func ToPaginatedFeatureResponse(paginated *models.PaginatedResult[*entities.Feature]) PaginatedFeatureResponse {
+	return PaginatedFeatureResponse{
+		Items:      ToFeatureResponses(paginated.Items),
+		Total:      paginated.Total,
+		Offset:     paginated.Offset,
+		Limit:      paginated.Limit,
+		HasNext:    paginated.HasNext,
+		HasPrev:    paginated.HasPrev,
+		TotalPages: paginated.TotalPages,
+	}
+}
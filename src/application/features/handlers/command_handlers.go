@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/features/commands"
+	"tax-priority-api/src/application/repositories"
+)
+
+type FeatureCommandHandlers struct {
+	Activate   *commands.ActivateFeatureCommandHandler
+	Deactivate *commands.DeactivateFeatureCommandHandler
+	Delete     *commands.DeleteFeatureCommandHandler
+	Create     *commands.CreateFeatureCommandHandler
+	Update     *commands.UpdateFeatureCommandHandler
+	Reorder    *commands.ReorderFeaturesCommandHandler
+	Restore    *commands.RestoreFeatureCommandHandler
+}
+
+func NewFeatureCommandHandlers(
+	repo repositories.CachedFeatureRepository,
+	transactor repositories.Transactor,
+	auditLog *audit.Recorder,
+	notificationService events.NotificationService,
+) *FeatureCommandHandlers {
+	return &FeatureCommandHandlers{
+		Activate:   commands.NewActivateFeatureCommandHandler(repo, transactor, auditLog, notificationService),
+		Deactivate: commands.NewDeactivateFeatureCommandHandler(repo, transactor, auditLog, notificationService),
+		Delete:     commands.NewDeleteFeatureCommandHandler(repo, transactor, auditLog, notificationService),
+		Create:     commands.NewCreateFeatureCommandHandler(repo, transactor, auditLog, notificationService),
+		Update:     commands.NewUpdateFeatureCommandHandler(repo, transactor, auditLog, notificationService),
+		Reorder:    commands.NewReorderFeaturesCommandHandler(repo, transactor, auditLog, notificationService),
+		Restore:    commands.NewRestoreFeatureCommandHandler(repo, transactor, auditLog, notificationService),
+	}
+}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"tax-priority-api/src/application/features/queries"
+	"tax-priority-api/src/application/repositories"
+)
+
+type FeatureQueryHandlers struct {
+	GetByID *queries.GetFeatureByIDQueryHandler
+	GetMany *queries.GetFeaturesQueryHandler
+}
+
+func NewFeatureQueryHandlers(repo repositories.CachedFeatureRepository) *FeatureQueryHandlers {
+	return &FeatureQueryHandlers{
+		GetByID: queries.NewGetFeatureByIDQueryHandler(repo),
+		GetMany: queries.NewGetFeaturesQueryHandler(repo),
+	}
+}
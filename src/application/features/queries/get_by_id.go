@@ -0,0 +1,39 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/repositories"
+	"time"
+)
+
+type GetFeatureByIDQuery struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type GetFeatureByIDQueryHandler struct {
+	featureRepo repositories.FeatureRepository
+}
+
+func NewGetFeatureByIDQueryHandler(repo repositories.FeatureRepository) *GetFeatureByIDQueryHandler {
+	return &GetFeatureByIDQueryHandler{featureRepo: repo}
+}
+
+func (h *GetFeatureByIDQueryHandler) HandleGetFeatureByID(ctx context.Context, query GetFeatureByIDQuery) (*dtos.QueryResult, error) {
+	feature, err := h.featureRepo.FindByID(ctx, query.ID)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find Feature: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Feature:   feature,
+		Success:   true,
+		Message:   "Feature retrieved successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
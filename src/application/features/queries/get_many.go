@@ -0,0 +1,76 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"time"
+)
+
+type GetFeaturesQuery struct {
+	Limit     int                    `json:"limit" validate:"min=1,max=100"`
+	Offset    int                    `json:"offset" validate:"min=0"`
+	SortBy    string                 `json:"sortBy"`
+	SortOrder string                 `json:"sortOrder" validate:"oneof=asc desc"`
+	Filters   map[string]interface{} `json:"filters"`
+	Where     *models.FilterGroup    `json:"where,omitempty"`
+}
+
+type GetFeaturesQueryHandler struct {
+	featureRepo repositories.FeatureRepository
+}
+
+func NewGetFeaturesQueryHandler(repo repositories.FeatureRepository) *GetFeaturesQueryHandler {
+	return &GetFeaturesQueryHandler{featureRepo: repo}
+}
+
+// HandleGetFeatures возвращает Feature, по умолчанию в порядке отображения на сайте
+func (h *GetFeaturesQueryHandler) HandleGetFeatures(ctx context.Context, query GetFeaturesQuery) (*dtos.QueryResult, error) {
+	if query.Limit == 0 {
+		query.Limit = 50
+	}
+	if query.SortBy == "" {
+		query.SortBy = "sortOrder"
+	}
+	if query.SortOrder == "" {
+		query.SortOrder = "asc"
+	}
+
+	opts := &models.QueryOptions{
+		Pagination: &models.PaginationParams{
+			Offset: query.Offset,
+			Limit:  query.Limit,
+		},
+		SortBy: []models.SortBy{
+			{
+				Field: query.SortBy,
+				Order: models.SortOrder(query.SortOrder),
+			},
+			// Стабильный порядок для Feature с одинаковой позицией
+			{
+				Field: "createdAt",
+				Order: models.SortOrder("asc"),
+			},
+		},
+		Filters: query.Filters,
+		Where:   query.Where,
+	}
+
+	paginated, err := h.featureRepo.FindWithPagination(ctx, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find Features: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Paginated: paginated,
+		Success:   true,
+		Message:   "Features retrieved successfully",
+		Timestamp: time.Now(),
+	}, nil
+}
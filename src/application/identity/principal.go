@@ -0,0 +1,48 @@
+package identity
+
+import (
+	"context"
+	"slices"
+)
+
+// principalKey ключ context.Context, под которым хранится аутентифицированный клиент запроса
+type principalKey struct{}
+
+// Principal аутентифицированный клиент запроса: пользователь с токеном или API ключ
+type Principal struct {
+	Subject  string
+	Username string
+	Roles    []string
+}
+
+// Name возвращает имя для полей автора (CreatedBy, UpdatedBy, ApprovedBy): username, а без него subject
+func (p *Principal) Name() string {
+	if p.Username != "" {
+		return p.Username
+	}
+	return p.Subject
+}
+
+// HasRole проверяет наличие роли
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// WithPrincipal возвращает контекст с клиентом запроса; заполняется только middleware аутентификации
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext возвращает клиента запроса или nil для анонимного запроса и фоновых задач
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
+
+// Actor возвращает имя клиента запроса для полей автора или пустую строку для анонимного запроса
+func Actor(ctx context.Context) string {
+	if principal := PrincipalFromContext(ctx); principal != nil {
+		return principal.Name()
+	}
+	return ""
+}
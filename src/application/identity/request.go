@@ -0,0 +1,24 @@
+package identity
+
+import "context"
+
+// requestInfoKey ключ context.Context, под которым хранятся сведения о HTTP запросе
+type requestInfoKey struct{}
+
+// RequestInfo сведения о HTTP запросе для журнала аудита
+type RequestInfo struct {
+	// ID идентификатор запроса из заголовка X-Request-ID или сгенерированный сервером
+	ID       string
+	ClientIP string
+}
+
+// WithRequestInfo возвращает контекст со сведениями о запросе
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFromContext возвращает сведения о запросе; у фоновых задач они пустые
+func RequestInfoFromContext(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
@@ -0,0 +1,21 @@
+package mail
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoRecipients письмо без получателей
+var ErrNoRecipients = errors.New("message has no recipients")
+
+// Message текстовое письмо
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Mailer отправляет письма; реализация выбирается переменной MAILER
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
@@ -0,0 +1,76 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+)
+
+// Имена шаблонов писем
+const (
+	TemplateTestimonialVerification = "testimonial_verification"
+	TemplateTestimonialSubmitted    = "testimonial_submitted"
+	TemplateTestimonialApproved     = "testimonial_approved"
+	TemplateTestimonialRejected     = "testimonial_rejected"
+)
+
+//go:embed templates/*.tmpl
+var embeddedTemplates embed.FS
+
+// Templates шаблоны писем. Каждый файл templates/<имя>.tmpl определяет шаблоны subject и body.
+type Templates struct {
+	templates map[string]*template.Template
+}
+
+// NewTemplates загружает встроенные шаблоны писем
+func NewTemplates() (*Templates, error) {
+	return LoadTemplates(embeddedTemplates, "templates")
+}
+
+// LoadTemplates загружает шаблоны *.tmpl из каталога dir
+func LoadTemplates(fsys fs.FS, dir string) (*Templates, error) {
+	paths, err := fs.Glob(fsys, dir+"/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	templates := &Templates{templates: make(map[string]*template.Template, len(paths))}
+	for _, file := range paths {
+		name := strings.TrimSuffix(path.Base(file), ".tmpl")
+		parsed, err := template.New(name).Option("missingkey=error").ParseFS(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mail template %s: %w", name, err)
+		}
+		if parsed.Lookup("subject") == nil || parsed.Lookup("body") == nil {
+			return nil, fmt.Errorf("mail template %s must define subject and body", name)
+		}
+		templates.templates[name] = parsed
+	}
+	return templates, nil
+}
+
+// Render - заполняет шаблон name данными data и возвращает письмо без получателей
+func (t *Templates) Render(name string, data any) (Message, error) {
+	tmpl, ok := t.templates[name]
+	if !ok {
+		return Message{}, fmt.Errorf("unknown mail template %s", name)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s body: %w", name, err)
+	}
+
+	return Message{
+		// Тема - одна строка: перевод строки в заголовке письма недопустим
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Body:    body.String(),
+	}, nil
+}
@@ -1,7 +1,44 @@
 package models
 
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxBatchSize наибольшее число ID в одной пакетной команде
+const MaxBatchSize = 100
+
+var (
+	// ErrBatchIncomplete атомарная пакетная операция затронула не все записи и была отменена целиком
+	ErrBatchIncomplete = errors.New("batch operation incomplete")
+	// ErrBatchEmpty в пакете нет ни одного ID
+	ErrBatchEmpty = errors.New("batch is empty")
+	// ErrBatchTooLarge в пакете больше MaxBatchSize ID
+	ErrBatchTooLarge = errors.New("batch too large")
+)
+
+// CheckBatchSize проверяет, что пакет из size ID не пуст и не превышает MaxBatchSize
+func CheckBatchSize(size int) error {
+	if size == 0 {
+		return ErrBatchEmpty
+	}
+	if size > MaxBatchSize {
+		return fmt.Errorf("%w: %d ids, at most %d allowed", ErrBatchTooLarge, size, MaxBatchSize)
+	}
+	return nil
+}
+
 type BulkOperationResult struct {
 	SuccessCount int     `json:"successCount"`
 	FailureCount int     `json:"failureCount"`
 	Errors       []error `json:"errors,omitempty"`
 }
+
+// RequireComplete возвращает ErrBatchIncomplete, если хотя бы одна запись пакета не обработана.
+// Внутри транзакции эта ошибка откатывает весь пакет.
+func (r *BulkOperationResult) RequireComplete() error {
+	if r.FailureCount == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d of %d failed", ErrBatchIncomplete, r.FailureCount, r.SuccessCount+r.FailureCount)
+}
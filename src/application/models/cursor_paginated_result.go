@@ -0,0 +1,11 @@
+package models
+
+// CursorPaginatedResult результат keyset-пагинации: вместо смещения возвращаются курсоры соседних страниц
+type CursorPaginatedResult[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+	HasNext    bool   `json:"hasNext"`
+	HasPrev    bool   `json:"hasPrev"`
+	Limit      int    `json:"limit"`
+}
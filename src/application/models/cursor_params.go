@@ -0,0 +1,7 @@
+package models
+
+// CursorParams параметры keyset-пагинации; пустой Cursor означает первую страницу
+type CursorParams struct {
+	Cursor string
+	Limit  int
+}
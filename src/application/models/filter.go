@@ -0,0 +1,85 @@
+package models
+
+// FilterOperator оператор сравнения в условии фильтра
+type FilterOperator string
+
+const (
+	FilterEq      FilterOperator = "eq"
+	FilterNe      FilterOperator = "ne"
+	FilterGt      FilterOperator = "gt"
+	FilterGte     FilterOperator = "gte"
+	FilterLt      FilterOperator = "lt"
+	FilterLte     FilterOperator = "lte"
+	FilterIn      FilterOperator = "in"
+	FilterNotIn   FilterOperator = "nin"
+	FilterLike    FilterOperator = "like"
+	FilterILike   FilterOperator = "ilike"
+	FilterBetween FilterOperator = "between"
+	FilterIsNull  FilterOperator = "null"
+)
+
+// IsValid проверяет, что оператор поддерживается
+func (o FilterOperator) IsValid() bool {
+	switch o {
+	case FilterEq, FilterNe, FilterGt, FilterGte, FilterLt, FilterLte,
+		FilterIn, FilterNotIn, FilterLike, FilterILike, FilterBetween, FilterIsNull:
+		return true
+	}
+	return false
+}
+
+// FilterLogic способ объединения условий группы
+type FilterLogic string
+
+const (
+	FilterAnd FilterLogic = "and"
+	FilterOr  FilterLogic = "or"
+)
+
+// FilterCondition условие вида "поле оператор значение"
+// Для in/nin значение - срез, для between - срез из двух элементов, для null - bool
+type FilterCondition struct {
+	Field    string         `json:"field"`
+	Operator FilterOperator `json:"operator"`
+	Value    any            `json:"value"`
+}
+
+// FilterGroup группа условий и вложенных групп, объединенных через AND или OR
+type FilterGroup struct {
+	Logic      FilterLogic       `json:"logic"`
+	Conditions []FilterCondition `json:"conditions,omitempty"`
+	Groups     []*FilterGroup    `json:"groups,omitempty"`
+}
+
+// NewFilterGroup создает пустую группу условий
+func NewFilterGroup(logic FilterLogic) *FilterGroup {
+	return &FilterGroup{Logic: logic}
+}
+
+// Add добавляет условие в группу
+func (g *FilterGroup) Add(field string, op FilterOperator, value any) *FilterGroup {
+	g.Conditions = append(g.Conditions, FilterCondition{Field: field, Operator: op, Value: value})
+	return g
+}
+
+// AddGroup добавляет вложенную группу
+func (g *FilterGroup) AddGroup(group *FilterGroup) *FilterGroup {
+	g.Groups = append(g.Groups, group)
+	return g
+}
+
+// IsEmpty возвращает true, если в группе нет ни одного условия
+func (g *FilterGroup) IsEmpty() bool {
+	if g == nil {
+		return true
+	}
+	if len(g.Conditions) > 0 {
+		return false
+	}
+	for _, group := range g.Groups {
+		if !group.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
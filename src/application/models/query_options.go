@@ -2,9 +2,12 @@ package models
 
 type QueryOptions struct {
 	Pagination *PaginationParams
+	Cursor     *CursorParams
 	SortBy     []SortBy
 	Filters    map[string]any
-	Includes   []string
+	// Where условия с операторами и группами AND/OR, применяются вместе с Filters
+	Where    *FilterGroup
+	Includes []string
 }
 
 func NewQueryOptions() *QueryOptions {
@@ -18,6 +21,11 @@ func (qo *QueryOptions) WithPagination(offset, limit int) *QueryOptions {
 	return qo
 }
 
+func (qo *QueryOptions) WithCursor(cursor string, limit int) *QueryOptions {
+	qo.Cursor = &CursorParams{Cursor: cursor, Limit: limit}
+	return qo
+}
+
 func (qo *QueryOptions) WithSort(field string, order SortOrder) *QueryOptions {
 	qo.SortBy = append(qo.SortBy, SortBy{Field: field, Order: order})
 	return qo
@@ -28,6 +36,11 @@ func (qo *QueryOptions) WithFilter(key string, value any) *QueryOptions {
 	return qo
 }
 
+func (qo *QueryOptions) WithWhere(group *FilterGroup) *QueryOptions {
+	qo.Where = group
+	return qo
+}
+
 func (qo *QueryOptions) WithIncludes(includes ...string) *QueryOptions {
 	qo.Includes = append(qo.Includes, includes...)
 	return qo
@@ -0,0 +1,8 @@
+package models
+
+// SearchHit результат полнотекстового поиска с оценкой релевантности
+type SearchHit[T any] struct {
+	Item       T                 `json:"item"`
+	Rank       float64           `json:"rank"`
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
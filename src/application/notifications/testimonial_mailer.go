@@ -0,0 +1,108 @@
+package notifications
+
+import (
+	"context"
+
+	"tax-priority-api/src/application/mail"
+	"tax-priority-api/src/domain/entities"
+)
+
+// rejectionReasonText причины отклонения в письме автору
+var rejectionReasonText = map[entities.RejectionReason]string{
+	entities.RejectionReasonSpam:         "отзыв похож на рекламу или спам",
+	entities.RejectionReasonOffensive:    "отзыв содержит оскорбления",
+	entities.RejectionReasonOffTopic:     "отзыв не относится к нашим услугам",
+	entities.RejectionReasonDuplicate:    "такой отзыв уже был отправлен",
+	entities.RejectionReasonPersonalData: "отзыв содержит персональные данные",
+	entities.RejectionReasonLowQuality:   "отзыв слишком короткий или неинформативный",
+	entities.RejectionReasonOther:        "отзыв не соответствует правилам публикации",
+}
+
+// TestimonialMailer письма о модерации отзывов: модераторам - о новом отзыве, автору - о решении.
+// Автору пишем только на подтвержденный email, чтобы не отправлять письма по чужому адресу.
+type TestimonialMailer struct {
+	mailer     mail.Mailer
+	templates  *mail.Templates
+	moderators []string
+	// moderationURL ссылка на очередь модерации в письме модераторам; пустая - без ссылки
+	moderationURL string
+}
+
+func NewTestimonialMailer(mailer mail.Mailer, templates *mail.Templates, moderators []string, moderationURL string) *TestimonialMailer {
+	return &TestimonialMailer{
+		mailer:        mailer,
+		templates:     templates,
+		moderators:    moderators,
+		moderationURL: moderationURL,
+	}
+}
+
+// NotifySubmitted - письмо модераторам о новом отзыве; без адресов модераторов ничего не отправляется
+func (m *TestimonialMailer) NotifySubmitted(ctx context.Context, testimonial *entities.Testimonial) error {
+	if len(m.moderators) == 0 {
+		return nil
+	}
+
+	message, err := m.templates.Render(mail.TemplateTestimonialSubmitted, struct {
+		ID            string
+		Author        string
+		Company       string
+		Position      string
+		Rating        int
+		Content       string
+		EmailVerified bool
+		ModerationURL string
+	}{
+		ID:            testimonial.ID,
+		Author:        testimonial.Author,
+		Company:       testimonial.Company,
+		Position:      testimonial.Position,
+		Rating:        testimonial.Rating,
+		Content:       testimonial.Content,
+		EmailVerified: testimonial.IsEmailVerified(),
+		ModerationURL: m.moderationURL,
+	})
+	if err != nil {
+		return err
+	}
+	message.To = m.moderators
+	return m.mailer.Send(ctx, message)
+}
+
+// NotifyApproved - письмо автору об одобрении отзыва
+func (m *TestimonialMailer) NotifyApproved(ctx context.Context, testimonial *entities.Testimonial) error {
+	return m.notifyAuthor(ctx, testimonial, mail.TemplateTestimonialApproved, struct {
+		Author string
+	}{
+		Author: testimonial.Author,
+	})
+}
+
+// NotifyRejected - письмо автору об отклонении отзыва с причиной; заметки модератора в письмо не попадают
+func (m *TestimonialMailer) NotifyRejected(ctx context.Context, testimonial *entities.Testimonial) error {
+	reason, ok := rejectionReasonText[testimonial.RejectionReason]
+	if !ok {
+		reason = rejectionReasonText[entities.RejectionReasonOther]
+	}
+
+	return m.notifyAuthor(ctx, testimonial, mail.TemplateTestimonialRejected, struct {
+		Author string
+		Reason string
+	}{
+		Author: testimonial.Author,
+		Reason: reason,
+	})
+}
+
+func (m *TestimonialMailer) notifyAuthor(ctx context.Context, testimonial *entities.Testimonial, template string, data any) error {
+	if !testimonial.IsEmailVerified() {
+		return nil
+	}
+
+	message, err := m.templates.Render(template, data)
+	if err != nil {
+		return err
+	}
+	message.To = []string{testimonial.AuthorEmail}
+	return m.mailer.Send(ctx, message)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// APIKeyRepository определяет интерфейс для хранения API ключей
+type APIKeyRepository interface {
+	GenericRepository[*entities.APIKey, string]
+	// FindByPrefix возвращает ключ по открытой части или nil, если такого ключа нет
+	FindByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error)
+	// TouchLastUsed обновляет время последнего использования, если сохраненное раньше staleBefore;
+	// так аутентификация не пишет в базу на каждом запросе
+	TouchLastUsed(ctx context.Context, id string, usedAt, staleBefore time.Time) error
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/domain/entities"
+)
+
+// AuditRepository определяет интерфейс журнала аудита. Журнал только дополняется,
+// поэтому интерфейс не содержит изменения и удаления записей.
+type AuditRepository interface {
+	// Append добавляет запись; в транзакции ctx запись сохраняется вместе с изменением
+	Append(ctx context.Context, record *entities.AuditRecord) error
+	// FindWithPagination возвращает страницу записей по фильтрам
+	FindWithPagination(ctx context.Context, opts *models.QueryOptions) (*models.PaginatedResult[*entities.AuditRecord], error)
+	// FindAll возвращает записи по фильтрам без подсчета общего количества
+	FindAll(ctx context.Context, opts *models.QueryOptions) ([]*entities.AuditRecord, error)
+}
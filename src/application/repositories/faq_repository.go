@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"tax-priority-api/src/application/models"
 	"tax-priority-api/src/domain/entities"
 )
 
@@ -11,4 +12,7 @@ type FAQRepository interface {
 	// GetCategories возвращает список категорий FAQ
 	// Если withCounts = true, также возвращает количество FAQ в каждой категории
 	GetCategories(ctx context.Context, withCounts bool) ([]string, map[string]int64, error)
+	// Search выполняет полнотекстовый поиск по вопросу, ответу и категории
+	// Результаты отсортированы по релевантности, фильтры и пагинация берутся из opts
+	Search(ctx context.Context, text string, opts *models.QueryOptions) (*models.PaginatedResult[models.SearchHit[*entities.FAQ]], error)
 }
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/domain/entities"
+)
+
+// FAQRevisionRepository определяет интерфейс для хранения истории изменений FAQ
+type FAQRevisionRepository interface {
+	GenericRepository[*entities.FAQRevision, string]
+	// Append сохраняет ревизию, присваивая ей следующий номер в истории FAQ
+	Append(ctx context.Context, revision *entities.FAQRevision) error
+	// FindByFAQ возвращает историю изменений FAQ, новые ревизии первыми
+	FindByFAQ(ctx context.Context, faqID string, opts *models.QueryOptions) (*models.PaginatedResult[*entities.FAQRevision], error)
+	// FindByNumber возвращает ревизию FAQ по номеру
+	FindByNumber(ctx context.Context, faqID string, revision int) (*entities.FAQRevision, error)
+}
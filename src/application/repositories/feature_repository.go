@@ -1,8 +1,14 @@
 package repositories
 
-import "tax-priority-api/src/domain/entities"
+import (
+	"context"
+	"tax-priority-api/src/domain/entities"
+)
 
 // FeatureRepository определяет интерфейс для работы с Feature
 type FeatureRepository interface {
 	GenericRepository[*entities.Feature, string]
+	// Reorder выставляет порядок Feature: переданные ids получают позиции 0..len(ids)-1,
+	// остальные Feature сохраняют взаимный порядок и идут следом
+	Reorder(ctx context.Context, ids []string) error
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"tax-priority-api/src/application/models"
 	"tax-priority-api/src/domain/entities"
+	"time"
 )
 
 type TransactionFunc func(ctx context.Context) error
@@ -21,19 +22,28 @@ type GenericRepository[T entities.Entity[ID], ID comparable] interface {
 	// FindByIDs - поиск сущностей по ID
 	FindByIDs(ctx context.Context, ids []ID) ([]T, error)
 
-	// Update - обновление сущности
+	// Update - обновление сущности; для entities.Versioned выполняется только при неизменной версии
+	// и увеличивает ее, иначе возвращает ошибку с entities.ErrVersionConflict
 	Update(ctx context.Context, entity T) error
-	// UpdateBatch - обновление пачки сущностей
+	// UpdateBatch - обновление пачки сущностей; для entities.Versioned каждая запись обновляется условно,
+	// как в Update, и первый конфликт версий возвращается ошибкой с entities.ErrVersionConflict
 	UpdateBatch(ctx context.Context, entities []T) (*models.BulkOperationResult, error)
-	// UpdateFields - обновление полей сущности
+	// UpdateFields - обновление полей сущности; для entities.Versioned увеличивает версию,
+	// а ключ "version" в fields задает ожидаемую версию, как в Update
 	UpdateFields(ctx context.Context, id ID, fields map[string]interface{}) error
 
-	// Delete - удаление сущности
+	// Delete - удаление сущности; для моделей с DeletedAt - перемещение в корзину
 	Delete(ctx context.Context, id ID) error
 	// DeleteBatch - удаление пачки сущностей
 	DeleteBatch(ctx context.Context, ids []ID) (*models.BulkOperationResult, error)
-	// SoftDelete - мягкое удаление сущности
+	// SoftDelete - мягкое удаление сущности (перемещение в корзину)
 	SoftDelete(ctx context.Context, id ID) error
+	// Restore - восстановление сущности из корзины
+	Restore(ctx context.Context, id ID) error
+	// Purge - окончательное удаление сущностей, помещенных в корзину раньше deletedBefore
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// FindDeletedBefore - сущности, которые удалит Purge с тем же deletedBefore
+	FindDeletedBefore(ctx context.Context, deletedBefore time.Time) ([]T, error)
 
 	// Расширенные операции поиска
 
@@ -43,6 +53,10 @@ type GenericRepository[T entities.Entity[ID], ID comparable] interface {
 	FindOne(ctx context.Context, opts *models.QueryOptions) (T, error)
 	// FindWithPagination - поиск с пагинацией
 	FindWithPagination(ctx context.Context, opts *models.QueryOptions) (*models.PaginatedResult[T], error)
+	// FindWithCursor - поиск с keyset-пагинацией по подписанному курсору (opts.Cursor)
+	FindWithCursor(ctx context.Context, opts *models.QueryOptions) (*models.CursorPaginatedResult[T], error)
+	// FindDeleted - поиск с пагинацией среди сущностей в корзине
+	FindDeleted(ctx context.Context, opts *models.QueryOptions) (*models.PaginatedResult[T], error)
 
 	// Операции подсчета
 
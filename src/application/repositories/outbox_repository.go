@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// OutboxRepository определяет интерфейс очереди доменных событий (transactional outbox)
+type OutboxRepository interface {
+	// Append сохраняет событие; в транзакции ctx событие сохраняется вместе с изменением сущности
+	Append(ctx context.Context, message *entities.OutboxMessage) error
+	// ClaimPending берет в работу до limit неотправленных событий, доступных на момент now, в порядке создания:
+	// откладывает их до leaseUntil, чтобы другие экземпляры API не брали их, пока идет доставка.
+	// Не требует транзакции; события, которые в этот момент берет другой экземпляр, пропускаются.
+	ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entities.OutboxMessage, error)
+	// MarkDelivered отмечает доставку события получателю sink
+	MarkDelivered(ctx context.Context, id string, sink string) error
+	// MarkPublished отмечает событие отправленным всем получателям
+	MarkPublished(ctx context.Context, id string, publishedAt time.Time) error
+	// MarkFailed сохраняет неудачную попытку отправки и откладывает следующую до availableAt
+	MarkFailed(ctx context.Context, id string, lastError string, availableAt time.Time) error
+	// DeletePublished удаляет события, отправленные раньше publishedBefore
+	DeletePublished(ctx context.Context, publishedBefore time.Time) (int64, error)
+}
@@ -0,0 +1,10 @@
+package repositories
+
+import "context"
+
+// Transactor выполняет функцию в транзакции базы данных. Транзакция передается через ctx функции:
+// все репозитории, вызванные с этим ctx, пишут в нее, и ошибка функции откатывает все изменения.
+type Transactor interface {
+	// WithinTransaction - выполняет fn в транзакции; если ctx уже в транзакции, fn выполняется в ней
+	WithinTransaction(ctx context.Context, fn TransactionFunc) error
+}
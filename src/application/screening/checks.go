@@ -0,0 +1,114 @@
+package screening
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// HoneypotCheck срабатывает, если заполнено скрытое поле формы
+type HoneypotCheck struct {
+	score int
+}
+
+func NewHoneypotCheck(score int) *HoneypotCheck {
+	return &HoneypotCheck{score: score}
+}
+
+func (c *HoneypotCheck) Name() string {
+	return "honeypot"
+}
+
+func (c *HoneypotCheck) Inspect(_ context.Context, submission Submission) (*Signal, error) {
+	if strings.TrimSpace(submission.Honeypot) == "" {
+		return nil, nil
+	}
+	return &Signal{Check: c.Name(), Score: c.score, Reason: "hidden field is filled"}, nil
+}
+
+// BlocklistCheck ищет запрещенные слова и фразы без учета регистра и пунктуации
+type BlocklistCheck struct {
+	// terms нормализованные термины, окруженные пробелами: совпадают только целые слова
+	terms       []string
+	scorePerHit int
+}
+
+func NewBlocklistCheck(terms []string, scorePerHit int) *BlocklistCheck {
+	check := &BlocklistCheck{scorePerHit: scorePerHit}
+	for _, term := range terms {
+		if normalized := normalizeText(term); normalized != "" {
+			check.terms = append(check.terms, " "+normalized+" ")
+		}
+	}
+	return check
+}
+
+func (c *BlocklistCheck) Name() string {
+	return "blocklist"
+}
+
+func (c *BlocklistCheck) Inspect(_ context.Context, submission Submission) (*Signal, error) {
+	if len(c.terms) == 0 {
+		return nil, nil
+	}
+
+	text := " " + normalizeText(submittedText(submission)) + " "
+	var matched []string
+	for _, term := range c.terms {
+		if strings.Contains(text, term) {
+			matched = append(matched, strings.TrimSpace(term))
+		}
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+	return &Signal{
+		Check:  c.Name(),
+		Score:  c.scorePerHit * len(matched),
+		Reason: "blocked terms: " + strings.Join(matched, ", "),
+	}, nil
+}
+
+// linkPattern ссылки с протоколом или начинающиеся с www
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// LinkCheck срабатывает, если ссылок больше maxLinks; оценка растет с каждой лишней ссылкой
+type LinkCheck struct {
+	maxLinks     int
+	scorePerLink int
+}
+
+func NewLinkCheck(maxLinks, scorePerLink int) *LinkCheck {
+	return &LinkCheck{maxLinks: maxLinks, scorePerLink: scorePerLink}
+}
+
+func (c *LinkCheck) Name() string {
+	return "links"
+}
+
+func (c *LinkCheck) Inspect(_ context.Context, submission Submission) (*Signal, error) {
+	count := len(linkPattern.FindAllString(submittedText(submission), -1))
+	if count <= c.maxLinks {
+		return nil, nil
+	}
+	return &Signal{
+		Check:  c.Name(),
+		Score:  c.scorePerLink * (count - c.maxLinks),
+		Reason: fmt.Sprintf("%d links, at most %d expected", count, c.maxLinks),
+	}, nil
+}
+
+// submittedText текстовые поля отправки, видимые на сайте
+func submittedText(submission Submission) string {
+	return strings.Join([]string{submission.Content, submission.Author, submission.Company, submission.Position}, "\n")
+}
+
+// normalizeText приводит текст к словам в нижнем регистре, разделенным одним пробелом
+func normalizeText(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
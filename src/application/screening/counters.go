@@ -0,0 +1,114 @@
+package screening
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DuplicateCheck срабатывает на повтор того же текста в пределах окна, даже от разных авторов
+type DuplicateCheck struct {
+	counter Counter
+	window  time.Duration
+	score   int
+}
+
+func NewDuplicateCheck(counter Counter, window time.Duration, score int) *DuplicateCheck {
+	return &DuplicateCheck{counter: counter, window: window, score: score}
+}
+
+func (c *DuplicateCheck) Name() string {
+	return "duplicate"
+}
+
+func (c *DuplicateCheck) Inspect(ctx context.Context, submission Submission) (*Signal, error) {
+	content := normalizeText(submission.Content)
+	if content == "" {
+		return nil, nil
+	}
+
+	count, err := c.counter.Increment(ctx, counterKey(c.Name(), content), c.window)
+	if err != nil {
+		return nil, err
+	}
+	if count <= 1 {
+		return nil, nil
+	}
+	return &Signal{
+		Check:  c.Name(),
+		Score:  c.score,
+		Reason: fmt.Sprintf("same content submitted %d times within %s", count, c.window),
+	}, nil
+}
+
+// ThrottleCheck срабатывает, если от одного отправителя пришло больше limit отзывов за окно
+type ThrottleCheck struct {
+	name    string
+	counter Counter
+	// sender ключ отправителя; пустой ключ пропускает проверку
+	sender func(Submission) string
+	limit  int
+	window time.Duration
+	score  int
+}
+
+// NewEmailThrottleCheck ограничивает число отправок с одного email
+func NewEmailThrottleCheck(counter Counter, limit int, window time.Duration, score int) *ThrottleCheck {
+	return &ThrottleCheck{
+		name:    "email_throttle",
+		counter: counter,
+		sender: func(submission Submission) string {
+			return strings.ToLower(strings.TrimSpace(submission.AuthorEmail))
+		},
+		limit:  limit,
+		window: window,
+		score:  score,
+	}
+}
+
+// NewIPThrottleCheck ограничивает число отправок с одного IP адреса
+func NewIPThrottleCheck(counter Counter, limit int, window time.Duration, score int) *ThrottleCheck {
+	return &ThrottleCheck{
+		name:    "ip_throttle",
+		counter: counter,
+		sender: func(submission Submission) string {
+			return submission.ClientIP
+		},
+		limit:  limit,
+		window: window,
+		score:  score,
+	}
+}
+
+func (c *ThrottleCheck) Name() string {
+	return c.name
+}
+
+func (c *ThrottleCheck) Inspect(ctx context.Context, submission Submission) (*Signal, error) {
+	sender := c.sender(submission)
+	if sender == "" || c.limit <= 0 {
+		return nil, nil
+	}
+
+	count, err := c.counter.Increment(ctx, counterKey(c.name, sender), c.window)
+	if err != nil {
+		return nil, err
+	}
+	if count <= int64(c.limit) {
+		return nil, nil
+	}
+	return &Signal{
+		Check:  c.name,
+		Score:  c.score,
+		Reason: fmt.Sprintf("%d submissions within %s, limit %d", count, c.window, c.limit),
+	}, nil
+}
+
+// counterKey ключ счетчика проверки; значение хешируется, чтобы не хранить email и текст в Redis
+func counterKey(check, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return "screening:" + check + ":" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,96 @@
+package screening
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Submission публичная отправка отзыва, которую проверяет конвейер
+type Submission struct {
+	Content     string
+	Author      string
+	AuthorEmail string
+	Company     string
+	Position    string
+	// ClientIP адрес отправителя; пустой адрес пропускает ограничение по IP
+	ClientIP string
+	// Honeypot скрытое поле формы: люди его не видят и не заполняют
+	Honeypot string
+}
+
+// Signal сработавшая проверка и ее вклад в оценку спама
+type Signal struct {
+	Check  string `json:"check"`
+	Score  int    `json:"score"`
+	Reason string `json:"reason"`
+}
+
+// String - описание сигнала для модератора
+func (s Signal) String() string {
+	return s.Check + ": " + s.Reason
+}
+
+// Check отдельная проверка конвейера; nil без ошибки - проверка не сработала
+type Check interface {
+	Name() string
+	Inspect(ctx context.Context, submission Submission) (*Signal, error)
+}
+
+// Counter счетчики событий в фиксированном окне, общие для всех реплик
+type Counter interface {
+	// Increment - увеличивает счетчик key и возвращает его значение; окно начинается с первого события
+	Increment(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+// Result итог проверки отправки
+type Result struct {
+	Score   int
+	Signals []Signal
+	// SuspectedSpam оценка достигла порога: отзыв попадает в отдельную очередь модерации
+	SuspectedSpam bool
+}
+
+// Reasons - описания сработавших проверок
+func (r Result) Reasons() []string {
+	reasons := make([]string, 0, len(r.Signals))
+	for _, signal := range r.Signals {
+		reasons = append(reasons, signal.String())
+	}
+	return reasons
+}
+
+// Pipeline последовательно применяет проверки и суммирует их оценки
+type Pipeline struct {
+	checks    []Check
+	threshold int
+}
+
+// NewPipeline создает конвейер; отправка с оценкой не ниже threshold считается подозрительной,
+// нулевой порог только сохраняет оценку
+func NewPipeline(threshold int, checks ...Check) *Pipeline {
+	return &Pipeline{
+		checks:    checks,
+		threshold: threshold,
+	}
+}
+
+// Screen проверяет отправку. Отправка никогда не отклоняется: сбой отдельной проверки
+// (например, недоступный Redis) пишется в лог и не влияет на оценку.
+func (p *Pipeline) Screen(ctx context.Context, submission Submission) Result {
+	var result Result
+	for _, check := range p.checks {
+		signal, err := check.Inspect(ctx, submission)
+		if err != nil {
+			log.Printf("Spam check %s failed, skipping: %v", check.Name(), err)
+			continue
+		}
+		if signal == nil {
+			continue
+		}
+		result.Score += signal.Score
+		result.Signals = append(result.Signals, *signal)
+	}
+	result.SuspectedSpam = p.threshold > 0 && result.Score >= p.threshold
+	return result
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"time"
+)
+
+var (
+	// ErrBlobNotFound объект отсутствует в хранилище
+	ErrBlobNotFound = errors.New("blob not found")
+	// ErrInvalidSignature подпись ссылки неверна или срок ее действия истек
+	ErrInvalidSignature = errors.New("invalid or expired signature")
+)
+
+// BlobInfo метаданные объекта хранилища
+type BlobInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+	ETag        string
+}
+
+// PutOptions параметры сохранения объекта
+type PutOptions struct {
+	ContentType string
+}
+
+// URLOptions заголовки ответа, которые хранилище подставит при скачивании по подписанной ссылке
+type URLOptions struct {
+	ContentType        string
+	ContentDisposition string
+}
+
+// BlobStore хранилище бинарных объектов.
+// Ключи - относительные пути вида testimonials/<uuid>.pdf, они же сохраняются в сущностях.
+type BlobStore interface {
+	// Put записывает содержимое под ключом, заменяя существующий объект.
+	// При ошибке чтения content частично записанный объект не остается в хранилище.
+	Put(ctx context.Context, key string, content io.Reader, opts PutOptions) (*BlobInfo, error)
+	// Get открывает объект для чтения; вызывающий обязан закрыть его.
+	// Для отсутствующего объекта возвращает ErrBlobNotFound.
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	// Stat возвращает метаданные объекта или ErrBlobNotFound
+	Stat(ctx context.Context, key string) (*BlobInfo, error)
+	// Delete удаляет объект; удаление отсутствующего объекта не считается ошибкой
+	Delete(ctx context.Context, key string) error
+	// SignedURL возвращает ссылку на скачивание объекта без авторизации, действующую ttl
+	SignedURL(ctx context.Context, key string, ttl time.Duration, opts URLOptions) (string, error)
+}
+
+// URLVerifier хранилище, подписанные ссылки которого обслуживает само приложение
+type URLVerifier interface {
+	// VerifyURL проверяет подпись ссылки на объект key и возвращает заголовки ответа из нее.
+	// Для неверной или просроченной подписи возвращает ErrInvalidSignature.
+	VerifyURL(key string, query url.Values) (*URLOptions, error)
+}
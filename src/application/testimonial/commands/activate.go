@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+)
+
+type ActivateTestimonialCommandHandler struct {
+	moderation
+}
+
+func NewActivateTestimonialCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *ActivateTestimonialCommandHandler {
+	return &ActivateTestimonialCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+func (h *ActivateTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.ActivateTestimonialCommand) (*dtos.CommandResult, error) {
+	// Активирует отзыв: одобренный отзыв снова показывается на сайте
+	return h.change(ctx, cmd.ID, cmd.ExpectedVersion, entities.AuditActionActivated, "Testimonial activated successfully",
+		func(testimonial *entities.Testimonial) error {
+			testimonial.Activate()
+			testimonial.SetUpdatedBy(identity.Actor(ctx))
+			return nil
+		})
+}
@@ -2,49 +2,33 @@ package commands
 
 import (
 	"context"
-	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/application/testimonial/dtos"
-	"time"
+	"tax-priority-api/src/domain/entities"
 )
 
 type ApproveTestimonialCommandHandler struct {
-	testimonialRepo repositories.TestimonialRepository
+	moderation
 }
 
-func NewApproveTestimonialCommandHandler(repo repositories.TestimonialRepository) *ApproveTestimonialCommandHandler {
+func NewApproveTestimonialCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *ApproveTestimonialCommandHandler {
 	return &ApproveTestimonialCommandHandler{
-		testimonialRepo: repo,
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
 	}
 }
 
 func (h *ApproveTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.ApproveTestimonialCommand) (*dtos.CommandResult, error) {
-	// Получаем отзыв
-	testimonial, err := h.testimonialRepo.FindByID(ctx, cmd.ID)
-	if err != nil {
-		return &dtos.CommandResult{
-			Success:   false,
-			Error:     fmt.Sprintf("testimonial not found: %v", err),
-			Timestamp: time.Now(),
-		}, err
-	}
-
-	// Одобряем отзыв
-	testimonial.Approve(cmd.ApprovedBy)
-
-	// Сохраняем изменения
-	if err := h.testimonialRepo.Update(ctx, testimonial); err != nil {
-		return &dtos.CommandResult{
-			Success:   false,
-			Error:     fmt.Sprintf("failed to approve testimonial: %v", err),
-			Timestamp: time.Now(),
-		}, err
-	}
-
-	return &dtos.CommandResult{
-		Success:   true,
-		Message:   "Testimonial approved successfully",
-		Data:      testimonial,
-		Timestamp: time.Now(),
-	}, nil
+	// Одобряем проверяемый отзыв от имени модератора из контекста запроса
+	return h.change(ctx, cmd.ID, cmd.ExpectedVersion, entities.AuditActionApproved, "Testimonial approved successfully",
+		func(testimonial *entities.Testimonial) error {
+			return testimonial.Approve(identity.Actor(ctx))
+		})
 }
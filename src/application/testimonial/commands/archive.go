@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+)
+
+type ArchiveTestimonialCommandHandler struct {
+	moderation
+}
+
+func NewArchiveTestimonialCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *ArchiveTestimonialCommandHandler {
+	return &ArchiveTestimonialCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+func (h *ArchiveTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.ArchiveTestimonialCommand) (*dtos.CommandResult, error) {
+	return h.change(ctx, cmd.ID, cmd.ExpectedVersion, entities.AuditActionArchived, "Testimonial archived successfully",
+		func(testimonial *entities.Testimonial) error {
+			return testimonial.Archive(identity.Actor(ctx))
+		})
+}
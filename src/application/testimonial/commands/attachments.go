@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"path/filepath"
+	"strings"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/uploads"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxFileNameLength длина колонки file_name
+const maxFileNameLength = 255
+
+// storedAttachment файл, сохраненный в хранилище
+type storedAttachment struct {
+	Key      string
+	FileName string
+	FileType string
+	FileSize int64
+}
+
+// storeAttachment проверяет файл по политике и сохраняет его под новым ключом;
+// расширение берется из типа, определенного по содержимому.
+// Для изображений рядом с оригиналом сохраняются уменьшенные копии.
+func storeAttachment(ctx context.Context, blobStore storage.BlobStore, policy *uploads.Policy, upload dtos.FileUpload) (*storedAttachment, error) {
+	inspected, err := policy.Inspect(upload.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	key := "testimonials/" + uuid.New().String() + inspected.Extension
+	content := inspected.Content
+
+	// Изображение читается целиком: копии создаются до сохранения, чтобы не хранить неразбираемые файлы.
+	// Оригинал отдается публично, поэтому сохраняется без EXIF и других метаданных.
+	var variants []uploads.RenderedVariant
+	if uploads.HasVariants(key) {
+		data, err := io.ReadAll(inspected.Content)
+		if err != nil {
+			return nil, err
+		}
+		if variants, err = uploads.RenderVariants(key, data); err != nil {
+			return nil, err
+		}
+		if data, err = uploads.StripMetadata(key, data); err != nil {
+			return nil, err
+		}
+		content = bytes.NewReader(data)
+	}
+
+	info, err := blobStore.Put(ctx, key, content, storage.PutOptions{ContentType: inspected.ContentType})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save file: %w", err)
+	}
+
+	for _, variant := range variants {
+		_, err := blobStore.Put(ctx, variant.Key, bytes.NewReader(variant.Content), storage.PutOptions{ContentType: variant.ContentType})
+		if err != nil {
+			deleteAttachment(ctx, blobStore, key)
+			return nil, fmt.Errorf("failed to save %s variant: %w", variant.Variant.Name, err)
+		}
+	}
+
+	return &storedAttachment{
+		Key:      key,
+		FileName: attachmentFileName(upload.FileName, inspected.Extension),
+		FileType: inspected.ContentType,
+		FileSize: info.Size,
+	}, nil
+}
+
+// deleteAttachment удаляет файл вместе с уменьшенными копиями; ошибки только логируются,
+// так как вызывается после того, как отзыв перестал ссылаться на файл
+func deleteAttachment(ctx context.Context, blobStore storage.BlobStore, key string) {
+	for _, storedKey := range uploads.StoredKeys(key) {
+		if err := blobStore.Delete(ctx, storedKey); err != nil {
+			log.Printf("Failed to remove file %s: %v", storedKey, err)
+		}
+	}
+}
+
+// attachmentFileName оставляет от имени клиента только базовое имя допустимой длины
+func attachmentFileName(name, ext string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		name = "attachment" + ext
+	}
+	for utf8.RuneCountInString(name) > maxFileNameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
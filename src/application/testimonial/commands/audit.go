@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/domain/entities"
+)
+
+// recordChange записывает изменение отзыва в журнал аудита; before равен nil при создании, after - при удалении
+func recordChange(ctx context.Context, auditLog *audit.Recorder, action entities.AuditAction, before, after *entities.Testimonial) error {
+	id := ""
+	switch {
+	case after != nil:
+		id = after.ID
+	case before != nil:
+		id = before.ID
+	}
+
+	return auditLog.Record(ctx, action, entities.AuditEntityTestimonial, id, before, after)
+}
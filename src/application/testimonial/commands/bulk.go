@@ -0,0 +1,201 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// errNotApplied исход ID, изменение которого отменено вместе с пакетом
+var errNotApplied = errors.New("not applied: batch rolled back")
+
+// batchOutcome собирает исходы пакетной команды в порядке ID запроса
+type batchOutcome struct {
+	ids     []string
+	results map[string]*dtos.BatchItemResult
+}
+
+// newBatchOutcome проверяет размер пакета и убирает повторяющиеся ID
+func newBatchOutcome(ids []string) (*batchOutcome, error) {
+	outcome := &batchOutcome{results: make(map[string]*dtos.BatchItemResult, len(ids))}
+	for _, id := range ids {
+		if _, seen := outcome.results[id]; seen {
+			continue
+		}
+		outcome.ids = append(outcome.ids, id)
+		outcome.results[id] = &dtos.BatchItemResult{ID: id}
+	}
+
+	if err := models.CheckBatchSize(len(outcome.ids)); err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+func (o *batchOutcome) succeed(testimonial *entities.Testimonial) {
+	result := o.results[testimonial.ID]
+	result.Success = true
+	result.Error = ""
+	result.Version = testimonial.Version
+}
+
+func (o *batchOutcome) fail(id string, err error) {
+	result := o.results[id]
+	result.Success = false
+	result.Error = err.Error()
+	result.Version = 0
+}
+
+// failures число ID с собственной ошибкой
+func (o *batchOutcome) failures() int {
+	count := 0
+	for _, result := range o.results {
+		if result.Error != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// rollback отмечает как не примененные все ID без собственной ошибки: транзакция пакета откатилась
+func (o *batchOutcome) rollback() {
+	for _, id := range o.ids {
+		if result := o.results[id]; result.Success || result.Error == "" {
+			o.fail(id, errNotApplied)
+		}
+	}
+}
+
+// requireComplete возвращает models.ErrBatchIncomplete для атомарного пакета с ошибками
+func (o *batchOutcome) requireComplete(atomic bool) error {
+	failed := o.failures()
+	if !atomic || failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d of %d failed", models.ErrBatchIncomplete, failed, len(o.ids))
+}
+
+// result собирает BatchCommandResult; err - ошибка пакета целиком
+func (o *batchOutcome) result(err error) *dtos.BatchCommandResult {
+	result := &dtos.BatchCommandResult{
+		Results:   make([]dtos.BatchItemResult, 0, len(o.ids)),
+		Timestamp: time.Now(),
+	}
+	for _, id := range o.ids {
+		item := *o.results[id]
+		if item.Success {
+			result.SuccessCount++
+		} else {
+			result.FailureCount++
+		}
+		result.Results = append(result.Results, item)
+	}
+	if err != nil {
+		result.Errors = []string{err.Error()}
+	}
+	return result
+}
+
+// changeMany применяет change к найденным отзывам и сохраняет изменения через UpdateBatch.
+// Чтение и запись идут в одной транзакции мимо кеша; без atomic ошибки отдельных ID не мешают остальным,
+// с atomic любая ошибка отменяет пакет целиком. UpdateBatch сверяет версии: если отзыв изменили между
+// чтением и записью, пакет отменяется с entities.ErrVersionConflict, а не перезаписывает чужое изменение.
+func (m *moderation) changeMany(
+	ctx context.Context,
+	ids []string,
+	atomic bool,
+	action entities.AuditAction,
+	change func(testimonial *entities.Testimonial) error,
+) (*dtos.BatchCommandResult, error) {
+	outcome, err := newBatchOutcome(ids)
+	if err != nil {
+		return &dtos.BatchCommandResult{
+			FailureCount: len(ids),
+			Errors:       []string{err.Error()},
+			Timestamp:    time.Now(),
+		}, err
+	}
+
+	err = m.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		found, err := m.findMany(ctx, outcome)
+		if err != nil {
+			return err
+		}
+
+		type changed struct {
+			before   entities.Testimonial
+			previous entities.TestimonialStatus
+			after    *entities.Testimonial
+		}
+		updates := make([]changed, 0, len(found))
+		batch := make([]*entities.Testimonial, 0, len(found))
+		for _, testimonial := range found {
+			before := *testimonial
+			previous := testimonial.CurrentStatus()
+			if err := change(testimonial); err != nil {
+				outcome.fail(testimonial.ID, err)
+				continue
+			}
+			updates = append(updates, changed{before: before, previous: previous, after: testimonial})
+			batch = append(batch, testimonial)
+		}
+
+		if err := outcome.requireComplete(atomic); err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if _, err := m.testimonialRepo.UpdateBatch(ctx, batch); err != nil {
+			return fmt.Errorf("failed to update testimonials: %w", err)
+		}
+
+		for _, update := range updates {
+			if err := recordChange(ctx, m.auditLog, action, &update.before, update.after); err != nil {
+				return fmt.Errorf("%s: %w", update.after.ID, err)
+			}
+			if update.after.CurrentStatus() != update.previous {
+				if err := m.notificationService.NotifyTestimonialStatusChanged(ctx, update.after, update.previous); err != nil {
+					return err
+				}
+			}
+			outcome.succeed(update.after)
+		}
+		return nil
+	})
+	if err != nil {
+		outcome.rollback()
+		return outcome.result(err), err
+	}
+
+	return outcome.result(nil), nil
+}
+
+// findMany загружает отзывы пакета в порядке запроса; ненайденные ID отмечаются в outcome
+func (m *moderation) findMany(ctx context.Context, outcome *batchOutcome) ([]*entities.Testimonial, error) {
+	testimonials, err := m.testimonialRepo.FindByIDs(ctx, outcome.ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find testimonials: %w", err)
+	}
+
+	byID := make(map[string]*entities.Testimonial, len(testimonials))
+	for _, testimonial := range testimonials {
+		byID[testimonial.ID] = testimonial
+	}
+
+	found := make([]*entities.Testimonial, 0, len(testimonials))
+	for _, id := range outcome.ids {
+		testimonial, ok := byID[id]
+		if !ok {
+			outcome.fail(id, fmt.Errorf("testimonial %s not found", id))
+			continue
+		}
+		found = append(found, testimonial)
+	}
+	return found, nil
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+)
+
+type BulkApproveTestimonialsCommandHandler struct {
+	moderation
+}
+
+func NewBulkApproveTestimonialsCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *BulkApproveTestimonialsCommandHandler {
+	return &BulkApproveTestimonialsCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+// Handle одобряет отзывы в статусе in_review; отзывы в других статусах отмечаются ошибкой перехода
+func (h *BulkApproveTestimonialsCommandHandler) Handle(ctx context.Context, cmd dtos.BulkApproveTestimonialsCommand) (*dtos.BatchCommandResult, error) {
+	actor := identity.Actor(ctx)
+	return h.changeMany(ctx, cmd.IDs, cmd.Atomic, entities.AuditActionApproved, func(testimonial *entities.Testimonial) error {
+		return testimonial.Approve(actor)
+	})
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+)
+
+type BulkDeactivateTestimonialsCommandHandler struct {
+	moderation
+}
+
+func NewBulkDeactivateTestimonialsCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *BulkDeactivateTestimonialsCommandHandler {
+	return &BulkDeactivateTestimonialsCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+func (h *BulkDeactivateTestimonialsCommandHandler) Handle(ctx context.Context, cmd dtos.BulkDeactivateTestimonialsCommand) (*dtos.BatchCommandResult, error) {
+	actor := identity.Actor(ctx)
+	return h.changeMany(ctx, cmd.IDs, cmd.Atomic, entities.AuditActionDeactivated, func(testimonial *entities.Testimonial) error {
+		testimonial.Deactivate()
+		testimonial.SetUpdatedBy(actor)
+		return nil
+	})
+}
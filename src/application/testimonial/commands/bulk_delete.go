@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type BulkDeleteTestimonialsCommandHandler struct {
+	moderation
+}
+
+func NewBulkDeleteTestimonialsCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *BulkDeleteTestimonialsCommandHandler {
+	return &BulkDeleteTestimonialsCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+// Handle переносит отзывы в корзину через DeleteBatch; файлы удаляются при очистке корзины
+func (h *BulkDeleteTestimonialsCommandHandler) Handle(ctx context.Context, cmd dtos.BulkDeleteTestimonialsCommand) (*dtos.BatchCommandResult, error) {
+	outcome, err := newBatchOutcome(cmd.IDs)
+	if err != nil {
+		return &dtos.BatchCommandResult{
+			FailureCount: len(cmd.IDs),
+			Errors:       []string{err.Error()},
+			Timestamp:    time.Now(),
+		}, err
+	}
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		found, err := h.findMany(ctx, outcome)
+		if err != nil {
+			return err
+		}
+		if err := outcome.requireComplete(cmd.Atomic); err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(found))
+		for _, testimonial := range found {
+			ids = append(ids, testimonial.ID)
+		}
+
+		deleted, err := h.testimonialRepo.DeleteBatch(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("failed to delete testimonials: %w", err)
+		}
+		// Отзывы найдены в этой же транзакции: расхождение значит, что их удалили параллельно
+		if err := deleted.RequireComplete(); err != nil {
+			return err
+		}
+
+		for _, testimonial := range found {
+			if err := recordChange(ctx, h.auditLog, entities.AuditActionDeleted, testimonial, nil); err != nil {
+				return fmt.Errorf("%s: %w", testimonial.ID, err)
+			}
+			outcome.succeed(testimonial)
+		}
+		return nil
+	})
+	if err != nil {
+		outcome.rollback()
+		return outcome.result(err), err
+	}
+
+	return outcome.result(nil), nil
+}
@@ -3,8 +3,14 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/screening"
+	"tax-priority-api/src/application/storage"
 	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/uploads"
 	"tax-priority-api/src/domain/entities"
 	"time"
 
@@ -12,12 +18,24 @@ import (
 )
 
 type CreateTestimonialCommandHandler struct {
-	testimonialRepo repositories.TestimonialRepository
+	testimonialRepo     repositories.TestimonialRepository
+	blobStore           storage.BlobStore
+	policy              *uploads.Policy
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+	screening           *screening.Pipeline
 }
 
-func NewCreateTestimonialCommandHandler(repo repositories.TestimonialRepository) *CreateTestimonialCommandHandler {
+func NewCreateTestimonialCommandHandler(repo repositories.TestimonialRepository, blobStore storage.BlobStore, policy *uploads.Policy, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService, pipeline *screening.Pipeline) *CreateTestimonialCommandHandler {
 	return &CreateTestimonialCommandHandler{
-		testimonialRepo: repo,
+		testimonialRepo:     repo,
+		blobStore:           blobStore,
+		policy:              policy,
+		transactor:          transactor,
+		auditLog:            auditLog,
+		notificationService: notificationService,
+		screening:           pipeline,
 	}
 }
 
@@ -30,6 +48,29 @@ func (h *CreateTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.C
 	)
 
 	testimonial.SetID(uuid.New().String())
+	testimonial.SetCreatedBy(identity.Actor(ctx))
+
+	// Проверяются все отправки, кроме добавленных модератором или редактором: они доверенные
+	// и email автора подтверждают сами. Токен или API ключ без этих прав доверия не дает.
+	fromSite := !cmd.Trusted
+	// Письмо подтверждения не отправляется подозрительным на спам отзывам: иначе форма позволяла бы
+	// рассылать письма на любой адрес. Такому отзыву письмо отправляет модератор после проверки.
+	sendVerification := false
+	if fromSite {
+		screened := h.screening.Screen(ctx, screening.Submission{
+			Content:     cmd.Content,
+			Author:      cmd.Author,
+			AuthorEmail: cmd.AuthorEmail,
+			Company:     cmd.Company,
+			Position:    cmd.Position,
+			ClientIP:    cmd.ClientIP,
+			Honeypot:    cmd.Honeypot,
+		})
+		testimonial.SetSpamScreening(screened.Score, screened.Reasons(), screened.SuspectedSpam)
+		sendVerification = !screened.SuspectedSpam
+	} else {
+		testimonial.VerifyEmail()
+	}
 
 	if cmd.Company != "" {
 		testimonial.Company = cmd.Company
@@ -38,17 +79,56 @@ func (h *CreateTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.C
 		testimonial.Position = cmd.Position
 	}
 
-	if err := h.testimonialRepo.Create(ctx, testimonial); err != nil {
+	// Файл сохраняется до отзыва: недопустимый файл не должен оставлять отзыв без вложения
+	if cmd.Attachment != nil {
+		stored, err := storeAttachment(ctx, h.blobStore, h.policy, *cmd.Attachment)
+		if err != nil {
+			return &dtos.CommandResult{
+				Success:   false,
+				Error:     fmt.Sprintf("failed to upload file: %v", err),
+				Timestamp: time.Now(),
+			}, err
+		}
+		testimonial.SetFile(stored.Key, stored.FileName, stored.FileType, stored.FileSize)
+	}
+
+	err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.testimonialRepo.Create(ctx, testimonial); err != nil {
+			return fmt.Errorf("failed to create testimonial: %w", err)
+		}
+		if err := recordChange(ctx, h.auditLog, entities.AuditActionCreated, nil, testimonial); err != nil {
+			return err
+		}
+		if err := h.notificationService.NotifyTestimonialStatusChanged(ctx, testimonial, ""); err != nil {
+			return err
+		}
+		// Письмо отправляет relay outbox после фиксации: ссылка не ведет на несохраненный отзыв,
+		// а ошибка SMTP повторяется, не задерживая ответ
+		if sendVerification {
+			return h.notificationService.NotifyTestimonialVerificationRequested(ctx, testimonial)
+		}
+		return nil
+	})
+	if err != nil {
+		if testimonial.HasFile() {
+			deleteAttachment(ctx, h.blobStore, testimonial.FilePath)
+		}
 		return &dtos.CommandResult{
 			Success:   false,
-			Error:     fmt.Sprintf("failed to create testimonial: %v", err),
+			Error:     err.Error(),
 			Timestamp: time.Now(),
 		}, err
 	}
 
+	// Ответ одинаков для всех отправок с сайта: отправитель не узнает, что отзыв сочли спамом
+	message := "Testimonial created successfully"
+	if fromSite {
+		message = "Testimonial created successfully, check your email to confirm it"
+	}
+
 	return &dtos.CommandResult{
 		Success:   true,
-		Message:   "Testimonial created successfully",
+		Message:   message,
 		Data:      testimonial,
 		Timestamp: time.Now(),
 	}, nil
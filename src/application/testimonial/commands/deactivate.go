@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+)
+
+type DeactivateTestimonialCommandHandler struct {
+	moderation
+}
+
+func NewDeactivateTestimonialCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *DeactivateTestimonialCommandHandler {
+	return &DeactivateTestimonialCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+func (h *DeactivateTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.DeactivateTestimonialCommand) (*dtos.CommandResult, error) {
+	// Скрывает отзыв с сайта без смены статуса модерации
+	return h.change(ctx, cmd.ID, cmd.ExpectedVersion, entities.AuditActionDeactivated, "Testimonial deactivated successfully",
+		func(testimonial *entities.Testimonial) error {
+			testimonial.Deactivate()
+			testimonial.SetUpdatedBy(identity.Actor(ctx))
+			return nil
+		})
+}
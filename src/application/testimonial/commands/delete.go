@@ -3,18 +3,24 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
 	"time"
 )
 
 type DeleteTestimonialCommandHandler struct {
 	testimonialRepo repositories.TestimonialRepository
+	transactor      repositories.Transactor
+	auditLog        *audit.Recorder
 }
 
-func NewDeleteTestimonialCommandHandler(repo repositories.TestimonialRepository) *DeleteTestimonialCommandHandler {
+func NewDeleteTestimonialCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder) *DeleteTestimonialCommandHandler {
 	return &DeleteTestimonialCommandHandler{
 		testimonialRepo: repo,
+		transactor:      transactor,
+		auditLog:        auditLog,
 	}
 }
 
@@ -29,10 +35,16 @@ func (h *DeleteTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.D
 	}
 
 	// Удаляем отзыв
-	if err := h.testimonialRepo.Delete(ctx, cmd.ID); err != nil {
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.testimonialRepo.Delete(ctx, cmd.ID); err != nil {
+			return fmt.Errorf("failed to delete testimonial: %w", err)
+		}
+		return recordChange(ctx, h.auditLog, entities.AuditActionDeleted, testimonial, nil)
+	})
+	if err != nil {
 		return &dtos.CommandResult{
 			Success:   false,
-			Error:     fmt.Sprintf("failed to delete testimonial: %v", err),
+			Error:     err.Error(),
 			Timestamp: time.Now(),
 		}, err
 	}
@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// moderation общая часть команд модерации: смена статуса и видимости отзыва
+type moderation struct {
+	testimonialRepo     repositories.TestimonialRepository
+	transactor          repositories.Transactor
+	auditLog            *audit.Recorder
+	notificationService events.NotificationService
+}
+
+// change загружает отзыв, проверяет версию и применяет transition; сохранение, запись в журнал аудита
+// и событие перехода (если статус изменился) выполняются в одной транзакции
+func (m *moderation) change(
+	ctx context.Context,
+	id string,
+	expectedVersion *int,
+	action entities.AuditAction,
+	message string,
+	transition func(testimonial *entities.Testimonial) error,
+) (*dtos.CommandResult, error) {
+	testimonial, err := m.testimonialRepo.FindByID(ctx, id)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     fmt.Sprintf("testimonial not found: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	if err := entities.CheckVersion(testimonial, expectedVersion); err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	before := *testimonial
+	previous := testimonial.CurrentStatus()
+
+	if err := transition(testimonial); err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	err = m.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := m.testimonialRepo.Update(ctx, testimonial); err != nil {
+			return fmt.Errorf("failed to update testimonial: %w", err)
+		}
+		if err := recordChange(ctx, m.auditLog, action, &before, testimonial); err != nil {
+			return err
+		}
+		if testimonial.CurrentStatus() == previous {
+			return nil
+		}
+		return m.notificationService.NotifyTestimonialStatusChanged(ctx, testimonial, previous)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		Success:   true,
+		Message:   message,
+		Data:      testimonial,
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"context"
+	"log"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// TestimonialTrashPurger окончательно удаляет отзывы из корзины вместе с их файлами
+type TestimonialTrashPurger struct {
+	testimonialRepo repositories.CachedTestimonialRepository
+	// baseRepo некешированный репозиторий: выборки корзины должны видеть актуальное состояние
+	baseRepo   repositories.GenericRepository[*entities.Testimonial, string]
+	blobStore  storage.BlobStore
+	transactor repositories.Transactor
+	auditLog   *audit.Recorder
+}
+
+func NewTestimonialTrashPurger(
+	repo repositories.CachedTestimonialRepository,
+	baseRepo repositories.GenericRepository[*entities.Testimonial, string],
+	blobStore storage.BlobStore,
+	transactor repositories.Transactor,
+	auditLog *audit.Recorder,
+) *TestimonialTrashPurger {
+	return &TestimonialTrashPurger{
+		testimonialRepo: repo,
+		baseRepo:        baseRepo,
+		blobStore:       blobStore,
+		transactor:      transactor,
+		auditLog:        auditLog,
+	}
+}
+
+// purgeSummary снимок очистки корзины для журнала аудита
+type purgeSummary struct {
+	DeletedBefore time.Time `json:"deletedBefore"`
+	Purged        int64     `json:"purged"`
+}
+
+// Purge удаляет отзывы, помещенные в корзину раньше deletedBefore, и файлы, на которые они ссылались
+func (p *TestimonialTrashPurger) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	files, err := p.expiredFiles(ctx, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	// Очистка записывается в журнал одной записью без ID сущности
+	var purged int64
+	err = p.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		purged, err = p.testimonialRepo.Purge(ctx, deletedBefore)
+		if err != nil || purged == 0 {
+			return err
+		}
+		summary := purgeSummary{DeletedBefore: deletedBefore, Purged: purged}
+		return p.auditLog.Record(ctx, entities.AuditActionTrashPurged, entities.AuditEntityTestimonial, "", nil, summary)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(files) == 0 {
+		return purged, nil
+	}
+
+	// Отзыв могли восстановить между выборкой и очисткой, его файл нужно сохранить
+	referenced, err := p.referencedIDs(ctx, files)
+	if err != nil {
+		log.Printf("Failed to check purged testimonials, files are kept: %v", err)
+		return purged, nil
+	}
+
+	for id, key := range files {
+		if referenced[id] {
+			continue
+		}
+		deleteAttachment(ctx, p.blobStore, key)
+	}
+
+	return purged, nil
+}
+
+// expiredFiles возвращает ключи файлов отзывов, которые будут удалены очисткой
+func (p *TestimonialTrashPurger) expiredFiles(ctx context.Context, deletedBefore time.Time) (map[string]string, error) {
+	expired, err := p.baseRepo.FindDeletedBefore(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	for _, testimonial := range expired {
+		if testimonial.HasFile() {
+			files[testimonial.ID] = testimonial.FilePath
+		}
+	}
+	return files, nil
+}
+
+// referencedIDs возвращает отзывы из files, которые после очистки остались в базе или в корзине
+func (p *TestimonialTrashPurger) referencedIDs(ctx context.Context, files map[string]string) (map[string]bool, error) {
+	ids := make([]string, 0, len(files))
+	for id := range files {
+		ids = append(ids, id)
+	}
+
+	referenced := make(map[string]bool)
+
+	active, err := p.baseRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, testimonial := range active {
+		referenced[testimonial.ID] = true
+	}
+
+	trashed, err := p.baseRepo.FindDeleted(ctx, &models.QueryOptions{
+		Pagination: &models.PaginationParams{Offset: 0, Limit: len(ids)},
+		Where:      models.NewFilterGroup(models.FilterAnd).Add("id", models.FilterIn, ids),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, testimonial := range trashed.Items {
+		referenced[testimonial.ID] = true
+	}
+
+	return referenced, nil
+}
@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/application/uploads"
+	"tax-priority-api/src/domain/entities"
+)
+
+// trashRepository отзывы в памяти с корзиной; методы повторяют условия GenericRepositoryImpl
+type trashRepository struct {
+	repositories.CachedTestimonialRepository
+	testimonials map[string]*entities.Testimonial
+}
+
+func (r *trashRepository) FindDeletedBefore(_ context.Context, deletedBefore time.Time) ([]*entities.Testimonial, error) {
+	var expired []*entities.Testimonial
+	for _, testimonial := range r.testimonials {
+		if testimonial.DeletedAt != nil && testimonial.DeletedAt.Before(deletedBefore) {
+			expired = append(expired, testimonial)
+		}
+	}
+	return expired, nil
+}
+
+func (r *trashRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	expired, _ := r.FindDeletedBefore(ctx, deletedBefore)
+	for _, testimonial := range expired {
+		delete(r.testimonials, testimonial.ID)
+	}
+	return int64(len(expired)), nil
+}
+
+func (r *trashRepository) FindByIDs(_ context.Context, ids []string) ([]*entities.Testimonial, error) {
+	var found []*entities.Testimonial
+	for _, id := range ids {
+		if testimonial, ok := r.testimonials[id]; ok && testimonial.DeletedAt == nil {
+			found = append(found, testimonial)
+		}
+	}
+	return found, nil
+}
+
+func (r *trashRepository) FindDeleted(context.Context, *models.QueryOptions) (*models.PaginatedResult[*entities.Testimonial], error) {
+	result := &models.PaginatedResult[*entities.Testimonial]{}
+	for _, testimonial := range r.testimonials {
+		if testimonial.DeletedAt != nil {
+			result.Items = append(result.Items, testimonial)
+		}
+	}
+	return result, nil
+}
+
+// memoryBlobStore хранилище в памяти; остальные методы очистка не вызывает
+type memoryBlobStore struct {
+	storage.BlobStore
+	blobs map[string]bool
+}
+
+func (s *memoryBlobStore) Delete(_ context.Context, key string) error {
+	delete(s.blobs, key)
+	return nil
+}
+
+// memoryAuditLog журнал аудита в памяти
+type memoryAuditLog struct {
+	repositories.AuditRepository
+	records []*entities.AuditRecord
+}
+
+func (l *memoryAuditLog) Append(_ context.Context, record *entities.AuditRecord) error {
+	l.records = append(l.records, record)
+	return nil
+}
+
+// inlineTransactor выполняет fn без транзакции
+type inlineTransactor struct{}
+
+func (inlineTransactor) WithinTransaction(ctx context.Context, fn repositories.TransactionFunc) error {
+	return fn(ctx)
+}
+
+func trashedTestimonial(id, filePath string, deletedAt time.Time) *entities.Testimonial {
+	testimonial := entities.NewTestimonial("Отличная консультация по налогам", "Иван", "author@example.com", 5)
+	testimonial.SetID(id)
+	testimonial.SetFile(filePath, "receipt.jpg", "image/jpeg", 1024)
+	testimonial.DeletedAt = &deletedAt
+	return testimonial
+}
+
+func TestTestimonialTrashPurgerPurgesExpired(t *testing.T) {
+	now := time.Now()
+	expired := trashedTestimonial("expired", "testimonials/expired.jpg", now.Add(-40*24*time.Hour))
+	recent := trashedTestimonial("recent", "testimonials/recent.jpg", now.Add(-time.Hour))
+
+	repo := &trashRepository{testimonials: map[string]*entities.Testimonial{
+		expired.ID: expired,
+		recent.ID:  recent,
+	}}
+	blobs := &memoryBlobStore{blobs: make(map[string]bool)}
+	for _, key := range append(uploads.StoredKeys(expired.FilePath), uploads.StoredKeys(recent.FilePath)...) {
+		blobs.blobs[key] = true
+	}
+	auditLog := &memoryAuditLog{}
+
+	purger := NewTestimonialTrashPurger(repo, repo, blobs, inlineTransactor{}, audit.NewRecorder(auditLog))
+	purged, err := purger.Purge(context.Background(), now.Add(-30*24*time.Hour))
+	if err != nil {
+		t.Fatalf("Purge: %v", err)
+	}
+	if purged != 1 {
+		t.Fatalf("purged = %d, want 1", purged)
+	}
+
+	if _, ok := repo.testimonials["expired"]; ok {
+		t.Fatal("expired testimonial is still in trash")
+	}
+	if _, ok := repo.testimonials["recent"]; !ok {
+		t.Fatal("recently deleted testimonial was purged")
+	}
+	for _, key := range uploads.StoredKeys(expired.FilePath) {
+		if blobs.blobs[key] {
+			t.Fatalf("file %s of purged testimonial was kept", key)
+		}
+	}
+	for _, key := range uploads.StoredKeys(recent.FilePath) {
+		if !blobs.blobs[key] {
+			t.Fatalf("file %s of testimonial in trash was deleted", key)
+		}
+	}
+
+	if len(auditLog.records) != 1 || auditLog.records[0].Action != entities.AuditActionTrashPurged {
+		t.Fatalf("audit records = %+v, want one %s", auditLog.records, entities.AuditActionTrashPurged)
+	}
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+)
+
+type RejectTestimonialCommandHandler struct {
+	moderation
+}
+
+func NewRejectTestimonialCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *RejectTestimonialCommandHandler {
+	return &RejectTestimonialCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+func (h *RejectTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.RejectTestimonialCommand) (*dtos.CommandResult, error) {
+	return h.change(ctx, cmd.ID, cmd.ExpectedVersion, entities.AuditActionRejected, "Testimonial rejected successfully",
+		func(testimonial *entities.Testimonial) error {
+			// Заметки сохраняются до отклонения: причина other проверяет их наличие
+			if cmd.Notes != "" {
+				testimonial.SetModeratorNotes(cmd.Notes)
+			}
+			return testimonial.Reject(identity.Actor(ctx), cmd.Reason)
+		})
+}
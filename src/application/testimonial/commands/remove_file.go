@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/uploads"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type RemoveTestimonialFileCommandHandler struct {
+	testimonialRepo repositories.TestimonialRepository
+	blobStore       storage.BlobStore
+	transactor      repositories.Transactor
+	auditLog        *audit.Recorder
+}
+
+func NewRemoveTestimonialFileCommandHandler(repo repositories.TestimonialRepository, blobStore storage.BlobStore, transactor repositories.Transactor, auditLog *audit.Recorder) *RemoveTestimonialFileCommandHandler {
+	return &RemoveTestimonialFileCommandHandler{
+		testimonialRepo: repo,
+		blobStore:       blobStore,
+		transactor:      transactor,
+		auditLog:        auditLog,
+	}
+}
+
+func (h *RemoveTestimonialFileCommandHandler) Handle(ctx context.Context, cmd dtos.RemoveTestimonialFileCommand) (*dtos.CommandResult, error) {
+	testimonial, err := h.testimonialRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     fmt.Sprintf("testimonial not found: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	if !testimonial.HasFile() {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     "testimonial has no file",
+			Timestamp: time.Now(),
+		}, uploads.ErrFileNotFound
+	}
+
+	before := *testimonial
+	key := testimonial.FilePath
+	testimonial.RemoveFile()
+	testimonial.SetUpdatedBy(identity.Actor(ctx))
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.testimonialRepo.Update(ctx, testimonial); err != nil {
+			return fmt.Errorf("failed to update testimonial: %w", err)
+		}
+		return recordChange(ctx, h.auditLog, entities.AuditActionFileRemoved, &before, testimonial)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	// Файл удаляется после обновления отзыва, чтобы отзыв не ссылался на отсутствующий файл
+	deleteAttachment(ctx, h.blobStore, key)
+
+	return &dtos.CommandResult{
+		Success:   true,
+		Message:   "File removed successfully",
+		Data:      testimonial,
+		Timestamp: time.Now(),
+	}, nil
+}
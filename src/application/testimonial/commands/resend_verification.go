@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type ResendTestimonialVerificationCommandHandler struct {
+	testimonialRepo     repositories.TestimonialRepository
+	transactor          repositories.Transactor
+	notificationService events.NotificationService
+}
+
+func NewResendTestimonialVerificationCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, notificationService events.NotificationService) *ResendTestimonialVerificationCommandHandler {
+	return &ResendTestimonialVerificationCommandHandler{
+		testimonialRepo:     repo,
+		transactor:          transactor,
+		notificationService: notificationService,
+	}
+}
+
+// Handle ставит в outbox письмо со ссылкой подтверждения на текущий email автора.
+// Нужна, если ссылка истекла, письмо не дошло или отзыв был подозрительным на спам и письмо не отправлялось.
+func (h *ResendTestimonialVerificationCommandHandler) Handle(ctx context.Context, cmd dtos.ResendTestimonialVerificationCommand) (*dtos.CommandResult, error) {
+	err := h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		testimonial, err := h.testimonialRepo.FindByID(ctx, cmd.ID)
+		if err != nil {
+			return fmt.Errorf("testimonial not found: %w", err)
+		}
+		if testimonial.IsEmailVerified() {
+			return entities.ErrEmailAlreadyVerified
+		}
+		return h.notificationService.NotifyTestimonialVerificationRequested(ctx, testimonial)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.CommandResult{
+		Success:   true,
+		Message:   "Verification email queued",
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+)
+
+type StartTestimonialReviewCommandHandler struct {
+	moderation
+}
+
+func NewStartTestimonialReviewCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder, notificationService events.NotificationService) *StartTestimonialReviewCommandHandler {
+	return &StartTestimonialReviewCommandHandler{
+		moderation: moderation{
+			testimonialRepo:     repo,
+			transactor:          transactor,
+			auditLog:            auditLog,
+			notificationService: notificationService,
+		},
+	}
+}
+
+func (h *StartTestimonialReviewCommandHandler) Handle(ctx context.Context, cmd dtos.StartTestimonialReviewCommand) (*dtos.CommandResult, error) {
+	return h.change(ctx, cmd.ID, cmd.ExpectedVersion, entities.AuditActionReviewStarted, "Testimonial review started",
+		func(testimonial *entities.Testimonial) error {
+			if err := testimonial.StartReview(identity.Actor(ctx)); err != nil {
+				return err
+			}
+			if cmd.Notes != "" {
+				testimonial.SetModeratorNotes(cmd.Notes)
+			}
+			return nil
+		})
+}
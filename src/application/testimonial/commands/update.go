@@ -3,18 +3,25 @@ package commands
 import (
 	"context"
 	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/identity"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
 	"time"
 )
 
 type UpdateTestimonialCommandHandler struct {
 	testimonialRepo repositories.TestimonialRepository
+	transactor      repositories.Transactor
+	auditLog        *audit.Recorder
 }
 
-func NewUpdateTestimonialCommandHandler(repo repositories.TestimonialRepository) *UpdateTestimonialCommandHandler {
+func NewUpdateTestimonialCommandHandler(repo repositories.TestimonialRepository, transactor repositories.Transactor, auditLog *audit.Recorder) *UpdateTestimonialCommandHandler {
 	return &UpdateTestimonialCommandHandler{
 		testimonialRepo: repo,
+		transactor:      transactor,
+		auditLog:        auditLog,
 	}
 }
 
@@ -29,6 +36,16 @@ func (h *UpdateTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.U
 		}, err
 	}
 
+	if err := entities.CheckVersion(testimonial, cmd.ExpectedVersion); err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	before := *testimonial
+
 	// Обновляем поля если они предоставлены
 	if cmd.Content != "" {
 		testimonial.UpdateContent(cmd.Content)
@@ -48,12 +65,23 @@ func (h *UpdateTestimonialCommandHandler) Handle(ctx context.Context, cmd dtos.U
 	if cmd.Position != "" {
 		testimonial.Position = cmd.Position
 	}
+	if cmd.ModeratorNotes != nil {
+		testimonial.SetModeratorNotes(*cmd.ModeratorNotes)
+	}
+
+	testimonial.SetUpdatedBy(identity.Actor(ctx))
 
 	// Сохраняем изменения
-	if err := h.testimonialRepo.Update(ctx, testimonial); err != nil {
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.testimonialRepo.Update(ctx, testimonial); err != nil {
+			return fmt.Errorf("failed to update testimonial: %w", err)
+		}
+		return recordChange(ctx, h.auditLog, entities.AuditActionUpdated, &before, testimonial)
+	})
+	if err != nil {
 		return &dtos.CommandResult{
 			Success:   false,
-			Error:     fmt.Sprintf("failed to update testimonial: %v", err),
+			Error:     err.Error(),
 			Timestamp: time.Now(),
 		}, err
 	}
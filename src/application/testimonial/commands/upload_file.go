@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/identity"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/uploads"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type UploadTestimonialFileCommandHandler struct {
+	testimonialRepo repositories.TestimonialRepository
+	blobStore       storage.BlobStore
+	policy          *uploads.Policy
+	transactor      repositories.Transactor
+	auditLog        *audit.Recorder
+}
+
+func NewUploadTestimonialFileCommandHandler(repo repositories.TestimonialRepository, blobStore storage.BlobStore, policy *uploads.Policy, transactor repositories.Transactor, auditLog *audit.Recorder) *UploadTestimonialFileCommandHandler {
+	return &UploadTestimonialFileCommandHandler{
+		testimonialRepo: repo,
+		blobStore:       blobStore,
+		policy:          policy,
+		transactor:      transactor,
+		auditLog:        auditLog,
+	}
+}
+
+func (h *UploadTestimonialFileCommandHandler) Handle(ctx context.Context, cmd dtos.UploadTestimonialFileCommand) (*dtos.CommandResult, error) {
+	testimonial, err := h.testimonialRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     fmt.Sprintf("testimonial not found: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	if err := entities.CheckVersion(testimonial, cmd.ExpectedVersion); err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	stored, err := storeAttachment(ctx, h.blobStore, h.policy, cmd.File)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to upload file: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	before := *testimonial
+	previousKey := testimonial.FilePath
+	testimonial.SetFile(stored.Key, stored.FileName, stored.FileType, stored.FileSize)
+	testimonial.SetUpdatedBy(identity.Actor(ctx))
+
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.testimonialRepo.Update(ctx, testimonial); err != nil {
+			return fmt.Errorf("failed to update testimonial: %w", err)
+		}
+		return recordChange(ctx, h.auditLog, entities.AuditActionFileUploaded, &before, testimonial)
+	})
+	if err != nil {
+		// Отзыв не ссылается на новый файл, удаляем его
+		deleteAttachment(ctx, h.blobStore, stored.Key)
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	if previousKey != "" {
+		deleteAttachment(ctx, h.blobStore, previousKey)
+	}
+
+	return &dtos.CommandResult{
+		Success:   true,
+		Message:   "File uploaded successfully",
+		Data:      testimonial,
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/verification"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+type VerifyTestimonialEmailCommandHandler struct {
+	testimonialRepo repositories.TestimonialRepository
+	tokens          verification.Tokens
+	transactor      repositories.Transactor
+	auditLog        *audit.Recorder
+}
+
+func NewVerifyTestimonialEmailCommandHandler(repo repositories.TestimonialRepository, tokens verification.Tokens, transactor repositories.Transactor, auditLog *audit.Recorder) *VerifyTestimonialEmailCommandHandler {
+	return &VerifyTestimonialEmailCommandHandler{
+		testimonialRepo: repo,
+		tokens:          tokens,
+		transactor:      transactor,
+		auditLog:        auditLog,
+	}
+}
+
+// Handle подтверждает email автора; повторный переход по ссылке не меняет отзыв.
+// Результат не содержит отзыв: ссылку открывает анонимный клиент.
+func (h *VerifyTestimonialEmailCommandHandler) Handle(ctx context.Context, cmd dtos.VerifyTestimonialEmailCommand) (*dtos.CommandResult, error) {
+	claims, err := h.tokens.Parse(cmd.Token)
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	verified := false
+	err = h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		testimonial, err := h.testimonialRepo.FindByID(ctx, claims.TestimonialID)
+		if err != nil {
+			return fmt.Errorf("testimonial not found: %w", err)
+		}
+		// Токен, выданный до смены email автора, не подтверждает новый адрес
+		if !claims.MatchesEmail(testimonial.AuthorEmail) {
+			return verification.ErrInvalidToken
+		}
+
+		before := *testimonial
+		if verified = testimonial.VerifyEmail(); !verified {
+			return nil
+		}
+		if err := h.testimonialRepo.Update(ctx, testimonial); err != nil {
+			return fmt.Errorf("failed to update testimonial: %w", err)
+		}
+		return recordChange(ctx, h.auditLog, entities.AuditActionEmailVerified, &before, testimonial)
+	})
+	if err != nil {
+		return &dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	message := "Email verified successfully"
+	if !verified {
+		message = "Email is already verified"
+	}
+	return &dtos.CommandResult{
+		Success:   true,
+		Message:   message,
+		Timestamp: time.Now(),
+	}, nil
+}
@@ -1,6 +1,8 @@
 package dtos
 
 import (
+	"io"
+	"tax-priority-api/src/domain/entities"
 	"time"
 )
 
@@ -12,6 +14,20 @@ type CreateTestimonialCommand struct {
 	Rating      int    `json:"rating" validate:"required,min=1,max=5"`
 	Company     string `json:"company,omitempty" validate:"max=255"`
 	Position    string `json:"position,omitempty" validate:"max=255"`
+	// Attachment необязательный файл, сохраняется вместе с отзывом
+	Attachment *FileUpload `json:"-" swaggerignore:"true"`
+	// ClientIP адрес отправителя для ограничения частоты отправок
+	ClientIP string `json:"-" swaggerignore:"true"`
+	// Honeypot скрытое поле формы; заполненное поле повышает оценку спама
+	Honeypot string `json:"-" swaggerignore:"true"`
+	// Trusted отзыв добавляет модератор или редактор: проверка на спам и подтверждение email не нужны
+	Trusted bool `json:"-" swaggerignore:"true"`
+}
+
+// FileUpload загружаемый файл; тип определяется по содержимому, а не по заголовкам клиента
+type FileUpload struct {
+	FileName string
+	Content  io.Reader
 }
 
 // UpdateTestimonialCommand для обновления отзыва
@@ -23,6 +39,10 @@ type UpdateTestimonialCommand struct {
 	Rating      int    `json:"rating,omitempty" validate:"min=1,max=5"`
 	Company     string `json:"company,omitempty" validate:"max=255"`
 	Position    string `json:"position,omitempty" validate:"max=255"`
+	// ModeratorNotes - внутренние заметки модератора; nil - без изменений, пустая строка удаляет заметки
+	ModeratorNotes *string `json:"moderatorNotes,omitempty" validate:"omitempty,max=2000"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
 }
 
 // DeleteTestimonialCommand для удаления отзыва
@@ -32,48 +52,119 @@ type DeleteTestimonialCommand struct {
 
 // ApproveTestimonialCommand для одобрения отзыва
 type ApproveTestimonialCommand struct {
-	ID         string `json:"id" validate:"required"`
-	ApprovedBy string `json:"approvedBy" validate:"required"`
+	ID string `json:"id" validate:"required"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
+}
+
+// StartTestimonialReviewCommand для взятия отзыва на проверку (submitted, approved или rejected -> in_review)
+type StartTestimonialReviewCommand struct {
+	ID string `json:"id" validate:"required"`
+	// Notes - заметки модератора; пустая строка оставляет прежние
+	Notes string `json:"notes,omitempty" validate:"max=2000"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
+}
+
+// RejectTestimonialCommand для отклонения проверяемого отзыва
+type RejectTestimonialCommand struct {
+	ID string `json:"id" validate:"required"`
+	// Reason - причина отклонения: spam, offensive, off_topic, duplicate, personal_data, low_quality, other
+	Reason entities.RejectionReason `json:"reason" validate:"required"`
+	// Notes - заметки модератора; для причины other обязательны, если у отзыва еще нет заметок
+	Notes string `json:"notes,omitempty" validate:"max=2000"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
+}
+
+// ArchiveTestimonialCommand для переноса одобренного или отклоненного отзыва в архив
+type ArchiveTestimonialCommand struct {
+	ID string `json:"id" validate:"required"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
+}
+
+// VerifyTestimonialEmailCommand для подтверждения email автора по токену из письма
+type VerifyTestimonialEmailCommand struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ResendTestimonialVerificationCommand для повторной отправки письма подтверждения email автора
+type ResendTestimonialVerificationCommand struct {
+	ID string `json:"id" validate:"required"`
 }
 
 // DeactivateTestimonialCommand для деактивации отзыва
 type DeactivateTestimonialCommand struct {
 	ID string `json:"id" validate:"required"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
 }
 
 // ActivateTestimonialCommand для активации отзыва
 type ActivateTestimonialCommand struct {
 	ID string `json:"id" validate:"required"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
 }
 
-// BulkApproveTestimonialsCommand для массового одобрения
+// BulkApproveTestimonialsCommand для массового одобрения отзывов в статусе in_review
 type BulkApproveTestimonialsCommand struct {
-	IDs        []string `json:"ids" validate:"required,min=1"`
-	ApprovedBy string   `json:"approvedBy" validate:"required"`
+	IDs []string `json:"ids" validate:"required,min=1,max=100"`
+	// Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет одобрен
+	Atomic bool `json:"atomic"`
 }
 
 // BulkDeactivateTestimonialsCommand для массовой деактивации
 type BulkDeactivateTestimonialsCommand struct {
-	IDs []string `json:"ids" validate:"required,min=1"`
+	IDs []string `json:"ids" validate:"required,min=1,max=100"`
+	// Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет деактивирован
+	Atomic bool `json:"atomic"`
 }
 
 // BulkActivateTestimonialsCommand для массовой активации
 type BulkActivateTestimonialsCommand struct {
-	IDs []string `json:"ids" validate:"required,min=1"`
+	IDs []string `json:"ids" validate:"required,min=1,max=100"`
+	// Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет активирован
+	Atomic bool `json:"atomic"`
 }
 
 // BulkDeleteTestimonialsCommand для массового удаления
 type BulkDeleteTestimonialsCommand struct {
-	IDs []string `json:"ids" validate:"required,min=1"`
+	IDs []string `json:"ids" validate:"required,min=1,max=100"`
+	// Atomic - все или ничего: при ошибке по любому ID ни один отзыв не будет удален
+	Atomic bool `json:"atomic"`
 }
 
-// UploadTestimonialFileCommand для загрузки файла к отзыву
+// UploadTestimonialFileCommand для загрузки файла к отзыву; заменяет ранее прикрепленный файл
 type UploadTestimonialFileCommand struct {
-	ID       string `json:"id" validate:"required"`
-	FilePath string `json:"filePath" validate:"required"`
-	FileName string `json:"fileName" validate:"required"`
-	FileType string `json:"fileType" validate:"required"`
-	FileSize int64  `json:"fileSize" validate:"required,min=1"`
+	ID   string     `json:"id" validate:"required"`
+	File FileUpload `json:"-" swaggerignore:"true"`
+	// ExpectedVersion - версия из If-Match; nil - без проверки
+	ExpectedVersion *int `json:"-"`
+}
+
+// RemoveTestimonialFileCommand для удаления файла отзыва
+type RemoveTestimonialFileCommand struct {
+	ID string `json:"id" validate:"required"`
+}
+
+// BatchItemResult результат пакетной команды для одного ID
+type BatchItemResult struct {
+	ID      string `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+	// Version - новая версия отзыва для If-Match после успешного изменения
+	Version int `json:"version,omitempty"`
+}
+
+// BatchCommandResult результат пакетной команды с исходом по каждому ID в порядке запроса
+type BatchCommandResult struct {
+	SuccessCount int               `json:"successCount"`
+	FailureCount int               `json:"failureCount"`
+	Results      []BatchItemResult `json:"results"`
+	Errors       []string          `json:"errors,omitempty"`
+	Timestamp    time.Time         `json:"timestamp"`
 }
 
 // CommandResult общий результат выполнения команды
@@ -1,6 +1,7 @@
 package dtos
 
 import (
+	"io"
 	"tax-priority-api/src/application/models"
 	"tax-priority-api/src/domain/entities"
 	"time"
@@ -13,6 +14,9 @@ type GetTestimonialsQuery struct {
 	SortBy    string                 `json:"sortBy"`
 	SortOrder string                 `json:"sortOrder" validate:"oneof=asc desc"`
 	Filters   map[string]interface{} `json:"filters"`
+	Where     *models.FilterGroup    `json:"where,omitempty"`
+	// Cursor включает keyset-пагинацию вместо Offset; пустая строка - первая страница
+	Cursor *string `json:"cursor,omitempty"`
 }
 
 // GetTestimonialByIDQuery для получения отзыва по ID
@@ -20,6 +24,47 @@ type GetTestimonialByIDQuery struct {
 	ID string `json:"id" validate:"required"`
 }
 
+// GetTestimonialFileQuery для получения файла отзыва
+type GetTestimonialFileQuery struct {
+	ID string `json:"id" validate:"required"`
+	// Variant уменьшенная копия изображения (thumb, preview); пустое значение - оригинал
+	Variant string `json:"variant,omitempty"`
+	// PublishedOnly скрывает файлы неодобренных и неактивных отзывов (для анонимных клиентов)
+	PublishedOnly bool `json:"-"`
+}
+
+// TestimonialFile открытый файл отзыва; вызывающий обязан закрыть Content
+type TestimonialFile struct {
+	FileName  string
+	FileType  string
+	FileSize  int64
+	UpdatedAt time.Time
+	// Content поддерживает io.Seeker, если хранилище позволяет произвольный доступ
+	Content io.ReadCloser
+}
+
+// GetTestimonialFileURLQuery для получения временной ссылки на файл отзыва
+type GetTestimonialFileURLQuery struct {
+	ID            string `json:"id" validate:"required"`
+	Variant       string `json:"variant,omitempty"`
+	PublishedOnly bool   `json:"-"`
+}
+
+// TestimonialFileURL подписанная ссылка на скачивание файла без авторизации
+type TestimonialFileURL struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetModerationQueueQuery для получения отзывов, ожидающих решения модератора, от старых к новым
+type GetModerationQueueQuery struct {
+	Limit  int `json:"limit" validate:"min=1,max=100"`
+	Offset int `json:"offset" validate:"min=0"`
+	// Status сужает очередь до submitted или in_review; suspected_spam выбирает очередь подозрительных на спам,
+	// пустое значение - submitted и in_review
+	Status entities.TestimonialStatus `json:"status,omitempty"`
+}
+
 // GetTestimonialsByRatingQuery для получения отзывов по рейтингу
 type GetTestimonialsByRatingQuery struct {
 	Rating    int                    `json:"rating" validate:"required,min=1,max=5"`
@@ -74,13 +119,15 @@ type GetTestimonialStatsQuery struct {
 
 // QueryResult общий результат выполнения запроса
 type QueryResult struct {
-	Success   bool                                           `json:"success"`
-	Message   string                                         `json:"message,omitempty"`
-	Error     string                                         `json:"error,omitempty"`
-	Data      *entities.Testimonial                          `json:"data,omitempty"`
-	Paginated *models.PaginatedResult[*entities.Testimonial] `json:"paginated,omitempty"`
-	Stats     *TestimonialStats                              `json:"stats,omitempty"`
-	Timestamp time.Time                                      `json:"timestamp"`
+	Success         bool                                                 `json:"success"`
+	Message         string                                               `json:"message,omitempty"`
+	Error           string                                               `json:"error,omitempty"`
+	Data            *entities.Testimonial                                `json:"data,omitempty"`
+	Paginated       *models.PaginatedResult[*entities.Testimonial]       `json:"paginated,omitempty"`
+	CursorPaginated *models.CursorPaginatedResult[*entities.Testimonial] `json:"cursorPaginated,omitempty"`
+	Stats           *TestimonialStats                                    `json:"stats,omitempty"`
+	FileURL         *TestimonialFileURL                                  `json:"fileUrl,omitempty"`
+	Timestamp       time.Time                                            `json:"timestamp"`
 }
 
 // TestimonialStats статистика отзывов
@@ -2,24 +2,65 @@ package handlers
 
 import (
 	"context"
+	"tax-priority-api/src/application/audit"
+	"tax-priority-api/src/application/events"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/screening"
+	"tax-priority-api/src/application/storage"
 	"tax-priority-api/src/application/testimonial/commands"
 	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/uploads"
+	"tax-priority-api/src/application/verification"
 )
 
 type TestimonialCommandHandlers struct {
-	CreateHandler  *commands.CreateTestimonialCommandHandler
-	UpdateHandler  *commands.UpdateTestimonialCommandHandler
-	DeleteHandler  *commands.DeleteTestimonialCommandHandler
-	ApproveHandler *commands.ApproveTestimonialCommandHandler
+	CreateHandler      *commands.CreateTestimonialCommandHandler
+	UpdateHandler      *commands.UpdateTestimonialCommandHandler
+	DeleteHandler      *commands.DeleteTestimonialCommandHandler
+	ApproveHandler     *commands.ApproveTestimonialCommandHandler
+	ReviewHandler      *commands.StartTestimonialReviewCommandHandler
+	RejectHandler      *commands.RejectTestimonialCommandHandler
+	ArchiveHandler     *commands.ArchiveTestimonialCommandHandler
+	ActivateHandler    *commands.ActivateTestimonialCommandHandler
+	DeactivateHandler  *commands.DeactivateTestimonialCommandHandler
+	VerifyEmailHandler *commands.VerifyTestimonialEmailCommandHandler
+	ResendVerification *commands.ResendTestimonialVerificationCommandHandler
+	BulkApprove        *commands.BulkApproveTestimonialsCommandHandler
+	BulkActivate       *commands.BulkActivateTestimonialsCommandHandler
+	BulkDeactivate     *commands.BulkDeactivateTestimonialsCommandHandler
+	BulkDelete         *commands.BulkDeleteTestimonialsCommandHandler
+	UploadFileHandler  *commands.UploadTestimonialFileCommandHandler
+	RemoveFileHandler  *commands.RemoveTestimonialFileCommandHandler
 }
 
-func NewTestimonialCommandHandlers(repo repositories.CachedTestimonialRepository) *TestimonialCommandHandlers {
+func NewTestimonialCommandHandlers(
+	repo repositories.CachedTestimonialRepository,
+	blobStore storage.BlobStore,
+	policy *uploads.Policy,
+	transactor repositories.Transactor,
+	auditLog *audit.Recorder,
+	notificationService events.NotificationService,
+	pipeline *screening.Pipeline,
+	verificationTokens verification.Tokens,
+) *TestimonialCommandHandlers {
 	return &TestimonialCommandHandlers{
-		CreateHandler:  commands.NewCreateTestimonialCommandHandler(repo),
-		UpdateHandler:  commands.NewUpdateTestimonialCommandHandler(repo),
-		DeleteHandler:  commands.NewDeleteTestimonialCommandHandler(repo),
-		ApproveHandler: commands.NewApproveTestimonialCommandHandler(repo),
+		CreateHandler:      commands.NewCreateTestimonialCommandHandler(repo, blobStore, policy, transactor, auditLog, notificationService, pipeline),
+		UpdateHandler:      commands.NewUpdateTestimonialCommandHandler(repo, transactor, auditLog),
+		DeleteHandler:      commands.NewDeleteTestimonialCommandHandler(repo, transactor, auditLog),
+		ApproveHandler:     commands.NewApproveTestimonialCommandHandler(repo, transactor, auditLog, notificationService),
+		ReviewHandler:      commands.NewStartTestimonialReviewCommandHandler(repo, transactor, auditLog, notificationService),
+		RejectHandler:      commands.NewRejectTestimonialCommandHandler(repo, transactor, auditLog, notificationService),
+		ArchiveHandler:     commands.NewArchiveTestimonialCommandHandler(repo, transactor, auditLog, notificationService),
+		ActivateHandler:    commands.NewActivateTestimonialCommandHandler(repo, transactor, auditLog, notificationService),
+		DeactivateHandler:  commands.NewDeactivateTestimonialCommandHandler(repo, transactor, auditLog, notificationService),
+		VerifyEmailHandler: commands.NewVerifyTestimonialEmailCommandHandler(repo, verificationTokens, transactor, auditLog),
+		ResendVerification: commands.NewResendTestimonialVerificationCommandHandler(repo, transactor, notificationService),
+		BulkApprove:        commands.NewBulkApproveTestimonialsCommandHandler(repo, transactor, auditLog, notificationService),
+		BulkActivate:       commands.NewBulkActivateTestimonialsCommandHandler(repo, transactor, auditLog, notificationService),
+		BulkDeactivate:     commands.NewBulkDeactivateTestimonialsCommandHandler(repo, transactor, auditLog, notificationService),
+		BulkDelete:         commands.NewBulkDeleteTestimonialsCommandHandler(repo, transactor, auditLog, notificationService),
+		UploadFileHandler:  commands.NewUploadTestimonialFileCommandHandler(repo, blobStore, policy, transactor, auditLog),
+		RemoveFileHandler:  commands.NewRemoveTestimonialFileCommandHandler(repo, blobStore, transactor, auditLog),
 	}
 }
 
@@ -44,3 +85,68 @@ func (h *TestimonialCommandHandlers) DeleteTestimonial(ctx context.Context, cmd
 func (h *TestimonialCommandHandlers) ApproveTestimonial(ctx context.Context, cmd dtos.ApproveTestimonialCommand) (*dtos.CommandResult, error) {
 	return h.ApproveHandler.Handle(ctx, cmd)
 }
+
+// StartTestimonialReview - взятие отзыва на проверку
+func (h *TestimonialCommandHandlers) StartTestimonialReview(ctx context.Context, cmd dtos.StartTestimonialReviewCommand) (*dtos.CommandResult, error) {
+	return h.ReviewHandler.Handle(ctx, cmd)
+}
+
+// RejectTestimonial - отклонение отзыва
+func (h *TestimonialCommandHandlers) RejectTestimonial(ctx context.Context, cmd dtos.RejectTestimonialCommand) (*dtos.CommandResult, error) {
+	return h.RejectHandler.Handle(ctx, cmd)
+}
+
+// ArchiveTestimonial - перенос отзыва в архив
+func (h *TestimonialCommandHandlers) ArchiveTestimonial(ctx context.Context, cmd dtos.ArchiveTestimonialCommand) (*dtos.CommandResult, error) {
+	return h.ArchiveHandler.Handle(ctx, cmd)
+}
+
+// ActivateTestimonial - активация отзыва
+func (h *TestimonialCommandHandlers) ActivateTestimonial(ctx context.Context, cmd dtos.ActivateTestimonialCommand) (*dtos.CommandResult, error) {
+	return h.ActivateHandler.Handle(ctx, cmd)
+}
+
+// DeactivateTestimonial - деактивация отзыва
+func (h *TestimonialCommandHandlers) DeactivateTestimonial(ctx context.Context, cmd dtos.DeactivateTestimonialCommand) (*dtos.CommandResult, error) {
+	return h.DeactivateHandler.Handle(ctx, cmd)
+}
+
+// VerifyTestimonialEmail - подтверждение email автора отзыва
+func (h *TestimonialCommandHandlers) VerifyTestimonialEmail(ctx context.Context, cmd dtos.VerifyTestimonialEmailCommand) (*dtos.CommandResult, error) {
+	return h.VerifyEmailHandler.Handle(ctx, cmd)
+}
+
+// ResendTestimonialVerification - повторная отправка письма подтверждения email автора
+func (h *TestimonialCommandHandlers) ResendTestimonialVerification(ctx context.Context, cmd dtos.ResendTestimonialVerificationCommand) (*dtos.CommandResult, error) {
+	return h.ResendVerification.Handle(ctx, cmd)
+}
+
+// BulkApproveTestimonials - массовое одобрение отзывов
+func (h *TestimonialCommandHandlers) BulkApproveTestimonials(ctx context.Context, cmd dtos.BulkApproveTestimonialsCommand) (*dtos.BatchCommandResult, error) {
+	return h.BulkApprove.Handle(ctx, cmd)
+}
+
+// BulkActivateTestimonials - массовая активация отзывов
+func (h *TestimonialCommandHandlers) BulkActivateTestimonials(ctx context.Context, cmd dtos.BulkActivateTestimonialsCommand) (*dtos.BatchCommandResult, error) {
+	return h.BulkActivate.Handle(ctx, cmd)
+}
+
+// BulkDeactivateTestimonials - массовая деактивация отзывов
+func (h *TestimonialCommandHandlers) BulkDeactivateTestimonials(ctx context.Context, cmd dtos.BulkDeactivateTestimonialsCommand) (*dtos.BatchCommandResult, error) {
+	return h.BulkDeactivate.Handle(ctx, cmd)
+}
+
+// BulkDeleteTestimonials - массовое удаление отзывов
+func (h *TestimonialCommandHandlers) BulkDeleteTestimonials(ctx context.Context, cmd dtos.BulkDeleteTestimonialsCommand) (*dtos.BatchCommandResult, error) {
+	return h.BulkDelete.Handle(ctx, cmd)
+}
+
+// UploadTestimonialFile - загрузка файла отзыва
+func (h *TestimonialCommandHandlers) UploadTestimonialFile(ctx context.Context, cmd dtos.UploadTestimonialFileCommand) (*dtos.CommandResult, error) {
+	return h.UploadFileHandler.Handle(ctx, cmd)
+}
+
+// RemoveTestimonialFile - удаление файла отзыва
+func (h *TestimonialCommandHandlers) RemoveTestimonialFile(ctx context.Context, cmd dtos.RemoveTestimonialFileCommand) (*dtos.CommandResult, error) {
+	return h.RemoveFileHandler.Handle(ctx, cmd)
+}
@@ -3,19 +3,26 @@ package handlers
 import (
 	"context"
 	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
 	"tax-priority-api/src/application/testimonial/dtos"
 	"tax-priority-api/src/application/testimonial/queries"
 )
 
 type TestimonialQueryHandlers struct {
-	GetManyHandler *queries.GetTestimonialsQueryHandler
-	GetByIDHandler *queries.GetTestimonialByIDQueryHandler
+	GetManyHandler         *queries.GetTestimonialsQueryHandler
+	GetByIDHandler         *queries.GetTestimonialByIDQueryHandler
+	GetFileHandler         *queries.GetTestimonialFileQueryHandler
+	GetFileURLHandler      *queries.GetTestimonialFileURLQueryHandler
+	ModerationQueueHandler *queries.GetModerationQueueQueryHandler
 }
 
-func NewTestimonialQueryHandlers(repo repositories.CachedTestimonialRepository) *TestimonialQueryHandlers {
+func NewTestimonialQueryHandlers(repo repositories.CachedTestimonialRepository, blobStore storage.BlobStore) *TestimonialQueryHandlers {
 	return &TestimonialQueryHandlers{
-		GetManyHandler: queries.NewGetTestimonialsQueryHandler(repo),
-		GetByIDHandler: queries.NewGetTestimonialByIDQueryHandler(repo),
+		GetManyHandler:         queries.NewGetTestimonialsQueryHandler(repo),
+		GetByIDHandler:         queries.NewGetTestimonialByIDQueryHandler(repo),
+		GetFileHandler:         queries.NewGetTestimonialFileQueryHandler(repo, blobStore),
+		GetFileURLHandler:      queries.NewGetTestimonialFileURLQueryHandler(repo, blobStore),
+		ModerationQueueHandler: queries.NewGetModerationQueueQueryHandler(repo),
 	}
 }
 
@@ -30,3 +37,18 @@ func (h *TestimonialQueryHandlers) GetTestimonials(ctx context.Context, query dt
 func (h *TestimonialQueryHandlers) GetTestimonialByID(ctx context.Context, query dtos.GetTestimonialByIDQuery) (*dtos.QueryResult, error) {
 	return h.GetByIDHandler.Handle(ctx, query)
 }
+
+// GetTestimonialFile - получение файла отзыва
+func (h *TestimonialQueryHandlers) GetTestimonialFile(ctx context.Context, query dtos.GetTestimonialFileQuery) (*dtos.TestimonialFile, error) {
+	return h.GetFileHandler.Handle(ctx, query)
+}
+
+// GetTestimonialFileURL - получение временной ссылки на файл отзыва
+func (h *TestimonialQueryHandlers) GetTestimonialFileURL(ctx context.Context, query dtos.GetTestimonialFileURLQuery) (*dtos.QueryResult, error) {
+	return h.GetFileURLHandler.Handle(ctx, query)
+}
+
+// GetModerationQueue - получение очереди модерации
+func (h *TestimonialQueryHandlers) GetModerationQueue(ctx context.Context, query dtos.GetModerationQueueQuery) (*dtos.QueryResult, error) {
+	return h.ModerationQueueHandler.Handle(ctx, query)
+}
@@ -0,0 +1,96 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/uploads"
+	"tax-priority-api/src/domain/entities"
+)
+
+type GetTestimonialFileQueryHandler struct {
+	testimonialRepo repositories.TestimonialRepository
+	blobStore       storage.BlobStore
+}
+
+func NewGetTestimonialFileQueryHandler(repo repositories.TestimonialRepository, blobStore storage.BlobStore) *GetTestimonialFileQueryHandler {
+	return &GetTestimonialFileQueryHandler{
+		testimonialRepo: repo,
+		blobStore:       blobStore,
+	}
+}
+
+// Handle открывает файл отзыва или его уменьшенную копию;
+// для отзыва без файла возвращает uploads.ErrFileNotFound, для файла без копий - uploads.ErrVariantNotFound
+func (h *GetTestimonialFileQueryHandler) Handle(ctx context.Context, query dtos.GetTestimonialFileQuery) (*dtos.TestimonialFile, error) {
+	testimonial, err := h.testimonialRepo.FindByID(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := resolveTestimonialFile(testimonial, query.Variant, query.PublishedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	content, info, err := h.blobStore.Get(ctx, file.key)
+	if errors.Is(err, storage.ErrBlobNotFound) && query.Variant != "" {
+		// Копии не создавались для изображений, загруженных до их появления
+		return nil, uploads.ErrVariantNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	size := testimonial.FileSize
+	if query.Variant != "" {
+		size = info.Size
+	}
+
+	return &dtos.TestimonialFile{
+		FileName:  file.fileName,
+		FileType:  file.fileType,
+		FileSize:  size,
+		UpdatedAt: testimonial.UpdatedAt,
+		Content:   content,
+	}, nil
+}
+
+// testimonialFile расположение файла отзыва или его уменьшенной копии в хранилище
+type testimonialFile struct {
+	key      string
+	fileName string
+	fileType string
+}
+
+// resolveTestimonialFile возвращает ключ, имя и тип запрошенного варианта файла; пустой variant - оригинал.
+// При publishedOnly файл неопубликованного отзыва считается отсутствующим.
+func resolveTestimonialFile(testimonial *entities.Testimonial, variantName string, publishedOnly bool) (*testimonialFile, error) {
+	if !testimonial.HasFile() || (publishedOnly && !testimonial.IsPublished()) {
+		return nil, uploads.ErrFileNotFound
+	}
+
+	if variantName == "" {
+		return &testimonialFile{
+			key:      testimonial.FilePath,
+			fileName: testimonial.FileName,
+			fileType: testimonial.FileType,
+		}, nil
+	}
+
+	variant, err := uploads.FindImageVariant(variantName)
+	if err != nil {
+		return nil, err
+	}
+	if !uploads.HasVariants(testimonial.FilePath) {
+		return nil, uploads.ErrVariantNotFound
+	}
+
+	return &testimonialFile{
+		key:      uploads.VariantKey(testimonial.FilePath, variant),
+		fileName: uploads.VariantFileName(testimonial.FileName, testimonial.FilePath, variant),
+		fileType: uploads.VariantContentType(testimonial.FilePath),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package queries
+
+import (
+	"context"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/application/uploads"
+	"time"
+)
+
+// fileURLTTL срок действия ссылки на файл отзыва
+const fileURLTTL = 15 * time.Minute
+
+type GetTestimonialFileURLQueryHandler struct {
+	testimonialRepo repositories.TestimonialRepository
+	blobStore       storage.BlobStore
+}
+
+func NewGetTestimonialFileURLQueryHandler(repo repositories.TestimonialRepository, blobStore storage.BlobStore) *GetTestimonialFileURLQueryHandler {
+	return &GetTestimonialFileURLQueryHandler{
+		testimonialRepo: repo,
+		blobStore:       blobStore,
+	}
+}
+
+// Handle подписывает ссылку на файл отзыва; по ссылке файл отдается с исходным именем и типом
+func (h *GetTestimonialFileURLQueryHandler) Handle(ctx context.Context, query dtos.GetTestimonialFileURLQuery) (*dtos.QueryResult, error) {
+	testimonial, err := h.testimonialRepo.FindByID(ctx, query.ID)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	file, err := resolveTestimonialFile(testimonial, query.Variant, query.PublishedOnly)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	expiresAt := time.Now().Add(fileURLTTL)
+	url, err := h.blobStore.SignedURL(ctx, file.key, fileURLTTL, storage.URLOptions{
+		ContentType:        file.fileType,
+		ContentDisposition: uploads.ContentDisposition(file.fileType, file.fileName),
+	})
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Success:   true,
+		Message:   "File URL created successfully",
+		FileURL:   &dtos.TestimonialFileURL{URL: url, ExpiresAt: expiresAt},
+		Timestamp: time.Now(),
+	}, nil
+}
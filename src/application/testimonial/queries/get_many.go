@@ -42,6 +42,11 @@ func (h *GetTestimonialsQueryHandler) Handle(ctx context.Context, query dtos.Get
 			},
 		},
 		Filters: query.Filters,
+		Where:   query.Where,
+	}
+
+	if query.Cursor != nil {
+		return h.handleCursor(ctx, *query.Cursor, query.Limit, opts)
 	}
 
 	paginated, err := h.testimonialRepo.FindWithPagination(ctx, opts)
@@ -60,3 +65,24 @@ func (h *GetTestimonialsQueryHandler) Handle(ctx context.Context, query dtos.Get
 		Timestamp: time.Now(),
 	}, nil
 }
+
+func (h *GetTestimonialsQueryHandler) handleCursor(ctx context.Context, cursor string, limit int, opts *models.QueryOptions) (*dtos.QueryResult, error) {
+	opts.Pagination = nil
+	opts.Cursor = &models.CursorParams{Cursor: cursor, Limit: limit}
+
+	page, err := h.testimonialRepo.FindWithCursor(ctx, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find testimonials: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Success:         true,
+		Message:         "Testimonials retrieved successfully",
+		CursorPaginated: page,
+		Timestamp:       time.Now(),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/testimonial/dtos"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// ErrNotInModerationQueue статус не относится к очереди модерации
+var ErrNotInModerationQueue = fmt.Errorf("status must be one of %v or %s",
+	entities.ModerationQueueStatuses, entities.TestimonialStatusSuspectedSpam)
+
+type GetModerationQueueQueryHandler struct {
+	testimonialRepo repositories.TestimonialRepository
+}
+
+func NewGetModerationQueueQueryHandler(repo repositories.TestimonialRepository) *GetModerationQueueQueryHandler {
+	return &GetModerationQueueQueryHandler{
+		testimonialRepo: repo,
+	}
+}
+
+// Handle возвращает отзывы в статусах submitted и in_review; дольше всех ожидающие отзывы идут первыми.
+// Подозрительные на спам отзывы в общую очередь не попадают и запрашиваются отдельно статусом suspected_spam.
+func (h *GetModerationQueueQueryHandler) Handle(ctx context.Context, query dtos.GetModerationQueueQuery) (*dtos.QueryResult, error) {
+	if query.Limit == 0 {
+		query.Limit = 20
+	}
+
+	statuses := entities.ModerationQueueStatuses
+	if query.Status != "" {
+		if !isQueueStatus(query.Status) {
+			return &dtos.QueryResult{
+				Success:   false,
+				Error:     ErrNotInModerationQueue.Error(),
+				Timestamp: time.Now(),
+			}, ErrNotInModerationQueue
+		}
+		statuses = []entities.TestimonialStatus{query.Status}
+	}
+
+	values := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		values = append(values, string(status))
+	}
+
+	opts := &models.QueryOptions{
+		Pagination: &models.PaginationParams{
+			Offset: query.Offset,
+			Limit:  query.Limit,
+		},
+		SortBy: []models.SortBy{
+			{Field: "createdAt", Order: models.SortOrder("asc")},
+			{Field: "id", Order: models.SortOrder("asc")},
+		},
+		Where: models.NewFilterGroup(models.FilterAnd).Add("status", models.FilterIn, values),
+	}
+
+	paginated, err := h.testimonialRepo.FindWithPagination(ctx, opts)
+	if err != nil {
+		return &dtos.QueryResult{
+			Success:   false,
+			Error:     fmt.Sprintf("failed to find moderation queue: %v", err),
+			Timestamp: time.Now(),
+		}, err
+	}
+
+	return &dtos.QueryResult{
+		Success:   true,
+		Message:   "Moderation queue retrieved successfully",
+		Paginated: paginated,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+func isQueueStatus(status entities.TestimonialStatus) bool {
+	if status == entities.TestimonialStatusSuspectedSpam {
+		return true
+	}
+	for _, queued := range entities.ModerationQueueStatuses {
+		if queued == status {
+			return true
+		}
+	}
+	return false
+}
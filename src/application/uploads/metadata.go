@@ -0,0 +1,136 @@
+package uploads
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"path"
+)
+
+// pngSignature первые байты любого PNG файла
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// pngMetadataChunks чанки PNG с EXIF и текстовыми метаданными (в iTXt хранится XMP)
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"iTXt": true,
+	"zTXt": true,
+}
+
+// StripMetadata удаляет из оригинала изображения EXIF, XMP и IPTC (в том числе координаты съемки)
+// без перекодирования пикселей. Для JPEG поворот из EXIF сохраняется в минимальном блоке EXIF
+// только с тегом Orientation, иначе снимок отображался бы повернутым. Прочие форматы не меняются.
+func StripMetadata(key string, data []byte) ([]byte, error) {
+	switch path.Ext(key) {
+	case ".jpg":
+		return stripJPEGMetadata(data)
+	case ".png":
+		return stripPNGMetadata(data)
+	}
+	return data, nil
+}
+
+// stripJPEGMetadata удаляет сегменты APP1 (EXIF, XMP) и APP13 (IPTC) до начала данных изображения
+func stripJPEGMetadata(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, fmt.Errorf("%w: missing JPEG start marker", ErrInvalidImage)
+	}
+	orientation := jpegOrientation(data)
+
+	var out bytes.Buffer
+	out.Grow(len(data))
+	out.Write(data[:2])
+	orientationWritten := orientation == 1
+
+	for pos := 2; ; {
+		if pos+2 > len(data) || data[pos] != 0xFF {
+			return nil, fmt.Errorf("%w: malformed JPEG segment at offset %d", ErrInvalidImage, pos)
+		}
+		marker := data[pos+1]
+		if marker == 0xFF {
+			pos++
+			continue
+		}
+		// С SOS начинаются сжатые данные, дальше метаданных нет
+		if marker == 0xDA || marker == 0xD9 {
+			if !orientationWritten {
+				out.Write(orientationSegment(orientation))
+			}
+			out.Write(data[pos:])
+			return out.Bytes(), nil
+		}
+
+		if pos+4 > len(data) {
+			return nil, fmt.Errorf("%w: malformed JPEG segment at offset %d", ErrInvalidImage, pos)
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, fmt.Errorf("%w: malformed JPEG segment at offset %d", ErrInvalidImage, pos)
+		}
+		end := pos + 2 + length
+
+		if marker == 0xE1 || marker == 0xED {
+			// Поворот записывается на место первого удаленного сегмента, рядом с JFIF
+			if !orientationWritten {
+				out.Write(orientationSegment(orientation))
+				orientationWritten = true
+			}
+		} else {
+			out.Write(data[pos:end])
+		}
+		pos = end
+	}
+}
+
+// orientationSegment сегмент APP1 с EXIF из одного тега Orientation
+func orientationSegment(orientation int) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08, // заголовок TIFF, IFD0 со смещения 8
+		0x00, 0x01, // одна запись
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, // Orientation, SHORT, одно значение
+		0x00, byte(orientation), 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, // следующего IFD нет
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	segment := []byte{0xFF, 0xE1, 0x00, 0x00}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// stripPNGMetadata удаляет чанки eXIf и текстовые чанки; остальные копируются вместе с их CRC
+func stripPNGMetadata(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, fmt.Errorf("%w: missing PNG signature", ErrInvalidImage)
+	}
+
+	var out bytes.Buffer
+	out.Grow(len(data))
+	out.Write(pngSignature)
+
+	for pos := len(pngSignature); pos < len(data); {
+		if pos+12 > len(data) {
+			return nil, fmt.Errorf("%w: truncated PNG chunk at offset %d", ErrInvalidImage, pos)
+		}
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length
+		if end > len(data) || end < pos {
+			return nil, fmt.Errorf("%w: truncated PNG chunk at offset %d", ErrInvalidImage, pos)
+		}
+		chunkType := string(data[pos+4 : pos+8])
+		if crc32.ChecksumIEEE(data[pos+4:end-4]) != binary.BigEndian.Uint32(data[end-4:]) {
+			return nil, fmt.Errorf("%w: bad CRC in PNG chunk %s", ErrInvalidImage, chunkType)
+		}
+
+		if !pngMetadataChunks[chunkType] {
+			out.Write(data[pos:end])
+		}
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	return out.Bytes(), nil
+}
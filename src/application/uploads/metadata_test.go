@@ -0,0 +1,143 @@
+package uploads
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func testImage() image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 4))
+	for x := 0; x < 8; x++ {
+		img.Set(x, 0, color.RGBA{R: 200, A: 255})
+	}
+	return img
+}
+
+// exifSegment APP1 с тегом Orientation и координатами съемки в данных после IFD0
+func exifSegment(orientation int, trailer string) []byte {
+	segment := orientationSegment(orientation)
+	segment = append(segment, trailer...)
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(segment)-2))
+	return segment
+}
+
+func appSegment(marker byte, payload string) []byte {
+	segment := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+func TestStripMetadataJPEG(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, testImage(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	const gps = "GPSLatitude 55.7558N GPSLongitude 37.6173E"
+	const xmp = "http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta>exif:GPSLatitude</x:xmpmeta>"
+	const iptc = "Photoshop 3.0\x008BIM City=Moscow"
+
+	var original []byte
+	original = append(original, encoded.Bytes()[:2]...)
+	original = append(original, exifSegment(6, gps)...)
+	original = append(original, appSegment(0xE1, xmp)...)
+	original = append(original, appSegment(0xED, iptc)...)
+	original = append(original, encoded.Bytes()[2:]...)
+
+	stripped, err := StripMetadata("testimonials/photo.jpg", original)
+	if err != nil {
+		t.Fatalf("StripMetadata: %v", err)
+	}
+
+	for _, secret := range []string{"GPSLatitude", "xmpmeta", "City=Moscow"} {
+		if bytes.Contains(stripped, []byte(secret)) {
+			t.Fatalf("stripped JPEG still contains %q", secret)
+		}
+	}
+	if got := jpegOrientation(stripped); got != 6 {
+		t.Fatalf("orientation after strip = %d, want 6", got)
+	}
+	if _, err := jpeg.Decode(bytes.NewReader(stripped)); err != nil {
+		t.Fatalf("stripped JPEG does not decode: %v", err)
+	}
+	// Сжатые данные копируются без перекодирования
+	if !bytes.HasSuffix(stripped, encoded.Bytes()[2:]) {
+		t.Fatal("image data changed after strip")
+	}
+}
+
+func TestStripMetadataJPEGWithoutEXIF(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, testImage(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	stripped, err := StripMetadata("testimonials/photo.jpg", encoded.Bytes())
+	if err != nil {
+		t.Fatalf("StripMetadata: %v", err)
+	}
+	if !bytes.Equal(stripped, encoded.Bytes()) {
+		t.Fatal("JPEG without metadata must not change")
+	}
+}
+
+func TestStripMetadataRejectsMalformedJPEG(t *testing.T) {
+	truncated := []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x10, 0x00, 'E', 'x'}
+	if _, err := StripMetadata("testimonials/photo.jpg", truncated); !errors.Is(err, ErrInvalidImage) {
+		t.Fatalf("StripMetadata: %v, want ErrInvalidImage", err)
+	}
+}
+
+func pngChunk(chunkType, data string) []byte {
+	chunk := make([]byte, 4, 12+len(data))
+	binary.BigEndian.PutUint32(chunk, uint32(len(data)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, data...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+func TestStripMetadataPNG(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, testImage()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Метаданные вставляются перед IEND, последним чанком длиной 12 байт
+	data := encoded.Bytes()
+	iend := len(data) - 12
+	var original []byte
+	original = append(original, data[:iend]...)
+	original = append(original, pngChunk("eXIf", "MM\x00\x2aGPSLatitude")...)
+	original = append(original, pngChunk("tEXt", "Comment\x00taken at home")...)
+	original = append(original, pngChunk("iTXt", "XML:com.adobe.xmp\x00\x00\x00\x00\x00<x:xmpmeta/>")...)
+	original = append(original, data[iend:]...)
+
+	stripped, err := StripMetadata("testimonials/photo.png", original)
+	if err != nil {
+		t.Fatalf("StripMetadata: %v", err)
+	}
+	if !bytes.Equal(stripped, data) {
+		t.Fatal("stripped PNG must equal the image without metadata chunks")
+	}
+	if _, err := png.Decode(bytes.NewReader(stripped)); err != nil {
+		t.Fatalf("stripped PNG does not decode: %v", err)
+	}
+}
+
+func TestStripMetadataKeepsOtherFormats(t *testing.T) {
+	data := []byte("%PDF-1.7 /Author (someone)")
+	stripped, err := StripMetadata("testimonials/file.pdf", data)
+	if err != nil {
+		t.Fatalf("StripMetadata: %v", err)
+	}
+	if !bytes.Equal(stripped, data) {
+		t.Fatal("non-image files must not change")
+	}
+}
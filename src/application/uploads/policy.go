@@ -0,0 +1,111 @@
+package uploads
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// sniffLen количество байт, по которым http.DetectContentType определяет тип
+const sniffLen = 512
+
+var (
+	// ErrUnsupportedType тип файла, определенный по содержимому, не разрешен
+	ErrUnsupportedType = errors.New("unsupported file type")
+	// ErrFileTooLarge файл превышает допустимый размер
+	ErrFileTooLarge = errors.New("file is too large")
+	// ErrEmptyFile файл не содержит данных
+	ErrEmptyFile = errors.New("file is empty")
+	// ErrFileNotFound к сущности не прикреплен файл
+	ErrFileNotFound = errors.New("file not found")
+)
+
+// Policy ограничения для загружаемых файлов
+type Policy struct {
+	MaxSize int64
+	// AllowedTypes разрешенные MIME типы и расширения, с которыми сохраняются файлы
+	AllowedTypes map[string]string
+}
+
+// NewAttachmentPolicy создает политику для вложений: PDF и изображения размером до maxSize байт
+func NewAttachmentPolicy(maxSize int64) *Policy {
+	return &Policy{
+		MaxSize: maxSize,
+		AllowedTypes: map[string]string{
+			"application/pdf": ".pdf",
+			"image/jpeg":      ".jpg",
+			"image/png":       ".png",
+			"image/gif":       ".gif",
+		},
+	}
+}
+
+// Inspected файл, прошедший проверку типа
+type Inspected struct {
+	ContentType string
+	Extension   string
+	// Content полное содержимое файла; чтение сверх MaxSize возвращает ErrFileTooLarge
+	Content io.Reader
+}
+
+// Inspect определяет тип файла по первым байтам содержимого, а не по заголовкам клиента,
+// и ограничивает размер читаемых данных
+func (p *Policy) Inspect(content io.Reader) (*Inspected, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrEmptyFile
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	ext, ok := p.AllowedTypes[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, contentType)
+	}
+
+	return &Inspected{
+		ContentType: contentType,
+		Extension:   ext,
+		Content:     &limitedReader{r: io.MultiReader(bytes.NewReader(head), content), remaining: p.MaxSize},
+	}, nil
+}
+
+// limitedReader в отличие от io.LimitReader сообщает о превышении лимита ошибкой
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, ErrFileTooLarge
+	}
+	// Читаем на байт больше лимита, чтобы отличить файл ровно MaxSize от более длинного
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, ErrFileTooLarge
+	}
+	return n, err
+}
+
+// ContentDisposition возвращает заголовок Content-Disposition для отдачи файла:
+// изображения показываются в браузере, остальные файлы скачиваются
+func ContentDisposition(contentType, fileName string) string {
+	disposition := "attachment"
+	if strings.HasPrefix(contentType, "image/") {
+		disposition = "inline"
+	}
+	return mime.FormatMediaType(disposition, map[string]string{"filename": fileName})
+}
@@ -0,0 +1,202 @@
+package uploads
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+const (
+	// maxImagePixels предел размера изображения, которое декодируется для уменьшенных копий;
+	// защищает от файлов небольшого размера с огромными размерами кадра. 16 Мп - снимок современного
+	// телефона; в памяти такое изображение занимает около 64 МБ в RGBA и столько же при декодировании
+	maxImagePixels = 16_000_000
+	// maxConcurrentRenders число изображений, которые декодируются одновременно
+	maxConcurrentRenders = 2
+	// variantJPEGQuality качество JPEG для уменьшенных копий
+	variantJPEGQuality = 85
+)
+
+// renderSlots ограничивает память на уменьшенные копии: отправка отзыва доступна анонимно,
+// и параллельные загрузки больших изображений не должны декодироваться все сразу
+var renderSlots = make(chan struct{}, maxConcurrentRenders)
+
+// RenderedVariant закодированная уменьшенная копия изображения
+type RenderedVariant struct {
+	Variant     ImageVariant
+	Key         string
+	ContentType string
+	Content     []byte
+}
+
+// RenderVariants создает уменьшенные копии изображения, сохраненного под ключом key.
+// Копии кодируются заново, поэтому метаданные оригинала (EXIF, координаты съемки) в них не попадают;
+// поворот из EXIF применяется к пикселям. Изображения меньше варианта не увеличиваются.
+func RenderVariants(key string, data []byte) ([]RenderedVariant, error) {
+	if !HasVariants(key) {
+		return nil, nil
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if int64(config.Width)*int64(config.Height) > maxImagePixels {
+		return nil, fmt.Errorf("%w: image dimensions %dx%d exceed the limit", ErrFileTooLarge, config.Width, config.Height)
+	}
+
+	renderSlots <- struct{}{}
+	defer func() { <-renderSlots }()
+
+	decoded, err := decodeImage(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	orientation := 1
+	if format == "jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	// Приводим к RGBA с началом координат в нуле, с ним работают масштабирование и поворот
+	bounds := decoded.Bounds()
+	source := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(source, source.Bounds(), decoded, bounds.Min, draw.Src)
+
+	contentType := VariantContentType(key)
+	rendered := make([]RenderedVariant, 0, len(ImageVariants))
+	for _, variant := range ImageVariants {
+		width, height := fitDimensions(source.Rect.Dx(), source.Rect.Dy(), variant.MaxDimension)
+		resized := orient(downscale(source, width, height), orientation)
+
+		var buf bytes.Buffer
+		if contentType == "image/jpeg" {
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: variantJPEGQuality})
+		} else {
+			err = png.Encode(&buf, resized)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s variant: %w", variant.Name, err)
+		}
+
+		rendered = append(rendered, RenderedVariant{
+			Variant:     variant,
+			Key:         VariantKey(key, variant),
+			ContentType: contentType,
+			Content:     buf.Bytes(),
+		})
+	}
+
+	return rendered, nil
+}
+
+// decodeImage декодирует изображение; для GIF берется первый кадр
+func decodeImage(format string, data []byte) (image.Image, error) {
+	switch format {
+	case "jpeg":
+		return jpeg.Decode(bytes.NewReader(data))
+	case "png":
+		return png.Decode(bytes.NewReader(data))
+	case "gif":
+		return gif.Decode(bytes.NewReader(data))
+	}
+	return nil, fmt.Errorf("unsupported image format %s", format)
+}
+
+// fitDimensions вписывает размеры в квадрат maxDimension с сохранением пропорций
+func fitDimensions(width, height, maxDimension int) (int, int) {
+	if width <= maxDimension && height <= maxDimension {
+		return width, height
+	}
+	if width >= height {
+		return maxDimension, max(1, height*maxDimension/width)
+	}
+	return max(1, width*maxDimension/height), maxDimension
+}
+
+// downscale уменьшает изображение усреднением по области: каждый пиксель копии - среднее
+// покрываемого им прямоугольника оригинала. Пиксели RGBA хранятся с premultiplied alpha,
+// поэтому прозрачные области не окрашивают соседние.
+func downscale(src *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := src.Rect.Dx(), src.Rect.Dy()
+	if width == srcWidth && height == srcHeight {
+		return src
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*srcHeight/height, (y+1)*srcHeight/height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+
+		for x := 0; x < width; x++ {
+			x0, x1 := x*srcWidth/width, (x+1)*srcWidth/width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride+x0*4 : sy*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					r += uint64(row[i])
+					g += uint64(row[i+1])
+					b += uint64(row[i+2])
+					a += uint64(row[i+3])
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// orient поворачивает и отражает изображение по значению EXIF Orientation (1-8)
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	dstWidth, dstHeight := width, height
+	// Ориентации 5-8 поворачивают кадр на 90 градусов
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = width-1-x, y
+			case 3:
+				dx, dy = width-1-x, height-1-y
+			case 4:
+				dx, dy = x, height-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = height-1-y, x
+			case 7:
+				dx, dy = height-1-y, width-1-x
+			case 8:
+				dx, dy = y, width-1-x
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(x, y):src.PixOffset(x, y)+4])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,82 @@
+package uploads
+
+import (
+	"errors"
+	"path"
+	"strings"
+)
+
+var (
+	// ErrUnknownVariant запрошен несуществующий вариант изображения
+	ErrUnknownVariant = errors.New("unknown image variant")
+	// ErrVariantNotFound для файла нет уменьшенных копий: это не изображение или оно загружено до их появления
+	ErrVariantNotFound = errors.New("image variant not found")
+	// ErrInvalidImage файл определен как изображение, но не декодируется
+	ErrInvalidImage = errors.New("invalid image")
+)
+
+// ImageVariant уменьшенная копия изображения, вписанная в квадрат MaxDimension
+type ImageVariant struct {
+	Name         string
+	MaxDimension int
+}
+
+// ImageVariants варианты, которые создаются для каждого загруженного изображения
+var ImageVariants = []ImageVariant{
+	{Name: "thumb", MaxDimension: 128},
+	{Name: "preview", MaxDimension: 512},
+}
+
+// FindImageVariant возвращает вариант по имени или ErrUnknownVariant
+func FindImageVariant(name string) (ImageVariant, error) {
+	for _, variant := range ImageVariants {
+		if variant.Name == name {
+			return variant, nil
+		}
+	}
+	return ImageVariant{}, ErrUnknownVariant
+}
+
+// variantFormats формат уменьшенной копии по расширению оригинала:
+// фотографии остаются JPEG, PNG и GIF сохраняются в PNG, чтобы не потерять прозрачность
+var variantFormats = map[string]struct {
+	Extension   string
+	ContentType string
+}{
+	".jpg": {Extension: ".jpg", ContentType: "image/jpeg"},
+	".png": {Extension: ".png", ContentType: "image/png"},
+	".gif": {Extension: ".png", ContentType: "image/png"},
+}
+
+// HasVariants сообщает, создаются ли уменьшенные копии для файла с ключом key
+func HasVariants(key string) bool {
+	_, ok := variantFormats[path.Ext(key)]
+	return ok
+}
+
+// VariantKey возвращает ключ уменьшенной копии рядом с оригиналом: testimonials/<uuid>_thumb.jpg
+func VariantKey(key string, variant ImageVariant) string {
+	ext := path.Ext(key)
+	return strings.TrimSuffix(key, ext) + "_" + variant.Name + variantFormats[ext].Extension
+}
+
+// VariantContentType возвращает MIME тип уменьшенной копии файла с ключом key
+func VariantContentType(key string) string {
+	return variantFormats[path.Ext(key)].ContentType
+}
+
+// VariantFileName возвращает имя файла уменьшенной копии для скачивания: photo.jpg -> photo_thumb.jpg
+func VariantFileName(fileName, key string, variant ImageVariant) string {
+	return strings.TrimSuffix(fileName, path.Ext(fileName)) + "_" + variant.Name + variantFormats[path.Ext(key)].Extension
+}
+
+// StoredKeys возвращает ключи оригинала и всех его уменьшенных копий
+func StoredKeys(key string) []string {
+	keys := []string{key}
+	if HasVariants(key) {
+		for _, variant := range ImageVariants {
+			keys = append(keys, VariantKey(key, variant))
+		}
+	}
+	return keys
+}
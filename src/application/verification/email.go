@@ -0,0 +1,106 @@
+package verification
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"tax-priority-api/src/application/mail"
+	"tax-priority-api/src/domain/entities"
+)
+
+var (
+	// ErrInvalidToken токен поврежден, подписан другим ключом или выдан для другого email
+	ErrInvalidToken = errors.New("invalid verification token")
+	// ErrTokenExpired срок действия токена истек
+	ErrTokenExpired = errors.New("verification token expired")
+)
+
+// Claims данные токена подтверждения email. Вместо адреса хранится его хеш:
+// токен передается в ссылке и может попасть в журналы прокси.
+type Claims struct {
+	TestimonialID string    `json:"tid"`
+	EmailHash     string    `json:"eh"`
+	ExpiresAt     time.Time `json:"exp"`
+}
+
+// NewClaims создает данные токена для отзыва с email автора
+func NewClaims(testimonialID, email string, expiresAt time.Time) Claims {
+	return Claims{
+		TestimonialID: testimonialID,
+		EmailHash:     hashEmail(email),
+		ExpiresAt:     expiresAt,
+	}
+}
+
+// MatchesEmail - проверяет, что токен выдан для email; после смены email автора старый токен не подходит
+func (c Claims) MatchesEmail(email string) bool {
+	return subtle.ConstantTimeCompare([]byte(c.EmailHash), []byte(hashEmail(email))) == 1
+}
+
+func hashEmail(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return fmt.Sprintf("%x", sum)
+}
+
+// Tokens выдает и проверяет подписанные токены подтверждения email
+type Tokens interface {
+	// Issue - подписывает токен для отзыва; срок действия задает реализация
+	Issue(testimonialID, email string) (token string, expiresAt time.Time, err error)
+	// Parse - проверяет подпись и срок действия; ошибки ErrInvalidToken и ErrTokenExpired
+	Parse(token string) (*Claims, error)
+}
+
+// Sender отправляет автору отзыва письмо со ссылкой подтверждения email
+type Sender struct {
+	tokens    Tokens
+	mailer    mail.Mailer
+	templates *mail.Templates
+	// linkURL адрес GET /testimonials/verify, к которому добавляется параметр token
+	linkURL string
+}
+
+func NewSender(tokens Tokens, mailer mail.Mailer, templates *mail.Templates, linkURL string) *Sender {
+	return &Sender{
+		tokens:    tokens,
+		mailer:    mailer,
+		templates: templates,
+		linkURL:   linkURL,
+	}
+}
+
+// Send - выдает токен и отправляет письмо на email автора отзыва
+func (s *Sender) Send(ctx context.Context, testimonial *entities.Testimonial) error {
+	token, expiresAt, err := s.tokens.Issue(testimonial.ID, testimonial.AuthorEmail)
+	if err != nil {
+		return fmt.Errorf("failed to issue verification token: %w", err)
+	}
+
+	link, err := url.Parse(s.linkURL)
+	if err != nil {
+		return fmt.Errorf("invalid verification link URL: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	message, err := s.templates.Render(mail.TemplateTestimonialVerification, struct {
+		Author    string
+		Link      string
+		ExpiresAt time.Time
+	}{
+		Author:    testimonial.Author,
+		Link:      link.String(),
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	message.To = []string{testimonial.AuthorEmail}
+	return s.mailer.Send(ctx, message)
+}
@@ -0,0 +1,150 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// APIKeyScopes scopes, которые можно выдать API ключу
+var APIKeyScopes = []string{"api:read", "api:write"}
+
+// APIKey ключ доступа для серверных клиентов (импорт из CMS, SSR сайта).
+// Ключ хранится только в виде хеша; Prefix - открытая часть ключа для поиска и отображения.
+type APIKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedBy  string     `json:"createdBy,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+// Реализация интерфейса Entity
+
+// GetID - возвращает ID
+func (k *APIKey) GetID() string {
+	return k.ID
+}
+
+// SetID - устанавливает ID
+func (k *APIKey) SetID(id string) {
+	k.ID = id
+}
+
+// GetCreatedAt - возвращает время создания
+func (k *APIKey) GetCreatedAt() time.Time {
+	return k.CreatedAt
+}
+
+// SetCreatedAt - устанавливает время создания
+func (k *APIKey) SetCreatedAt(t time.Time) {
+	k.CreatedAt = t
+}
+
+// GetUpdatedAt - возвращает время обновления
+func (k *APIKey) GetUpdatedAt() time.Time {
+	return k.UpdatedAt
+}
+
+// SetUpdatedAt - устанавливает время обновления
+func (k *APIKey) SetUpdatedAt(t time.Time) {
+	k.UpdatedAt = t
+}
+
+// Бизнес-логика
+
+// NewAPIKey - создает APIKey по открытой части и хешу сгенерированного ключа
+func NewAPIKey(name, prefix, keyHash string, scopes []string, expiresAt *time.Time, createdBy string) (*APIKey, error) {
+	key := &APIKey{
+		Name:      strings.TrimSpace(name),
+		Prefix:    prefix,
+		KeyHash:   keyHash,
+		Scopes:    normalizeScopes(scopes),
+		ExpiresAt: expiresAt,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+	if expiresAt != nil && !expiresAt.After(key.CreatedAt) {
+		return nil, errors.New("expiration time must be in the future")
+	}
+
+	return key, nil
+}
+
+// Revoke - отзывает ключ; повторный отзыв не меняет время отзыва
+func (k *APIKey) Revoke() {
+	if k.RevokedAt != nil {
+		return
+	}
+	now := time.Now()
+	k.RevokedAt = &now
+	k.UpdatedAt = now
+}
+
+// IsRevoked - проверяет, отозван ли ключ
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IsExpired - проверяет, истек ли срок действия ключа на момент now
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// IsUsable - проверяет, можно ли аутентифицироваться ключом на момент now
+func (k *APIKey) IsUsable(now time.Time) bool {
+	return !k.IsRevoked() && !k.IsExpired(now)
+}
+
+// Validate - проверяет валидность APIKey
+func (k *APIKey) Validate() error {
+	if k.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+
+	if utf8.RuneCountInString(k.Name) > 100 {
+		return errors.New("name cannot exceed 100 characters")
+	}
+
+	if k.Prefix == "" || k.KeyHash == "" {
+		return errors.New("key prefix and hash are required")
+	}
+
+	if len(k.Scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+
+	for _, scope := range k.Scopes {
+		if !slices.Contains(APIKeyScopes, scope) {
+			return fmt.Errorf("unknown scope %q, allowed: %s", scope, strings.Join(APIKeyScopes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// normalizeScopes убирает пробелы и повторы, сохраняя порядок
+func normalizeScopes(scopes []string) []string {
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope != "" && !slices.Contains(result, scope) {
+			result = append(result, scope)
+		}
+	}
+	return result
+}
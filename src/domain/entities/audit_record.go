@@ -0,0 +1,125 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AuditEntityType тип сущности, изменение которой записано в журнал аудита
+type AuditEntityType string
+
+const (
+	AuditEntityFAQ         AuditEntityType = "faq"
+	AuditEntityTestimonial AuditEntityType = "testimonial"
+	AuditEntityFeature     AuditEntityType = "feature"
+)
+
+// AuditAction действие, записанное в журнал аудита
+type AuditAction string
+
+const (
+	AuditActionCreated       AuditAction = "created"
+	AuditActionUpdated       AuditAction = "updated"
+	AuditActionActivated     AuditAction = "activated"
+	AuditActionDeactivated   AuditAction = "deactivated"
+	AuditActionDeleted       AuditAction = "deleted"
+	AuditActionRestored      AuditAction = "restored"
+	AuditActionApproved      AuditAction = "approved"
+	AuditActionReviewStarted AuditAction = "review_started"
+	AuditActionRejected      AuditAction = "rejected"
+	AuditActionArchived      AuditAction = "archived"
+	AuditActionEmailVerified AuditAction = "email_verified"
+	AuditActionFileUploaded  AuditAction = "file_uploaded"
+	AuditActionFileRemoved   AuditAction = "file_removed"
+	AuditActionTrashPurged   AuditAction = "trash_purged"
+	AuditActionReordered     AuditAction = "reordered"
+)
+
+// AuditRecord запись журнала аудита: кто, когда, из какого запроса и как изменил сущность.
+// Записи только добавляются; Before и After - JSON снимки сущности до и после изменения.
+type AuditRecord struct {
+	ID         string          `json:"id"`
+	Actor      string          `json:"actor,omitempty"`
+	Action     AuditAction     `json:"action"`
+	EntityType AuditEntityType `json:"entityType"`
+	// EntityID пустой для действий над несколькими записями, например очистки корзины
+	EntityID  string          `json:"entityId,omitempty"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	RequestID string          `json:"requestId,omitempty"`
+	ClientIP  string          `json:"clientIp,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// Реализация интерфейса Entity; записи аудита не изменяются, поэтому обе даты совпадают с CreatedAt
+
+// GetID - возвращает ID
+func (r *AuditRecord) GetID() string {
+	return r.ID
+}
+
+// SetID - устанавливает ID
+func (r *AuditRecord) SetID(id string) {
+	r.ID = id
+}
+
+// GetCreatedAt - возвращает время создания
+func (r *AuditRecord) GetCreatedAt() time.Time {
+	return r.CreatedAt
+}
+
+// SetCreatedAt - устанавливает время создания
+func (r *AuditRecord) SetCreatedAt(t time.Time) {
+	r.CreatedAt = t
+}
+
+// GetUpdatedAt - возвращает время обновления
+func (r *AuditRecord) GetUpdatedAt() time.Time {
+	return r.CreatedAt
+}
+
+// SetUpdatedAt - записи аудита неизменяемы, метод ничего не делает
+func (r *AuditRecord) SetUpdatedAt(time.Time) {}
+
+// NewAuditRecord - создает запись аудита; before и after сериализуются в JSON, nil означает отсутствие снимка
+func NewAuditRecord(actor string, action AuditAction, entityType AuditEntityType, entityID string, before, after any) (*AuditRecord, error) {
+	if action == "" {
+		return nil, errors.New("audit action cannot be empty")
+	}
+	if entityType == "" {
+		return nil, errors.New("audit entity type cannot be empty")
+	}
+
+	beforeJSON, err := marshalAuditSnapshot(before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state before change: %w", err)
+	}
+	afterJSON, err := marshalAuditSnapshot(after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state after change: %w", err)
+	}
+
+	return &AuditRecord{
+		Actor:      actor,
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Before:     beforeJSON,
+		After:      afterJSON,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// marshalAuditSnapshot сериализует снимок; nil и типизированный nil указатель дают пустой снимок
+func marshalAuditSnapshot(snapshot any) (json.RawMessage, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil || string(data) == "null" {
+		return nil, err
+	}
+	return data, nil
+}
@@ -1,6 +1,10 @@
 package entities
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 type Entity[ID comparable] interface {
 	GetID() ID
@@ -10,3 +14,20 @@ type Entity[ID comparable] interface {
 	GetUpdatedAt() time.Time
 	SetUpdatedAt(time.Time)
 }
+
+// ErrVersionConflict сущность уже изменена другим клиентом: ожидаемая версия не совпадает с текущей
+var ErrVersionConflict = errors.New("version conflict")
+
+// Versioned сущность с версией для оптимистичной блокировки; версия растет при каждом обновлении
+type Versioned interface {
+	GetVersion() int
+	SetVersion(version int)
+}
+
+// CheckVersion сравнивает версию сущности с ожидаемой клиентом; без ожидаемой версии проверка не выполняется
+func CheckVersion(entity Versioned, expected *int) error {
+	if expected == nil || *expected == entity.GetVersion() {
+		return nil
+	}
+	return fmt.Errorf("%w: expected version %d, current %d", ErrVersionConflict, *expected, entity.GetVersion())
+}
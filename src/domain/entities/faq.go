@@ -8,14 +8,18 @@ import (
 
 // FAQ представляет сущность часто задаваемых вопросов
 type FAQ struct {
-	ID        string    `json:"id"`
-	Question  string    `json:"question"`
-	Answer    string    `json:"answer"`
-	Category  string    `json:"category"`
-	IsActive  bool      `json:"isActive"`
-	Priority  int       `json:"priority"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID        string     `json:"id"`
+	Question  string     `json:"question"`
+	Answer    string     `json:"answer"`
+	Category  string     `json:"category"`
+	IsActive  bool       `json:"isActive"`
+	Priority  int        `json:"priority"`
+	CreatedBy string     `json:"createdBy,omitempty"`
+	UpdatedBy string     `json:"updatedBy,omitempty"`
+	Version   int        `json:"version"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 }
 
 // Реализация интерфейса Entity
@@ -50,6 +54,16 @@ func (f *FAQ) SetUpdatedAt(t time.Time) {
 	f.UpdatedAt = t
 }
 
+// GetVersion - возвращает версию
+func (f *FAQ) GetVersion() int {
+	return f.Version
+}
+
+// SetVersion - устанавливает версию
+func (f *FAQ) SetVersion(version int) {
+	f.Version = version
+}
+
 // Бизнес-логика
 
 // NewFAQ - создает новую FAQ сущность
@@ -60,6 +74,7 @@ func NewFAQ(question, answer, category string, priority int) (*FAQ, error) {
 		Category:  strings.TrimSpace(category),
 		IsActive:  true,
 		Priority:  priority,
+		Version:   1,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -71,6 +86,18 @@ func NewFAQ(question, answer, category string, priority int) (*FAQ, error) {
 	return faq, nil
 }
 
+// SetCreatedBy - устанавливает автора FAQ, он же последний редактор
+func (f *FAQ) SetCreatedBy(actor string) {
+	f.CreatedBy = actor
+	f.UpdatedBy = actor
+}
+
+// SetUpdatedBy - устанавливает последнего редактора FAQ
+func (f *FAQ) SetUpdatedBy(actor string) {
+	f.UpdatedBy = actor
+	f.UpdatedAt = time.Now()
+}
+
 // Validate - проверяет валидность FAQ
 func (f *FAQ) Validate() error {
 	if f.Question == "" {
@@ -0,0 +1,145 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// FAQRevisionAction тип изменения, зафиксированного в ревизии
+type FAQRevisionAction string
+
+const (
+	FAQRevisionCreated     FAQRevisionAction = "created"
+	FAQRevisionUpdated     FAQRevisionAction = "updated"
+	FAQRevisionActivated   FAQRevisionAction = "activated"
+	FAQRevisionDeactivated FAQRevisionAction = "deactivated"
+	FAQRevisionDeleted     FAQRevisionAction = "deleted"
+	FAQRevisionRestored    FAQRevisionAction = "restored"
+)
+
+// FAQSnapshot состояние редактируемых полей FAQ на момент ревизии
+type FAQSnapshot struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+	Category string `json:"category"`
+	Priority int    `json:"priority"`
+	IsActive bool   `json:"isActive"`
+}
+
+// NewFAQSnapshot - снимает состояние FAQ
+func NewFAQSnapshot(faq *FAQ) *FAQSnapshot {
+	if faq == nil {
+		return nil
+	}
+	return &FAQSnapshot{
+		Question: faq.Question,
+		Answer:   faq.Answer,
+		Category: faq.Category,
+		Priority: faq.Priority,
+		IsActive: faq.IsActive,
+	}
+}
+
+// FAQFieldChange изменение одного поля между двумя снимками
+type FAQFieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// Diff - возвращает список полей, отличающихся в other
+func (s *FAQSnapshot) Diff(other *FAQSnapshot) []FAQFieldChange {
+	from, to := s, other
+	if from == nil {
+		from = &FAQSnapshot{}
+	}
+	if to == nil {
+		to = &FAQSnapshot{}
+	}
+
+	changes := make([]FAQFieldChange, 0, 5)
+	add := func(field string, a, b any) {
+		if a != b {
+			changes = append(changes, FAQFieldChange{Field: field, From: a, To: b})
+		}
+	}
+
+	add("question", from.Question, to.Question)
+	add("answer", from.Answer, to.Answer)
+	add("category", from.Category, to.Category)
+	add("priority", from.Priority, to.Priority)
+	add("isActive", from.IsActive, to.IsActive)
+
+	return changes
+}
+
+// FAQRevision зафиксированное изменение FAQ: кто, когда и какие значения были до и после
+type FAQRevision struct {
+	ID        string            `json:"id"`
+	FAQID     string            `json:"faqId"`
+	Revision  int               `json:"revision"`
+	Action    FAQRevisionAction `json:"action"`
+	Before    *FAQSnapshot      `json:"before,omitempty"`
+	After     *FAQSnapshot      `json:"after,omitempty"`
+	ChangedBy string            `json:"changedBy,omitempty"`
+	ChangedAt time.Time         `json:"changedAt"`
+	// RestoredFrom номер ревизии, из которой восстановлено состояние (только для restored)
+	RestoredFrom *int `json:"restoredFrom,omitempty"`
+}
+
+// Реализация интерфейса Entity; ревизии не изменяются, поэтому обе даты совпадают с ChangedAt
+
+// GetID - возвращает ID
+func (r *FAQRevision) GetID() string {
+	return r.ID
+}
+
+// SetID - устанавливает ID
+func (r *FAQRevision) SetID(id string) {
+	r.ID = id
+}
+
+// GetCreatedAt - возвращает время создания
+func (r *FAQRevision) GetCreatedAt() time.Time {
+	return r.ChangedAt
+}
+
+// SetCreatedAt - устанавливает время создания
+func (r *FAQRevision) SetCreatedAt(t time.Time) {
+	r.ChangedAt = t
+}
+
+// GetUpdatedAt - возвращает время обновления
+func (r *FAQRevision) GetUpdatedAt() time.Time {
+	return r.ChangedAt
+}
+
+// SetUpdatedAt - ревизии неизменяемы, метод ничего не делает
+func (r *FAQRevision) SetUpdatedAt(time.Time) {}
+
+// NewFAQRevision - создает ревизию изменения FAQ; номер присваивается при сохранении
+func NewFAQRevision(faqID string, action FAQRevisionAction, before, after *FAQSnapshot, changedBy string) (*FAQRevision, error) {
+	if faqID == "" {
+		return nil, errors.New("faq id cannot be empty")
+	}
+	if before == nil && after == nil {
+		return nil, errors.New("revision must contain at least one snapshot")
+	}
+
+	return &FAQRevision{
+		FAQID:     faqID,
+		Action:    action,
+		Before:    before,
+		After:     after,
+		ChangedBy: changedBy,
+		ChangedAt: time.Now(),
+	}, nil
+}
+
+// State - состояние FAQ после ревизии; для удаления - последнее известное состояние
+func (r *FAQRevision) State() *FAQSnapshot {
+	if r.After != nil {
+		return r.After
+	}
+	return r.Before
+}
@@ -4,16 +4,25 @@ import (
 	"errors"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// Feature преимущество компании для блока «Почему выбирают нас»
 type Feature struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	IsActive  bool      `json:"isActive"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Icon        string     `json:"icon"`
+	SortOrder   int        `json:"sortOrder"`
+	IsActive    bool       `json:"isActive"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	DeletedAt   *time.Time `json:"deletedAt,omitempty"`
 }
 
+// Реализация интерфейса Entity
+
+// GetID - возвращает ID
 func (f *Feature) GetID() string {
 	return f.ID
 }
@@ -46,46 +55,79 @@ func (f *Feature) SetUpdatedAt(t time.Time) {
 // Бизнес-логика
 
 // NewFeature - создает новую Feature сущность
-func NewFeature(name string) (*Feature, error) {
-	faq := &Feature{
-		Name:      strings.TrimSpace(name),
-		IsActive:  true,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+func NewFeature(name, description, icon string, sortOrder int) (*Feature, error) {
+	feature := &Feature{
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		Icon:        strings.TrimSpace(icon),
+		SortOrder:   sortOrder,
+		IsActive:    true,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
 	}
 
-	if err := faq.Validate(); err != nil {
+	if err := feature.Validate(); err != nil {
 		return nil, err
 	}
 
-	return faq, nil
+	return feature, nil
 }
 
-func (f *Feature) SetName(name string) error {
+// Update - обновляет содержимое Feature
+func (f *Feature) Update(name, description, icon string) error {
 	f.Name = strings.TrimSpace(name)
+	f.Description = strings.TrimSpace(description)
+	f.Icon = strings.TrimSpace(icon)
+	f.UpdatedAt = time.Now()
+	return f.Validate()
+}
+
+// SetSortOrder - устанавливает позицию в списке
+func (f *Feature) SetSortOrder(sortOrder int) error {
+	if sortOrder < 0 {
+		return errors.New("sort order cannot be negative")
+	}
+	f.SortOrder = sortOrder
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// Activate - активирует Feature
+func (f *Feature) Activate() {
+	f.IsActive = true
+	f.UpdatedAt = time.Now()
+}
 
-	return f.ValidateName()
+// Deactivate - деактивирует Feature
+func (f *Feature) Deactivate() {
+	f.IsActive = false
+	f.UpdatedAt = time.Now()
 }
 
-func (f *Feature) ValidateName() error {
+// Validate - проверяет валидность Feature
+func (f *Feature) Validate() error {
 	if f.Name == "" {
 		return errors.New("name cannot be empty")
 	}
 
-	if len(f.Name) < 10 {
-		return errors.New("question must be at least 10 characters long")
+	if utf8.RuneCountInString(f.Name) < 3 {
+		return errors.New("name must be at least 3 characters long")
 	}
 
-	if len(f.Name) > 500 {
-		return errors.New("question cannot exceed 500 characters")
+	if utf8.RuneCountInString(f.Name) > 200 {
+		return errors.New("name cannot exceed 200 characters")
 	}
 
-	return nil
-}
+	if utf8.RuneCountInString(f.Description) > 1000 {
+		return errors.New("description cannot exceed 1000 characters")
+	}
 
-func (f *Feature) Validate() error {
-	if err := f.ValidateName(); err != nil {
-		return err
+	if utf8.RuneCountInString(f.Icon) > 100 {
+		return errors.New("icon cannot exceed 100 characters")
+	}
+
+	if f.SortOrder < 0 {
+		return errors.New("sort order cannot be negative")
 	}
 
 	return nil
@@ -0,0 +1,72 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// OutboxMessage доменное событие, ожидающее отправки получателям (WebSocket и другие).
+// Сохраняется в одной транзакции с изменением сущности, поэтому событие не теряется при сбое
+// между записью в базу и отправкой. ID передается получателям для отбрасывания повторов.
+type OutboxMessage struct {
+	ID       string          `json:"id"`
+	Entity   string          `json:"entity"`
+	Action   string          `json:"action"`
+	EntityID string          `json:"entityId,omitempty"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+	// Attempts количество неудачных попыток отправки, LastError - ошибка последней из них
+	Attempts  int    `json:"attempts"`
+	LastError string `json:"lastError,omitempty"`
+	// DeliveredSinks получатели, которым событие уже доставлено; при повторе они его не получают
+	DeliveredSinks []string `json:"deliveredSinks,omitempty"`
+	// AvailableAt время, раньше которого событие не отправляется повторно или берется другим экземпляром API
+	AvailableAt time.Time  `json:"availableAt"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// NewOutboxMessage - создает событие; data сериализуется в JSON
+func NewOutboxMessage(entity, action, entityID string, data any) (*OutboxMessage, error) {
+	if entity == "" || action == "" {
+		return nil, errors.New("event entity and action cannot be empty")
+	}
+
+	var payload json.RawMessage
+	if data != nil {
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode event payload: %w", err)
+		}
+		payload = encoded
+	}
+
+	now := time.Now()
+	return &OutboxMessage{
+		Entity:      entity,
+		Action:      action,
+		EntityID:    entityID,
+		Payload:     payload,
+		AvailableAt: now,
+		CreatedAt:   now,
+	}, nil
+}
+
+// IsPublished - проверяет, отправлено ли событие
+func (m *OutboxMessage) IsPublished() bool {
+	return m.PublishedAt != nil
+}
+
+// IsDeliveredTo - проверяет, доставлено ли событие получателю sink
+func (m *OutboxMessage) IsDeliveredTo(sink string) bool {
+	return slices.Contains(m.DeliveredSinks, sink)
+}
+
+// MarkDeliveredTo - отмечает доставку событию получателю sink
+func (m *OutboxMessage) MarkDeliveredTo(sink string) {
+	if !m.IsDeliveredTo(sink) {
+		m.DeliveredSinks = append(m.DeliveredSinks, sink)
+	}
+}
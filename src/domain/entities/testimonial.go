@@ -4,24 +4,39 @@ import (
 	"time"
 )
 
+// Testimonial отзыв клиента. Status меняется только методами StartReview, Approve, Reject и Archive,
+// при создании - SetSpamScreening; IsApproved совпадает с Status == approved и хранится для фильтров публичных списков.
+// Одобрить можно только отзыв с подтвержденным email автора (EmailVerifiedAt).
 type Testimonial struct {
-	ID          string     `json:"id"`
-	Content     string     `json:"content" validate:"required,min=10,max=1000"`
-	Author      string     `json:"author" validate:"required,min=2,max=100"`
-	AuthorEmail string     `json:"authorEmail" validate:"required,email"`
-	Rating      int        `json:"rating" validate:"required,min=1,max=5"`
-	FilePath    string     `json:"filePath,omitempty"`
-	FileName    string     `json:"fileName,omitempty"`
-	FileType    string     `json:"fileType,omitempty"`
-	FileSize    int64      `json:"fileSize,omitempty"`
-	IsApproved  bool       `json:"isApproved" default:"false"`
-	IsActive    bool       `json:"isActive" default:"true"`
-	ApprovedAt  *time.Time `json:"approvedAt,omitempty"`
-	ApprovedBy  string     `json:"approvedBy,omitempty"`
-	Company     string     `json:"company,omitempty"`
-	Position    string     `json:"position,omitempty"`
-	CreatedAt   time.Time  `json:"createdAt"`
-	UpdatedAt   time.Time  `json:"updatedAt"`
+	ID              string            `json:"id"`
+	Content         string            `json:"content" validate:"required,min=10,max=1000"`
+	Author          string            `json:"author" validate:"required,min=2,max=100"`
+	AuthorEmail     string            `json:"authorEmail" validate:"required,email"`
+	EmailVerifiedAt *time.Time        `json:"emailVerifiedAt,omitempty"`
+	Rating          int               `json:"rating" validate:"required,min=1,max=5"`
+	FilePath        string            `json:"filePath,omitempty"`
+	FileName        string            `json:"fileName,omitempty"`
+	FileType        string            `json:"fileType,omitempty"`
+	FileSize        int64             `json:"fileSize,omitempty"`
+	Status          TestimonialStatus `json:"status"`
+	StatusChangedAt *time.Time        `json:"statusChangedAt,omitempty"`
+	IsApproved      bool              `json:"isApproved" default:"false"`
+	IsActive        bool              `json:"isActive" default:"true"`
+	ApprovedAt      *time.Time        `json:"approvedAt,omitempty"`
+	ApprovedBy      string            `json:"approvedBy,omitempty"`
+	ReviewedBy      string            `json:"reviewedBy,omitempty"`
+	RejectionReason RejectionReason   `json:"rejectionReason,omitempty"`
+	ModeratorNotes  string            `json:"moderatorNotes,omitempty"`
+	SpamScore       int               `json:"spamScore,omitempty"`
+	SpamSignals     []string          `json:"spamSignals,omitempty"`
+	Company         string            `json:"company,omitempty"`
+	Position        string            `json:"position,omitempty"`
+	CreatedBy       string            `json:"createdBy,omitempty"`
+	UpdatedBy       string            `json:"updatedBy,omitempty"`
+	Version         int               `json:"version"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	UpdatedAt       time.Time         `json:"updatedAt"`
+	DeletedAt       *time.Time        `json:"deletedAt,omitempty"`
 }
 
 // Реализация интерфейса Entity
@@ -56,6 +71,16 @@ func (t *Testimonial) SetUpdatedAt(time time.Time) {
 	t.UpdatedAt = time
 }
 
+// GetVersion - возвращает версию
+func (t *Testimonial) GetVersion() int {
+	return t.Version
+}
+
+// SetVersion - устанавливает версию
+func (t *Testimonial) SetVersion(version int) {
+	t.Version = version
+}
+
 // NewTestimonial - создает новую Testimonial сущность
 func NewTestimonial(content, author, authorEmail string, rating int) *Testimonial {
 	now := time.Now()
@@ -64,20 +89,25 @@ func NewTestimonial(content, author, authorEmail string, rating int) *Testimonia
 		Author:      author,
 		AuthorEmail: authorEmail,
 		Rating:      rating,
+		Status:      TestimonialStatusSubmitted,
 		IsApproved:  false,
 		IsActive:    true,
+		Version:     1,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
 }
 
-// Approve - одобряет Testimonial
-func (t *Testimonial) Approve(approvedBy string) {
-	now := time.Now()
-	t.IsApproved = true
-	t.ApprovedAt = &now
-	t.ApprovedBy = approvedBy
-	t.UpdatedAt = now
+// SetCreatedBy - устанавливает клиента, создавшего Testimonial; для отзывов с сайта остается пустым
+func (t *Testimonial) SetCreatedBy(actor string) {
+	t.CreatedBy = actor
+	t.UpdatedBy = actor
+}
+
+// SetUpdatedBy - устанавливает последнего редактора Testimonial
+func (t *Testimonial) SetUpdatedBy(actor string) {
+	t.UpdatedBy = actor
+	t.UpdatedAt = time.Now()
 }
 
 // Deactivate - деактивирует Testimonial
@@ -92,6 +122,11 @@ func (t *Testimonial) Activate() {
 	t.UpdatedAt = time.Now()
 }
 
+// IsPublished - проверяет, показывается ли Testimonial на сайте: одобрен и активен
+func (t *Testimonial) IsPublished() bool {
+	return t.IsApproved && t.IsActive
+}
+
 // SetFile - устанавливает файл
 func (t *Testimonial) SetFile(filePath, fileName, fileType string, fileSize int64) {
 	t.FilePath = filePath
@@ -101,6 +136,16 @@ func (t *Testimonial) SetFile(filePath, fileName, fileType string, fileSize int6
 	t.UpdatedAt = time.Now()
 }
 
+// RemoveFile - удаляет сведения о файле
+func (t *Testimonial) RemoveFile() {
+	t.SetFile("", "", "", 0)
+}
+
+// HasFile - проверяет, прикреплен ли файл
+func (t *Testimonial) HasFile() bool {
+	return t.FilePath != ""
+}
+
 // UpdateContent - обновляет контент
 func (t *Testimonial) UpdateContent(content string) {
 	t.Content = content
@@ -0,0 +1,202 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TestimonialStatus этап модерации отзыва
+type TestimonialStatus string
+
+const (
+	// TestimonialStatusSubmitted - отправлен посетителем и ждет модератора
+	TestimonialStatusSubmitted TestimonialStatus = "submitted"
+	// TestimonialStatusInReview - взят модератором на проверку
+	TestimonialStatusInReview TestimonialStatus = "in_review"
+	// TestimonialStatusApproved - одобрен и может быть опубликован
+	TestimonialStatusApproved TestimonialStatus = "approved"
+	// TestimonialStatusRejected - отклонен с указанием причины
+	TestimonialStatusRejected TestimonialStatus = "rejected"
+	// TestimonialStatusArchived - снят с модерации и публикации; конечный статус
+	TestimonialStatusArchived TestimonialStatus = "archived"
+	// TestimonialStatusSuspectedSpam - отправлен посетителем, но проверка на спам дала высокую оценку;
+	// ждет модератора в отдельной очереди
+	TestimonialStatusSuspectedSpam TestimonialStatus = "suspected_spam"
+)
+
+// testimonialTransitions допустимые переходы между статусами отзыва.
+// Одобренный и отклоненный отзыв можно вернуть на повторную проверку,
+// подозрительный на спам можно отклонить без проверки.
+var testimonialTransitions = map[TestimonialStatus][]TestimonialStatus{
+	TestimonialStatusSubmitted:     {TestimonialStatusInReview},
+	TestimonialStatusSuspectedSpam: {TestimonialStatusInReview, TestimonialStatusRejected},
+	TestimonialStatusInReview:      {TestimonialStatusApproved, TestimonialStatusRejected},
+	TestimonialStatusApproved:      {TestimonialStatusInReview, TestimonialStatusArchived},
+	TestimonialStatusRejected:      {TestimonialStatusInReview, TestimonialStatusArchived},
+}
+
+// ModerationQueueStatuses статусы отзывов, ожидающих решения модератора
+var ModerationQueueStatuses = []TestimonialStatus{TestimonialStatusSubmitted, TestimonialStatusInReview}
+
+// IsValid проверяет, что статус известен
+func (s TestimonialStatus) IsValid() bool {
+	switch s {
+	case TestimonialStatusSubmitted, TestimonialStatusInReview, TestimonialStatusApproved,
+		TestimonialStatusRejected, TestimonialStatusArchived, TestimonialStatusSuspectedSpam:
+		return true
+	}
+	return false
+}
+
+// RejectionReason причина отклонения отзыва
+type RejectionReason string
+
+const (
+	RejectionReasonSpam         RejectionReason = "spam"
+	RejectionReasonOffensive    RejectionReason = "offensive"
+	RejectionReasonOffTopic     RejectionReason = "off_topic"
+	RejectionReasonDuplicate    RejectionReason = "duplicate"
+	RejectionReasonPersonalData RejectionReason = "personal_data"
+	RejectionReasonLowQuality   RejectionReason = "low_quality"
+	RejectionReasonOther        RejectionReason = "other"
+)
+
+// IsValid проверяет, что причина известна
+func (r RejectionReason) IsValid() bool {
+	switch r {
+	case RejectionReasonSpam, RejectionReasonOffensive, RejectionReasonOffTopic, RejectionReasonDuplicate,
+		RejectionReasonPersonalData, RejectionReasonLowQuality, RejectionReasonOther:
+		return true
+	}
+	return false
+}
+
+var (
+	// ErrInvalidStatusTransition переход между статусами отзыва не разрешен
+	ErrInvalidStatusTransition = errors.New("invalid testimonial status transition")
+	// ErrInvalidRejectionReason неизвестная причина отклонения или причина other без заметки модератора
+	ErrInvalidRejectionReason = errors.New("invalid rejection reason")
+	// ErrEmailNotVerified автор не подтвердил email, отзыв нельзя одобрить
+	ErrEmailNotVerified = errors.New("author email is not verified")
+	// ErrEmailAlreadyVerified email автора уже подтвержден, письмо со ссылкой не нужно
+	ErrEmailAlreadyVerified = errors.New("author email is already verified")
+)
+
+// CurrentStatus - возвращает статус модерации; для отзывов, сохраненных до появления статусов,
+// статус выводится из IsApproved
+func (t *Testimonial) CurrentStatus() TestimonialStatus {
+	if t.Status != "" {
+		return t.Status
+	}
+	if t.IsApproved {
+		return TestimonialStatusApproved
+	}
+	return TestimonialStatusSubmitted
+}
+
+// CanTransitionTo - проверяет, разрешен ли переход в статус to
+func (t *Testimonial) CanTransitionTo(to TestimonialStatus) bool {
+	for _, allowed := range testimonialTransitions[t.CurrentStatus()] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// StartReview - берет отзыв на проверку; повторная проверка снимает одобрение и причину отклонения
+func (t *Testimonial) StartReview(moderator string) error {
+	if err := t.transition(TestimonialStatusInReview, moderator); err != nil {
+		return err
+	}
+	t.ReviewedBy = moderator
+	t.RejectionReason = ""
+	return nil
+}
+
+// Approve - одобряет проверяемый Testimonial с подтвержденным email автора
+func (t *Testimonial) Approve(approvedBy string) error {
+	if !t.IsEmailVerified() {
+		return ErrEmailNotVerified
+	}
+	if err := t.transition(TestimonialStatusApproved, approvedBy); err != nil {
+		return err
+	}
+	t.ApprovedAt = t.StatusChangedAt
+	t.ApprovedBy = approvedBy
+	return nil
+}
+
+// Reject - отклоняет проверяемый или подозрительный на спам Testimonial; причина other требует заметки модератора
+func (t *Testimonial) Reject(rejectedBy string, reason RejectionReason) error {
+	if !reason.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidRejectionReason, reason)
+	}
+	if reason == RejectionReasonOther && t.ModeratorNotes == "" {
+		return fmt.Errorf("%w: reason %q requires moderator notes", ErrInvalidRejectionReason, reason)
+	}
+
+	if err := t.transition(TestimonialStatusRejected, rejectedBy); err != nil {
+		return err
+	}
+	t.RejectionReason = reason
+	return nil
+}
+
+// Archive - переносит одобренный или отклоненный Testimonial в архив
+func (t *Testimonial) Archive(archivedBy string) error {
+	return t.transition(TestimonialStatusArchived, archivedBy)
+}
+
+// IsEmailVerified - проверяет, подтвердил ли автор email
+func (t *Testimonial) IsEmailVerified() bool {
+	return t.EmailVerifiedAt != nil
+}
+
+// VerifyEmail - отмечает email автора подтвержденным; возвращает false, если он уже подтвержден
+func (t *Testimonial) VerifyEmail() bool {
+	if t.IsEmailVerified() {
+		return false
+	}
+	now := time.Now()
+	t.EmailVerifiedAt = &now
+	t.UpdatedAt = now
+	return true
+}
+
+// SetSpamScreening - сохраняет результат проверки новой отправки на спам;
+// подозрительная отправка вместо submitted получает статус suspected_spam
+func (t *Testimonial) SetSpamScreening(score int, signals []string, suspected bool) {
+	t.SpamScore = score
+	t.SpamSignals = signals
+	if suspected && t.CurrentStatus() == TestimonialStatusSubmitted {
+		t.Status = TestimonialStatusSuspectedSpam
+	}
+}
+
+// SetModeratorNotes - устанавливает заметки модератора; пустая строка удаляет их
+func (t *Testimonial) SetModeratorNotes(notes string) {
+	t.ModeratorNotes = notes
+	t.UpdatedAt = time.Now()
+}
+
+// transition переводит отзыв в статус to, если переход разрешен; одобрение снимается при выходе из approved
+func (t *Testimonial) transition(to TestimonialStatus, actor string) error {
+	from := t.CurrentStatus()
+	if !t.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+	}
+
+	now := time.Now()
+	t.Status = to
+	t.StatusChangedAt = &now
+	t.IsApproved = to == TestimonialStatusApproved
+	if !t.IsApproved {
+		t.ApprovedAt = nil
+		t.ApprovedBy = ""
+	}
+	t.UpdatedBy = actor
+	t.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// OIDCConfig настройки проверки access token OpenID Connect провайдера (Keycloak)
+type OIDCConfig struct {
+	// Issuers доверенные издатели токенов, значение iss сравнивается точно
+	Issuers []string
+	// Audience ожидаемое значение aud
+	Audience string
+	// ClientID клиент, роли которого читаются из resource_access
+	ClientID string
+	// JWKSURL адрес ключей; если задан, discovery не выполняется и адрес используется для всех издателей
+	JWKSURL string
+	// RefreshInterval период плановой перезагрузки ключей
+	RefreshInterval time.Duration
+	// MinRefetchInterval минимальный интервал между загрузками ключей одного издателя
+	// при токене с неизвестным kid; защищает провайдера от потока поддельных токенов
+	MinRefetchInterval time.Duration
+	// ClockSkew допустимое расхождение часов при проверке exp, nbf и iat
+	ClockSkew  time.Duration
+	HTTPClient *http.Client
+}
+
+// NewOIDCConfig загружает настройки из переменных окружения
+func NewOIDCConfig() *OIDCConfig {
+	audience := config.GetEnv("OIDC_AUDIENCE", "golang-api")
+	return &OIDCConfig{
+		Issuers:            config.GetEnvList("OIDC_ISSUERS", []string{"http://localhost:8080/realms/master"}),
+		Audience:           audience,
+		ClientID:           config.GetEnv("OIDC_CLIENT_ID", audience),
+		JWKSURL:            config.GetEnv("OIDC_JWKS_URL", ""),
+		RefreshInterval:    config.GetEnvDuration("OIDC_JWKS_REFRESH_INTERVAL", time.Hour),
+		MinRefetchInterval: config.GetEnvDuration("OIDC_JWKS_MIN_REFETCH_INTERVAL", 30*time.Second),
+		ClockSkew:          config.GetEnvDuration("OIDC_CLOCK_SKEW", 30*time.Second),
+		HTTPClient:         &http.Client{Timeout: 10 * time.Second},
+	}
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"tax-priority-api/src/application/verification"
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// EmailTokenSigner подписывает токены подтверждения email через HMAC-SHA256
+type EmailTokenSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewEmailTokenSigner создает подписчик токенов со сроком действия ttl
+func NewEmailTokenSigner(secret []byte, ttl time.Duration) *EmailTokenSigner {
+	return &EmailTokenSigner{secret: secret, ttl: ttl}
+}
+
+// NewEmailTokenSignerFromEnv создает подписчик с ключом EMAIL_VERIFICATION_SECRET и сроком EMAIL_VERIFICATION_TTL.
+// Ключ обязателен: ссылки из писем должны проверяться после перезапуска и на любой реплике.
+func NewEmailTokenSignerFromEnv() verification.Tokens {
+	ttl := config.GetEnvDuration("EMAIL_VERIFICATION_TTL", 72*time.Hour)
+	return NewEmailTokenSigner(config.RequireSecret("EMAIL_VERIFICATION_SECRET"), ttl)
+}
+
+// Issue подписывает данные токена; токен - данные и подпись в base64url, разделенные точкой
+func (s *EmailTokenSigner) Issue(testimonialID, email string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(s.ttl).Truncate(time.Second)
+	data, err := json.Marshal(verification.NewClaims(testimonialID, email, expiresAt))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded)), expiresAt, nil
+}
+
+// Parse проверяет подпись и срок действия токена
+func (s *EmailTokenSigner) Parse(token string) (*verification.Claims, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, verification.ErrInvalidToken
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, s.sign(encoded)) {
+		return nil, verification.ErrInvalidToken
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, verification.ErrInvalidToken
+	}
+	var claims verification.Claims
+	if err := json.Unmarshal(data, &claims); err != nil || claims.TestimonialID == "" {
+		return nil, verification.ErrInvalidToken
+	}
+
+	if time.Now().After(claims.ExpiresAt) {
+		return nil, verification.ErrTokenExpired
+	}
+	return &claims, nil
+}
+
+func (s *EmailTokenSigner) sign(data string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
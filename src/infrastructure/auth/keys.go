@@ -0,0 +1,156 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v3/jwk"
+)
+
+// ErrKeyNotFound ключ с kid токена не найден и в перезагруженном JWKS
+var ErrKeyNotFound = errors.New("signing key not found")
+
+// discoveryPath путь документа OpenID Provider Metadata относительно издателя
+const discoveryPath = "/.well-known/openid-configuration"
+
+// issuerKeys ключи подписи одного издателя
+type issuerKeys struct {
+	issuer string
+	// jwksURL адрес из конфигурации; пустой - адрес берется из discovery
+	jwksURL            string
+	minRefetchInterval time.Duration
+	client             *http.Client
+
+	mu  sync.RWMutex
+	set jwk.Set
+
+	// fetchMu сериализует загрузки, чтобы одновременные запросы с новым kid не загружали JWKS каждый
+	fetchMu       sync.Mutex
+	lastAttempt   time.Time
+	discoveredURL string
+}
+
+func newIssuerKeys(issuer, jwksURL string, minRefetchInterval time.Duration, client *http.Client) *issuerKeys {
+	return &issuerKeys{
+		issuer:             issuer,
+		jwksURL:            jwksURL,
+		minRefetchInterval: minRefetchInterval,
+		client:             client,
+	}
+}
+
+// key возвращает ключ по kid. Неизвестный kid означает ротацию ключей у провайдера:
+// JWKS загружается заново, но не чаще minRefetchInterval.
+func (k *issuerKeys) key(ctx context.Context, kid string) (jwk.Key, error) {
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	k.fetchMu.Lock()
+	defer k.fetchMu.Unlock()
+
+	// Пока ждали блокировку, ключи мог загрузить другой запрос
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+	if !k.lastAttempt.IsZero() && time.Since(k.lastAttempt) < k.minRefetchInterval {
+		return nil, ErrKeyNotFound
+	}
+
+	if err := k.fetchLocked(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+// refresh загружает JWKS независимо от ограничения частоты
+func (k *issuerKeys) refresh(ctx context.Context) error {
+	k.fetchMu.Lock()
+	defer k.fetchMu.Unlock()
+	return k.fetchLocked(ctx)
+}
+
+func (k *issuerKeys) lookup(kid string) (jwk.Key, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.set == nil {
+		return nil, false
+	}
+	return k.set.LookupKeyID(kid)
+}
+
+// fetchLocked загружает JWKS; вызывается под fetchMu
+func (k *issuerKeys) fetchLocked(ctx context.Context) error {
+	k.lastAttempt = time.Now()
+
+	jwksURL := k.jwksURL
+	if jwksURL == "" {
+		if k.discoveredURL == "" {
+			discovered, err := discoverJWKSURL(ctx, k.client, k.issuer)
+			if err != nil {
+				return err
+			}
+			k.discoveredURL = discovered
+		}
+		jwksURL = k.discoveredURL
+	}
+
+	set, err := jwk.Fetch(ctx, jwksURL, jwk.WithHTTPClient(k.client))
+	if err != nil {
+		// Адрес мог измениться, при следующей загрузке discovery выполнится заново
+		k.discoveredURL = ""
+		return fmt.Errorf("failed to fetch JWKS of %s: %w", k.issuer, err)
+	}
+
+	k.mu.Lock()
+	k.set = set
+	k.mu.Unlock()
+	return nil
+}
+
+// providerMetadata поля документа discovery, которые нужны для проверки токенов
+type providerMetadata struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// discoverJWKSURL получает jwks_uri из /.well-known/openid-configuration издателя
+func discoverJWKSURL(ctx context.Context, client *http.Client, issuer string) (string, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + discoveryPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create discovery request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("OIDC discovery of %s failed: %w", issuer, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("OIDC discovery of %s returned status %d", issuer, resp.StatusCode)
+	}
+
+	var metadata providerMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return "", fmt.Errorf("invalid OIDC discovery document of %s: %w", issuer, err)
+	}
+	// Документ должен принадлежать тому же издателю (OpenID Connect Discovery 1.0, раздел 4.3)
+	if metadata.Issuer != issuer {
+		return "", fmt.Errorf("OIDC discovery issuer mismatch: expected %s, got %s", issuer, metadata.Issuer)
+	}
+	if metadata.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery document of %s has no jwks_uri", issuer)
+	}
+	return metadata.JWKSURI, nil
+}
@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+)
+
+// fakeSigningKey ключ подписи фейкового провайдера
+type fakeSigningKey struct {
+	kid     string
+	alg     string
+	private crypto.Signer
+	// public публичная часть ключа в формате JWK с kid, alg и use
+	public jwk.Key
+}
+
+func newFakeSigningKey(t *testing.T, kid, alg string) *fakeSigningKey {
+	t.Helper()
+
+	var (
+		private crypto.Signer
+		err     error
+	)
+	switch alg {
+	case "RS256", "PS256":
+		private, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "ES256":
+		private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "EdDSA":
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		t.Fatalf("unsupported test algorithm %s", alg)
+	}
+	if err != nil {
+		t.Fatalf("generate %s key: %v", alg, err)
+	}
+
+	public, err := jwk.Import(private.Public())
+	if err != nil {
+		t.Fatalf("import %s key: %v", kid, err)
+	}
+	for name, value := range map[string]any{jwk.KeyIDKey: kid, jwk.AlgorithmKey: alg, jwk.KeyUsageKey: "sig"} {
+		if err := public.Set(name, value); err != nil {
+			t.Fatalf("set %s of %s: %v", name, kid, err)
+		}
+	}
+	return &fakeSigningKey{kid: kid, alg: alg, private: private, public: public}
+}
+
+// sign выпускает токен с kid ключа; claims дополняются exp и iat, если не заданы
+func (k *fakeSigningKey) sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+
+	if _, ok := claims["exp"]; !ok {
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+	}
+	if _, ok := claims["iat"]; !ok {
+		claims["iat"] = time.Now().Unix()
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(k.alg), claims)
+	token.Header["kid"] = k.kid
+	signed, err := token.SignedString(k.private)
+	if err != nil {
+		t.Fatalf("sign token with %s: %v", k.kid, err)
+	}
+	return signed
+}
+
+// fakeRealm издатель фейкового провайдера со своим набором ключей
+type fakeRealm struct {
+	issuer string
+	keys   []*fakeSigningKey
+	// jwksRequests и discoveryRequests число загрузок ключей и документа discovery
+	jwksRequests      int
+	discoveryRequests int
+}
+
+// fakeOIDCProvider провайдер OpenID Connect в процессе теста: несколько издателей вида
+// <server>/realms/<name>, у каждого discovery и JWKS, ключи можно менять на лету
+type fakeOIDCProvider struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu     sync.Mutex
+	realms map[string]*fakeRealm
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	t.Helper()
+
+	provider := &fakeOIDCProvider{t: t, realms: make(map[string]*fakeRealm)}
+	provider.server = httptest.NewServer(http.HandlerFunc(provider.serve))
+	t.Cleanup(provider.server.Close)
+	return provider
+}
+
+// addRealm регистрирует издателя с ключами и возвращает его iss
+func (p *fakeOIDCProvider) addRealm(name string, keys ...*fakeSigningKey) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	issuer := p.server.URL + "/realms/" + name
+	p.realms[name] = &fakeRealm{issuer: issuer, keys: keys}
+	return issuer
+}
+
+// rotate заменяет ключи издателя, как при ротации в Keycloak
+func (p *fakeOIDCProvider) rotate(name string, keys ...*fakeSigningKey) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.realms[name].keys = keys
+}
+
+// requests возвращает число загрузок JWKS и discovery издателя
+func (p *fakeOIDCProvider) requests(name string) (jwks, discovery int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	realm := p.realms[name]
+	return realm.jwksRequests, realm.discoveryRequests
+}
+
+func (p *fakeOIDCProvider) serve(w http.ResponseWriter, r *http.Request) {
+	rest, ok := strings.CutPrefix(r.URL.Path, "/realms/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	name, endpoint, _ := strings.Cut(rest, "/")
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	realm, ok := p.realms[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch "/" + endpoint {
+	case discoveryPath:
+		realm.discoveryRequests++
+		writeJSON(p.t, w, map[string]any{
+			"issuer":                                realm.issuer,
+			"jwks_uri":                              realm.issuer + "/protocol/openid-connect/certs",
+			"id_token_signing_alg_values_supported": signingAlgorithms,
+		})
+	case "/protocol/openid-connect/certs":
+		realm.jwksRequests++
+		set := jwk.NewSet()
+		for _, key := range realm.keys {
+			if err := set.AddKey(key.public); err != nil {
+				p.t.Errorf("add key %s: %v", key.kid, err)
+			}
+		}
+		writeJSON(p.t, w, set)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		t.Errorf("encode response: %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+)
+
+// ErrUnknownIssuer токен выпущен издателем, которого нет в OIDC_ISSUERS
+var ErrUnknownIssuer = errors.New("untrusted token issuer")
+
+// signingAlgorithms алгоритмы подписи, которые принимаются от провайдера; HMAC и none исключены
+var signingAlgorithms = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// OIDCVerifier проверяет access token доверенных издателей по их JWKS
+type OIDCVerifier struct {
+	issuers         map[string]*issuerKeys
+	parser          *jwt.Parser
+	refreshInterval time.Duration
+}
+
+// NewOIDCVerifier создает проверку токенов; ключи загружаются в Start или при первом токене
+func NewOIDCVerifier(cfg *OIDCConfig) *OIDCVerifier {
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	issuers := make(map[string]*issuerKeys, len(cfg.Issuers))
+	for _, issuer := range cfg.Issuers {
+		issuers[issuer] = newIssuerKeys(issuer, cfg.JWKSURL, cfg.MinRefetchInterval, client)
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(signingAlgorithms),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(cfg.ClockSkew),
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &OIDCVerifier{
+		issuers:         issuers,
+		parser:          jwt.NewParser(options...),
+		refreshInterval: cfg.RefreshInterval,
+	}
+}
+
+// Start загружает ключи всех издателей и периодически обновляет их до отмены ctx.
+// Ошибка загрузки не фатальна: ключи будут загружены при первом токене издателя.
+func (v *OIDCVerifier) Start(ctx context.Context) {
+	v.refreshAll(ctx)
+	if v.refreshInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(v.refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				v.refreshAll(ctx)
+			}
+		}
+	}()
+}
+
+func (v *OIDCVerifier) refreshAll(ctx context.Context) {
+	for _, keys := range v.issuers {
+		if err := keys.refresh(ctx); err != nil {
+			log.Printf("JWKS refresh failed: %v", err)
+		}
+	}
+}
+
+// Verify проверяет подпись, издателя, аудиторию и срок действия токена и возвращает его claims
+func (v *OIDCVerifier) Verify(ctx context.Context, tokenStr string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
+		return v.signingKey(ctx, token)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// signingKey выбирает ключ по iss и kid токена. Издатель проверяется здесь,
+// до проверки подписи, чтобы токен чужого издателя не вызывал загрузку ключей.
+func (v *OIDCVerifier) signingKey(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	issuer, err := token.Claims.GetIssuer()
+	if err != nil {
+		return nil, err
+	}
+	keys, ok := v.issuers[issuer]
+	if !ok {
+		return nil, ErrUnknownIssuer
+	}
+
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return nil, errors.New("token has no kid header")
+	}
+
+	key, err := keys.key(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ключ с указанным alg нельзя использовать с другим алгоритмом
+	if alg, ok := key.Algorithm(); ok && alg.String() != token.Method.Alg() {
+		return nil, fmt.Errorf("key %s is for %s, token is signed with %s", kid, alg, token.Method.Alg())
+	}
+	if use, ok := key.KeyUsage(); ok && use != "sig" {
+		return nil, fmt.Errorf("key %s is not a signing key", kid)
+	}
+
+	var raw interface{}
+	if err := jwk.Export(key, &raw); err != nil {
+		return nil, fmt.Errorf("failed to export key %s: %w", kid, err)
+	}
+	return raw, nil
+}
@@ -0,0 +1,281 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testAudience = "golang-api"
+
+// newTestVerifier создает проверку токенов издателей фейкового провайдера через discovery
+func newTestVerifier(provider *fakeOIDCProvider, minRefetch time.Duration, issuers ...string) *OIDCVerifier {
+	return NewOIDCVerifier(&OIDCConfig{
+		Issuers:            issuers,
+		Audience:           testAudience,
+		MinRefetchInterval: minRefetch,
+		ClockSkew:          time.Second,
+		HTTPClient:         provider.server.Client(),
+	})
+}
+
+func tokenClaims(issuer string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss": issuer,
+		"aud": testAudience,
+		"sub": "user-1",
+	}
+}
+
+func TestOIDCVerifierAlgorithms(t *testing.T) {
+	for _, alg := range []string{"RS256", "PS256", "ES256", "EdDSA"} {
+		t.Run(alg, func(t *testing.T) {
+			provider := newFakeOIDCProvider(t)
+			key := newFakeSigningKey(t, "key-"+alg, alg)
+			issuer := provider.addRealm("main", key)
+			verifier := newTestVerifier(provider, time.Minute, issuer)
+
+			claims, err := verifier.Verify(context.Background(), key.sign(t, tokenClaims(issuer)))
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if claims["sub"] != "user-1" {
+				t.Fatalf("sub = %v", claims["sub"])
+			}
+
+			jwks, discovery := provider.requests("main")
+			if jwks != 1 || discovery != 1 {
+				t.Fatalf("jwks requests = %d, discovery requests = %d, want 1 and 1", jwks, discovery)
+			}
+		})
+	}
+}
+
+func TestOIDCVerifierRejectsInvalidTokens(t *testing.T) {
+	provider := newFakeOIDCProvider(t)
+	key := newFakeSigningKey(t, "rsa", "RS256")
+	issuer := provider.addRealm("main", key)
+	verifier := newTestVerifier(provider, time.Minute, issuer)
+	ctx := context.Background()
+
+	foreign := newFakeSigningKey(t, "rsa", "RS256")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "expired", token: key.sign(t, jwt.MapClaims{
+			"iss": issuer, "aud": testAudience, "exp": time.Now().Add(-time.Hour).Unix(),
+		})},
+		{name: "no exp", token: func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"iss": issuer, "aud": testAudience})
+			token.Header["kid"] = key.kid
+			signed, _ := token.SignedString(key.private)
+			return signed
+		}()},
+		{name: "wrong audience", token: key.sign(t, jwt.MapClaims{"iss": issuer, "aud": "other-api"})},
+		{name: "signed by other key with same kid", token: foreign.sign(t, tokenClaims(issuer))},
+		{name: "hmac", token: func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims(issuer))
+			token.Header["kid"] = key.kid
+			signed, _ := token.SignedString([]byte("secret"))
+			return signed
+		}()},
+		{name: "no kid", token: func() string {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+				"iss": issuer, "aud": testAudience, "exp": time.Now().Add(time.Hour).Unix(),
+			})
+			signed, _ := token.SignedString(key.private)
+			return signed
+		}()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verifier.Verify(ctx, tt.token); err == nil {
+				t.Fatal("expected token to be rejected")
+			}
+		})
+	}
+}
+
+func TestOIDCVerifierRejectsAlgorithmOtherThanKeyAlg(t *testing.T) {
+	provider := newFakeOIDCProvider(t)
+	key := newFakeSigningKey(t, "rsa", "RS256")
+	issuer := provider.addRealm("main", key)
+	verifier := newTestVerifier(provider, time.Minute, issuer)
+
+	// Тот же RSA ключ, но PS256 вместо объявленного в JWKS RS256
+	token := jwt.NewWithClaims(jwt.SigningMethodPS256, jwt.MapClaims{
+		"iss": issuer, "aud": testAudience, "exp": time.Now().Add(time.Hour).Unix(), "iat": time.Now().Unix(),
+	})
+	token.Header["kid"] = key.kid
+	signed, err := token.SignedString(key.private)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := verifier.Verify(context.Background(), signed); err == nil || !strings.Contains(err.Error(), "is for RS256") {
+		t.Fatalf("Verify: %v, want algorithm mismatch", err)
+	}
+}
+
+func TestOIDCVerifierMultipleIssuers(t *testing.T) {
+	provider := newFakeOIDCProvider(t)
+	staffKey := newFakeSigningKey(t, "staff-key", "RS256")
+	partnerKey := newFakeSigningKey(t, "partner-key", "ES256")
+	staff := provider.addRealm("staff", staffKey)
+	partners := provider.addRealm("partners", partnerKey)
+	untrusted := provider.addRealm("untrusted", newFakeSigningKey(t, "untrusted-key", "RS256"))
+	verifier := newTestVerifier(provider, time.Minute, staff, partners)
+	ctx := context.Background()
+
+	if _, err := verifier.Verify(ctx, staffKey.sign(t, tokenClaims(staff))); err != nil {
+		t.Fatalf("staff token: %v", err)
+	}
+	if _, err := verifier.Verify(ctx, partnerKey.sign(t, tokenClaims(partners))); err != nil {
+		t.Fatalf("partner token: %v", err)
+	}
+
+	// Ключ одного издателя не принимается для токена другого
+	if _, err := verifier.Verify(ctx, staffKey.sign(t, tokenClaims(partners))); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("staff key with partners issuer: %v, want ErrKeyNotFound", err)
+	}
+
+	// Недоверенный издатель отклоняется без обращения к его ключам
+	if _, err := verifier.Verify(ctx, staffKey.sign(t, tokenClaims(untrusted))); !errors.Is(err, ErrUnknownIssuer) {
+		t.Fatalf("untrusted issuer: %v, want ErrUnknownIssuer", err)
+	}
+	if jwks, discovery := provider.requests("untrusted"); jwks != 0 || discovery != 0 {
+		t.Fatalf("untrusted issuer was contacted: jwks %d, discovery %d", jwks, discovery)
+	}
+}
+
+func TestOIDCVerifierRefetchesUnknownKidWithRateLimit(t *testing.T) {
+	provider := newFakeOIDCProvider(t)
+	oldKey := newFakeSigningKey(t, "old", "RS256")
+	issuer := provider.addRealm("main", oldKey)
+	verifier := newTestVerifier(provider, time.Hour, issuer)
+	ctx := context.Background()
+
+	verifier.Start(ctx)
+	if jwks, _ := provider.requests("main"); jwks != 1 {
+		t.Fatalf("jwks requests after Start = %d, want 1", jwks)
+	}
+	if _, err := verifier.Verify(ctx, oldKey.sign(t, tokenClaims(issuer))); err != nil {
+		t.Fatalf("old key: %v", err)
+	}
+
+	// Провайдер выпустил новый ключ: токен с неизвестным kid вызывает одну перезагрузку JWKS
+	newKey := newFakeSigningKey(t, "new", "ES256")
+	provider.rotate("main", oldKey, newKey)
+	keys := verifier.issuers[issuer]
+	keys.lastAttempt = time.Now().Add(-2 * time.Hour)
+
+	if _, err := verifier.Verify(ctx, newKey.sign(t, tokenClaims(issuer))); err != nil {
+		t.Fatalf("rotated key: %v", err)
+	}
+	if jwks, discovery := provider.requests("main"); jwks != 2 || discovery != 1 {
+		t.Fatalf("after rotation: jwks %d, discovery %d, want 2 and 1", jwks, discovery)
+	}
+
+	// Поток токенов с неизвестными kid не загружает JWKS чаще MinRefetchInterval
+	forged := newFakeSigningKey(t, "forged", "RS256")
+	for i := 0; i < 5; i++ {
+		if _, err := verifier.Verify(ctx, forged.sign(t, tokenClaims(issuer))); !errors.Is(err, ErrKeyNotFound) {
+			t.Fatalf("forged kid: %v, want ErrKeyNotFound", err)
+		}
+	}
+	if jwks, _ := provider.requests("main"); jwks != 2 {
+		t.Fatalf("jwks requests within refetch interval = %d, want 2", jwks)
+	}
+
+	// Известные ключи продолжают работать без загрузок
+	if _, err := verifier.Verify(ctx, oldKey.sign(t, tokenClaims(issuer))); err != nil {
+		t.Fatalf("old key within refetch interval: %v", err)
+	}
+
+	// После интервала неизвестный kid снова вызывает загрузку
+	keys.lastAttempt = time.Now().Add(-2 * time.Hour)
+	if _, err := verifier.Verify(ctx, forged.sign(t, tokenClaims(issuer))); !errors.Is(err, ErrKeyNotFound) {
+		t.Fatalf("forged kid after interval: %v, want ErrKeyNotFound", err)
+	}
+	if jwks, _ := provider.requests("main"); jwks != 3 {
+		t.Fatalf("jwks requests after interval = %d, want 3", jwks)
+	}
+}
+
+func TestOIDCVerifierConcurrentUnknownKidFetchesOnce(t *testing.T) {
+	provider := newFakeOIDCProvider(t)
+	key := newFakeSigningKey(t, "key", "EdDSA")
+	issuer := provider.addRealm("main", key)
+	verifier := newTestVerifier(provider, time.Hour, issuer)
+	token := key.sign(t, tokenClaims(issuer))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := verifier.Verify(context.Background(), token)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Verify: %v", err)
+		}
+	}
+	if jwks, discovery := provider.requests("main"); jwks != 1 || discovery != 1 {
+		t.Fatalf("jwks %d, discovery %d, want 1 and 1", jwks, discovery)
+	}
+}
+
+func TestDiscoverJWKSURLRejectsIssuerMismatch(t *testing.T) {
+	provider := newFakeOIDCProvider(t)
+	issuer := provider.addRealm("main", newFakeSigningKey(t, "key", "RS256"))
+
+	// Издатель в конфигурации со слешем на конце не совпадает с iss документа discovery
+	if _, err := discoverJWKSURL(context.Background(), provider.server.Client(), issuer+"/"); err == nil ||
+		!strings.Contains(err.Error(), "issuer mismatch") {
+		t.Fatalf("discoverJWKSURL: %v, want issuer mismatch", err)
+	}
+
+	jwksURL, err := discoverJWKSURL(context.Background(), provider.server.Client(), issuer)
+	if err != nil {
+		t.Fatalf("discoverJWKSURL: %v", err)
+	}
+	if jwksURL != issuer+"/protocol/openid-connect/certs" {
+		t.Fatalf("jwks_uri = %s", jwksURL)
+	}
+}
+
+func TestOIDCVerifierStaticJWKSURL(t *testing.T) {
+	provider := newFakeOIDCProvider(t)
+	key := newFakeSigningKey(t, "key", "ES256")
+	issuer := provider.addRealm("main", key)
+
+	verifier := NewOIDCVerifier(&OIDCConfig{
+		Issuers:            []string{issuer},
+		Audience:           testAudience,
+		JWKSURL:            issuer + "/protocol/openid-connect/certs",
+		MinRefetchInterval: time.Minute,
+		HTTPClient:         provider.server.Client(),
+	})
+
+	if _, err := verifier.Verify(context.Background(), key.sign(t, tokenClaims(issuer))); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if jwks, discovery := provider.requests("main"); jwks != 1 || discovery != 0 {
+		t.Fatalf("jwks %d, discovery %d, want 1 and 0", jwks, discovery)
+	}
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"errors"
+
+	appCache "tax-priority-api/src/application/cache"
+)
+
+// GenerationCacheManager увеличивает поколение области при каждой инвалидации:
+// любое изменение записей инвалидирует кеш, поэтому поколение отражает все изменения области
+type GenerationCacheManager[T any, ID comparable] struct {
+	CacheManager[T, ID]
+	generations appCache.GenerationStore
+	scope       string
+}
+
+func NewGenerationCacheManager[T any, ID comparable](
+	cacheManager CacheManager[T, ID],
+	generations appCache.GenerationStore,
+	scope string,
+) CacheManager[T, ID] {
+	return &GenerationCacheManager[T, ID]{
+		CacheManager: cacheManager,
+		generations:  generations,
+		scope:        scope,
+	}
+}
+
+func (m *GenerationCacheManager[T, ID]) Invalidate(ctx context.Context, entity T) error {
+	return m.bump(ctx, m.CacheManager.Invalidate(ctx, entity))
+}
+
+func (m *GenerationCacheManager[T, ID]) InvalidateMultiple(ctx context.Context, entities []T) error {
+	return m.bump(ctx, m.CacheManager.InvalidateMultiple(ctx, entities))
+}
+
+func (m *GenerationCacheManager[T, ID]) InvalidateByID(ctx context.Context, id ID) error {
+	return m.bump(ctx, m.CacheManager.InvalidateByID(ctx, id))
+}
+
+func (m *GenerationCacheManager[T, ID]) InvalidateQuery(ctx context.Context, queryKey string) error {
+	return m.bump(ctx, m.CacheManager.InvalidateQuery(ctx, queryKey))
+}
+
+func (m *GenerationCacheManager[T, ID]) InvalidatePattern(ctx context.Context, pattern string) error {
+	return m.bump(ctx, m.CacheManager.InvalidatePattern(ctx, pattern))
+}
+
+func (m *GenerationCacheManager[T, ID]) InvalidateAll(ctx context.Context) error {
+	return m.bump(ctx, m.CacheManager.InvalidateAll(ctx))
+}
+
+// bump увеличивает поколение даже при ошибке инвалидации: данные в базе уже изменились
+func (m *GenerationCacheManager[T, ID]) bump(ctx context.Context, invalidateErr error) error {
+	return errors.Join(invalidateErr, m.generations.Bump(ctx, m.scope))
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"tax-priority-api/src/application/cache"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	generationValueField      = "value"
+	generationModifiedAtField = "modified_at"
+)
+
+// RedisGenerationStore счетчики поколений в хешах Redis generation:<scope>
+type RedisGenerationStore struct {
+	client *redis.Client
+}
+
+func NewRedisGenerationStore(client *redis.Client) cache.GenerationStore {
+	return &RedisGenerationStore{client: client}
+}
+
+func generationKey(scope string) string {
+	return "generation:" + scope
+}
+
+func (s *RedisGenerationStore) Current(ctx context.Context, scope string) (cache.Generation, error) {
+	key := generationKey(scope)
+
+	fields, err := s.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return cache.Generation{}, fmt.Errorf("failed to read generation %s: %w", scope, err)
+	}
+
+	if len(fields) == 0 {
+		// Счетчик начинается с текущего времени, а не с нуля: после очистки Redis
+		// новые ETag не совпадут с выданными раньше
+		now := time.Now()
+		_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSetNX(ctx, key, generationValueField, now.UnixNano())
+			pipe.HSetNX(ctx, key, generationModifiedAtField, now.UnixMilli())
+			return nil
+		})
+		if err != nil {
+			return cache.Generation{}, fmt.Errorf("failed to init generation %s: %w", scope, err)
+		}
+
+		if fields, err = s.client.HGetAll(ctx, key).Result(); err != nil {
+			return cache.Generation{}, fmt.Errorf("failed to read generation %s: %w", scope, err)
+		}
+	}
+
+	value, err := strconv.ParseInt(fields[generationValueField], 10, 64)
+	if err != nil {
+		return cache.Generation{}, fmt.Errorf("invalid generation %s: %w", scope, err)
+	}
+	modifiedAt, err := strconv.ParseInt(fields[generationModifiedAtField], 10, 64)
+	if err != nil {
+		return cache.Generation{}, fmt.Errorf("invalid generation %s: %w", scope, err)
+	}
+
+	return cache.Generation{Value: value, ModifiedAt: time.UnixMilli(modifiedAt)}, nil
+}
+
+func (s *RedisGenerationStore) Bump(ctx context.Context, scope string) error {
+	key := generationKey(scope)
+
+	now := time.Now()
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSetNX(ctx, key, generationValueField, now.UnixNano())
+		pipe.HIncrBy(ctx, key, generationValueField, 1)
+		pipe.HSet(ctx, key, generationModifiedAtField, now.UnixMilli())
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bump generation %s: %w", scope, err)
+	}
+	return nil
+}
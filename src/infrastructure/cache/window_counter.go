@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tax-priority-api/src/application/screening"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisWindowCounter счетчики событий в фиксированном окне на ключах Redis с TTL
+type RedisWindowCounter struct {
+	client *redis.Client
+}
+
+func NewRedisWindowCounter(client *redis.Client) screening.Counter {
+	return &RedisWindowCounter{client: client}
+}
+
+func (c *RedisWindowCounter) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		// NX: TTL задается первым событием окна и не продлевается следующими
+		pipe.ExpireNX(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment counter %s: %w", key, err)
+	}
+	return incr.Val(), nil
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetEnv возвращает значение переменной окружения или значение по умолчанию
+func GetEnv(key, defaultValue string) string {
+	if value, ok := os.LookupEnv(key); ok && strings.TrimSpace(value) != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// GetEnvInt возвращает целочисленное значение переменной окружения или значение по умолчанию
+func GetEnvInt(key string, defaultValue int) int {
+	value := GetEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid value for %s: %q, using default %d", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// GetEnvBool возвращает логическое значение переменной окружения или значение по умолчанию
+func GetEnvBool(key string, defaultValue bool) bool {
+	value := GetEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Printf("Invalid value for %s: %q, using default %t", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// GetEnvDuration возвращает длительность из переменной окружения (формат time.ParseDuration)
+func GetEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := GetEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid value for %s: %q, using default %s", key, value, defaultValue)
+		return defaultValue
+	}
+	return parsed
+}
+
+// GetEnvList возвращает список значений, разделенных запятой
+func GetEnvList(key string, defaultValue []string) []string {
+	value := GetEnv(key, "")
+	if value == "" {
+		return defaultValue
+	}
+
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"crypto/rand"
+	"log"
+	"sync"
+)
+
+// Ключи подписи читаются из окружения по одному правилу:
+//   - RequireSecret - для подписей, которые уходят за пределы запроса и должны проверяться
+//     после перезапуска и на любой реплике (ссылки из писем). Без ключа сервис не запускается;
+//   - SecretOrRandom - для короткоживущих подписей (курсоры, ссылки на файлы). Без ключа
+//     используется случайный ключ процесса: подписи не переживут перезапуск и не подойдут
+//     другим репликам, поэтому в продакшене ключ нужно задать.
+var randomSecrets sync.Map
+
+// randomSecretKeyLength длина случайного ключа в байтах
+const randomSecretKeyLength = 32
+
+// RequireSecret возвращает ключ подписи из переменной окружения key и останавливает запуск, если он не задан
+func RequireSecret(key string) []byte {
+	secret := GetEnv(key, "")
+	if secret == "" {
+		log.Fatalf("%s is not set", key)
+	}
+	return []byte(secret)
+}
+
+// SecretOrRandom возвращает ключ подписи из переменной окружения key или случайный ключ процесса.
+// Случайный ключ генерируется один раз на key и общий для всех вызовов.
+func SecretOrRandom(key string) []byte {
+	if secret := GetEnv(key, ""); secret != "" {
+		return []byte(secret)
+	}
+
+	if secret, ok := randomSecrets.Load(key); ok {
+		return secret.([]byte)
+	}
+
+	secret := make([]byte, randomSecretKeyLength)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatalf("Failed to generate random %s: %v", key, err)
+	}
+	stored, loaded := randomSecrets.LoadOrStore(key, secret)
+	if !loaded {
+		log.Printf("%s is not set, using random signing key: signatures will not survive restart or work across replicas", key)
+	}
+	return stored.([]byte)
+}
@@ -0,0 +1,103 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"tax-priority-api/src/application/notifications"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/verification"
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/infrastructure/persistence"
+)
+
+// EmailSink отправляет письма по событиям модерации отзывов рядом с WebSocket хабом
+// и письма подтверждения email автора по событиям почты.
+// Событие не содержит email автора, поэтому отзыв загружается из базы.
+type EmailSink struct {
+	testimonials repositories.GenericRepository[*entities.Testimonial, string]
+	mailer       *notifications.TestimonialMailer
+	verification *verification.Sender
+}
+
+// NewEmailSink создает получателя событий, отправляющего письма
+func NewEmailSink(testimonials repositories.GenericRepository[*entities.Testimonial, string], mailer *notifications.TestimonialMailer, verificationSender *verification.Sender) *EmailSink {
+	return &EmailSink{
+		testimonials: testimonials,
+		mailer:       mailer,
+		verification: verificationSender,
+	}
+}
+
+// Name возвращает имя получателя
+func (s *EmailSink) Name() string {
+	return "email"
+}
+
+// Publish отправляет письмо модераторам о новом отзыве, автору об одобрении или отклонении
+// и письмо со ссылкой подтверждения email.
+// Ошибка отправки возвращается для повтора события; удаленный с тех пор отзыв пропускается.
+func (s *EmailSink) Publish(ctx context.Context, message *entities.OutboxMessage) error {
+	switch message.Entity {
+	case TestimonialEntity:
+		return s.publishModeration(ctx, message)
+	case MailEntity:
+		if message.Action == ActionTestimonialVerification {
+			return s.publishVerification(ctx, message)
+		}
+	}
+	return nil
+}
+
+// publishModeration отправляет письмо о переходе отзыва в новый статус
+func (s *EmailSink) publishModeration(ctx context.Context, message *entities.OutboxMessage) error {
+	var notify func(context.Context, *entities.Testimonial) error
+	switch entities.TestimonialStatus(message.Action) {
+	case entities.TestimonialStatusSubmitted:
+		notify = s.mailer.NotifySubmitted
+	case entities.TestimonialStatusApproved:
+		notify = s.mailer.NotifyApproved
+	case entities.TestimonialStatusRejected:
+		notify = s.mailer.NotifyRejected
+	default:
+		return nil
+	}
+
+	testimonial, err := s.findTestimonial(ctx, message)
+	if err != nil || testimonial == nil {
+		return err
+	}
+	// Статус мог измениться после события: письмо о решении, которое уже отменено, не отправляется
+	if string(testimonial.CurrentStatus()) != message.Action {
+		return nil
+	}
+	return notify(ctx, testimonial)
+}
+
+// publishVerification отправляет ссылку подтверждения на текущий email автора;
+// если email уже подтвержден, письмо не нужно
+func (s *EmailSink) publishVerification(ctx context.Context, message *entities.OutboxMessage) error {
+	testimonial, err := s.findTestimonial(ctx, message)
+	if err != nil || testimonial == nil {
+		return err
+	}
+	if testimonial.IsEmailVerified() {
+		return nil
+	}
+	return s.verification.Send(ctx, testimonial)
+}
+
+// findTestimonial загружает отзыв события; для удаленного отзыва возвращает nil без ошибки
+func (s *EmailSink) findTestimonial(ctx context.Context, message *entities.OutboxMessage) (*entities.Testimonial, error) {
+	testimonial, err := s.testimonials.FindByID(ctx, message.EntityID)
+	if err != nil {
+		var repoErr *persistence.RepositoryError
+		if errors.As(err, &repoErr) && repoErr.Code == persistence.ErrCodeNotFound {
+			log.Printf("Testimonial %s of event %s not found, email is not sent", message.EntityID, message.ID)
+			return nil, nil
+		}
+		return nil, err
+	}
+	return testimonial, nil
+}
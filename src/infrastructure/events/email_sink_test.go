@@ -0,0 +1,145 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appMail "tax-priority-api/src/application/mail"
+	"tax-priority-api/src/application/notifications"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/verification"
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/infrastructure/mail"
+	"tax-priority-api/src/infrastructure/persistence"
+)
+
+// fakeTestimonials репозиторий с одним методом FindByID; остальные методы sink не вызывает
+type fakeTestimonials struct {
+	repositories.GenericRepository[*entities.Testimonial, string]
+	testimonials map[string]*entities.Testimonial
+}
+
+func (r *fakeTestimonials) FindByID(_ context.Context, id string) (*entities.Testimonial, error) {
+	testimonial, ok := r.testimonials[id]
+	if !ok {
+		return nil, persistence.NewNotFoundError("testimonial "+id+" not found", nil)
+	}
+	copied := *testimonial
+	return &copied, nil
+}
+
+// fakeTokens выдает токен из ID отзыва
+type fakeTokens struct{}
+
+func (fakeTokens) Issue(testimonialID, _ string) (string, time.Time, error) {
+	return "token-" + testimonialID, time.Now().Add(time.Hour), nil
+}
+
+func (fakeTokens) Parse(string) (*verification.Claims, error) {
+	return nil, verification.ErrInvalidToken
+}
+
+func newTestEmailSink(t *testing.T, testimonials ...*entities.Testimonial) (*EmailSink, *mail.MemoryMailer) {
+	t.Helper()
+
+	templates, err := appMail.NewTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mailer := mail.NewMemoryMailer()
+	repo := &fakeTestimonials{testimonials: make(map[string]*entities.Testimonial)}
+	for _, testimonial := range testimonials {
+		repo.testimonials[testimonial.ID] = testimonial
+	}
+
+	sink := NewEmailSink(
+		repo,
+		notifications.NewTestimonialMailer(mailer, templates, []string{"moderator@example.com"}, ""),
+		verification.NewSender(fakeTokens{}, mailer, templates, "https://example.com/testimonials/verify"),
+	)
+	return sink, mailer
+}
+
+func newTestTestimonial(id string) *entities.Testimonial {
+	testimonial := entities.NewTestimonial("Отличная консультация по налогам", "Иван", "author@example.com", 5)
+	testimonial.SetID(id)
+	return testimonial
+}
+
+func verificationMessage(t *testing.T, testimonialID string) *entities.OutboxMessage {
+	t.Helper()
+	message, err := entities.NewOutboxMessage(MailEntity, ActionTestimonialVerification, testimonialID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return message
+}
+
+func TestEmailSinkSendsVerification(t *testing.T) {
+	sink, mailer := newTestEmailSink(t, newTestTestimonial("t-1"))
+
+	if err := sink.Publish(context.Background(), verificationMessage(t, "t-1")); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	messages := mailer.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("sent %d messages, want 1", len(messages))
+	}
+	if got := messages[0].To; len(got) != 1 || got[0] != "author@example.com" {
+		t.Fatalf("To = %v", got)
+	}
+	if !strings.Contains(messages[0].Body, "https://example.com/testimonials/verify?token=token-t-1") {
+		t.Fatalf("body has no verification link:\n%s", messages[0].Body)
+	}
+}
+
+func TestEmailSinkSkipsVerification(t *testing.T) {
+	verified := newTestTestimonial("verified")
+	verified.VerifyEmail()
+	sink, mailer := newTestEmailSink(t, verified)
+
+	// Подтвержденный email и удаленный отзыв не вызывают ни письма, ни повтора события
+	for _, id := range []string{"verified", "deleted"} {
+		if err := sink.Publish(context.Background(), verificationMessage(t, id)); err != nil {
+			t.Fatalf("Publish %s: %v", id, err)
+		}
+	}
+	if messages := mailer.Messages(); len(messages) != 0 {
+		t.Fatalf("sent %d messages, want none", len(messages))
+	}
+}
+
+func TestEmailSinkModerationEvents(t *testing.T) {
+	submitted := newTestTestimonial("submitted")
+	submitted.SetSpamScreening(0, nil, false)
+	sink, mailer := newTestEmailSink(t, submitted)
+	ctx := context.Background()
+
+	message, err := entities.NewOutboxMessage(TestimonialEntity, string(entities.TestimonialStatusSubmitted), "submitted", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Publish(ctx, message); err != nil {
+		t.Fatalf("Publish submitted: %v", err)
+	}
+	messages := mailer.Messages()
+	if len(messages) != 1 || messages[0].To[0] != "moderator@example.com" {
+		t.Fatalf("messages = %+v, want one to moderator", messages)
+	}
+
+	// Событие о решении, которое уже не совпадает со статусом отзыва, письма не вызывает
+	mailer.Reset()
+	message, err = entities.NewOutboxMessage(TestimonialEntity, string(entities.TestimonialStatusApproved), "submitted", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Publish(ctx, message); err != nil {
+		t.Fatalf("Publish approved: %v", err)
+	}
+	if messages := mailer.Messages(); len(messages) != 0 {
+		t.Fatalf("sent %d messages for outdated event, want none", len(messages))
+	}
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/infrastructure/websocket"
+)
+
+// HubSink отправляет события outbox клиентам WebSocket хаба
+type HubSink struct {
+	hub *websocket.Hub
+}
+
+// NewHubSink создает получателя событий для WebSocket хаба
+func NewHubSink(hub *websocket.Hub) *HubSink {
+	return &HubSink{hub: hub}
+}
+
+// Name возвращает имя получателя
+func (s *HubSink) Name() string {
+	return "websocket"
+}
+
+// Publish рассылает событие подписчикам сущности, системные события - всем клиентам;
+// события почты (MailEntity) пропускаются.
+// Подписаться на /ws может любой посетитель, поэтому события отзывов рассылаются в публичном виде (publicTestimonialEvent).
+// Клиент получает ID события и время его создания, а не отправки. Ошибка пересылки в кластер
+// возвращается, чтобы relay повторил событие; локальные клиенты при этом получат его повторно.
+func (s *HubSink) Publish(ctx context.Context, message *entities.OutboxMessage) error {
+	if message.Entity == MailEntity {
+		return nil
+	}
+
+	action, payload := message.Action, message.Payload
+	if message.Entity == TestimonialEntity {
+		var err error
+		if action, payload, err = publicTestimonialEvent(action, payload); err != nil {
+			return err
+		}
+	}
+
+	var data interface{}
+	if len(payload) > 0 {
+		data = payload
+	}
+
+	if message.Entity == SystemEntity {
+		return s.hub.BroadcastToAll(websocket.Message{
+			ID:        message.ID,
+			Type:      "system",
+			Event:     action,
+			Data:      data,
+			Timestamp: message.CreatedAt,
+		})
+	}
+
+	event := websocket.NewEventMessage(message.Entity, action, message.EntityID, data)
+	event.ID = message.ID
+	event.Timestamp = message.CreatedAt
+	return s.hub.PublishEvent(event)
+}
+
+// publicTestimonialEvent приводит событие отзыва к виду для анонимных подписчиков, как ответы API:
+// статус suspected_spam показывается как submitted, причина отклонения не передается.
+// Модераторы узнают о подозрительных отзывах из очереди модерации.
+func publicTestimonialEvent(action string, payload json.RawMessage) (string, json.RawMessage, error) {
+	action = publicTestimonialStatus(action)
+	if len(payload) == 0 {
+		return action, payload, nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return "", nil, fmt.Errorf("failed to decode testimonial event: %w", err)
+	}
+	for _, key := range []string{"status", "previousStatus"} {
+		if status, ok := data[key].(string); ok {
+			data[key] = publicTestimonialStatus(status)
+		}
+	}
+	delete(data, "rejectionReason")
+
+	public, err := json.Marshal(data)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode testimonial event: %w", err)
+	}
+	return action, public, nil
+}
+
+// publicTestimonialStatus скрывает статус suspected_spam
+func publicTestimonialStatus(status string) string {
+	if status == string(entities.TestimonialStatusSuspectedSpam) {
+		return string(entities.TestimonialStatusSubmitted)
+	}
+	return status
+}
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPublicTestimonialEventHidesSpam(t *testing.T) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"id":              "t-1",
+		"status":          "rejected",
+		"previousStatus":  "suspected_spam",
+		"rejectionReason": "реклама",
+		"version":         3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	action, public, err := publicTestimonialEvent("rejected", payload)
+	if err != nil {
+		t.Fatalf("publicTestimonialEvent: %v", err)
+	}
+	if action != "rejected" {
+		t.Fatalf("action = %q, want rejected", action)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(public, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data["previousStatus"] != "submitted" {
+		t.Fatalf("previousStatus = %v, want submitted", data["previousStatus"])
+	}
+	if _, ok := data["rejectionReason"]; ok {
+		t.Fatal("rejection reason is sent to public subscribers")
+	}
+	if data["id"] != "t-1" || data["version"] != float64(3) {
+		t.Fatalf("payload = %v", data)
+	}
+
+	// Новый подозрительный отзыв для подписчиков выглядит как обычная отправка
+	action, public, err = publicTestimonialEvent("suspected_spam", json.RawMessage(`{"status":"suspected_spam","previousStatus":""}`))
+	if err != nil {
+		t.Fatalf("publicTestimonialEvent: %v", err)
+	}
+	if action != "submitted" || string(public) != `{"previousStatus":"","status":"submitted"}` {
+		t.Fatalf("event = %s %s", action, public)
+	}
+}
@@ -2,34 +2,29 @@ package events
 
 import (
 	"context"
-	"log"
-	"time"
+	"fmt"
 
 	"tax-priority-api/src/application/events"
+	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
 	"tax-priority-api/src/infrastructure/websocket"
 )
 
-// NotificationServiceImpl реализация сервиса для отправки уведомлений
+// NotificationServiceImpl реализация сервиса уведомлений: события сохраняются в outbox
+// в транзакции изменения, в WebSocket хаб их отправляет OutboxRelay
 type NotificationServiceImpl struct {
-	hub *websocket.Hub
+	hub    *websocket.Hub
+	outbox repositories.OutboxRepository
 }
 
 // NewNotificationService создает новый сервис уведомлений
-func NewNotificationService(hub *websocket.Hub) events.NotificationService {
+func NewNotificationService(hub *websocket.Hub, outbox repositories.OutboxRepository) events.NotificationService {
 	return &NotificationServiceImpl{
-		hub: hub,
+		hub:    hub,
+		outbox: outbox,
 	}
 }
 
-// Event типы событий
-type Event struct {
-	Entity   string      `json:"entity"`
-	Action   string      `json:"action"`
-	EntityID string      `json:"entityId,omitempty"`
-	Data     interface{} `json:"data,omitempty"`
-}
-
 // FAQ события
 const (
 	FAQEntity = "faq"
@@ -42,188 +37,159 @@ const (
 	ActionDeactivated     = "deactivated"
 	ActionPriorityChanged = "priority_changed"
 	ActionCategoryChanged = "category_changed"
+	ActionBatchCreated    = "batch_created"
+	ActionBatchDeleted    = "batch_deleted"
 )
 
-// NotifyFAQCreated отправляет уведомление о создании FAQ
-func (s *NotificationServiceImpl) NotifyFAQCreated(ctx context.Context, faq *entities.FAQ) {
-	if s.hub == nil {
-		return
-	}
-
-	event := Event{
-		Entity:   FAQEntity,
-		Action:   ActionCreated,
-		EntityID: faq.ID,
-		Data:     faq,
-	}
-
-	s.hub.BroadcastEvent(event.Entity, event.Action, event.EntityID, event.Data)
-	log.Printf("Sent FAQ created notification for ID: %s", faq.ID)
-}
+// Feature события
+const (
+	FeatureEntity = "feature"
 
-// NotifyFAQUpdated отправляет уведомление об обновлении FAQ
-func (s *NotificationServiceImpl) NotifyFAQUpdated(ctx context.Context, faq *entities.FAQ) {
-	if s.hub == nil {
-		return
-	}
+	ActionReordered = "reordered"
+)
 
-	event := Event{
-		Entity:   FAQEntity,
-		Action:   ActionUpdated,
-		EntityID: faq.ID,
-		Data:     faq,
-	}
+// Testimonial события: действие совпадает с новым статусом отзыва
+// (submitted, suspected_spam, in_review, approved, rejected, archived)
+const TestimonialEntity = "testimonial"
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, event.EntityID, event.Data)
-	log.Printf("Sent FAQ updated notification for ID: %s", faq.ID)
-}
+// MailEntity события, которые получает только почта: в WebSocket они не рассылаются
+const (
+	MailEntity = "mail"
 
-// NotifyFAQDeleted отправляет уведомление об удалении FAQ
-func (s *NotificationServiceImpl) NotifyFAQDeleted(ctx context.Context, faqID string) {
-	if s.hub == nil {
-		return
-	}
+	// ActionTestimonialVerification письмо автору отзыва со ссылкой подтверждения email
+	ActionTestimonialVerification = "testimonial_verification"
+)
 
-	event := Event{
-		Entity:   FAQEntity,
-		Action:   ActionDeleted,
-		EntityID: faqID,
-		Data:     map[string]string{"id": faqID},
-	}
+// SystemEntity системные события рассылаются всем клиентам без подписки
+const SystemEntity = "system"
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, event.EntityID, event.Data)
-	log.Printf("Sent FAQ deleted notification for ID: %s", faqID)
+// NotifyFAQCreated сохраняет событие создания FAQ
+func (s *NotificationServiceImpl) NotifyFAQCreated(ctx context.Context, faq *entities.FAQ) error {
+	return s.enqueue(ctx, FAQEntity, ActionCreated, faq.ID, faq)
 }
 
-// NotifyFAQActivated отправляет уведомление об активации FAQ
-func (s *NotificationServiceImpl) NotifyFAQActivated(ctx context.Context, faq *entities.FAQ) {
-	if s.hub == nil {
-		return
-	}
-
-	event := Event{
-		Entity:   FAQEntity,
-		Action:   ActionActivated,
-		EntityID: faq.ID,
-		Data:     faq,
-	}
+// NotifyFAQUpdated сохраняет событие обновления FAQ
+func (s *NotificationServiceImpl) NotifyFAQUpdated(ctx context.Context, faq *entities.FAQ) error {
+	return s.enqueue(ctx, FAQEntity, ActionUpdated, faq.ID, faq)
+}
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, event.EntityID, event.Data)
-	log.Printf("Sent FAQ activated notification for ID: %s", faq.ID)
+// NotifyFAQDeleted сохраняет событие удаления FAQ
+func (s *NotificationServiceImpl) NotifyFAQDeleted(ctx context.Context, faqID string) error {
+	return s.enqueue(ctx, FAQEntity, ActionDeleted, faqID, map[string]string{"id": faqID})
 }
 
-// NotifyFAQDeactivated отправляет уведомление о деактивации FAQ
-func (s *NotificationServiceImpl) NotifyFAQDeactivated(ctx context.Context, faq *entities.FAQ) {
-	if s.hub == nil {
-		return
-	}
+// NotifyFAQActivated сохраняет событие активации FAQ
+func (s *NotificationServiceImpl) NotifyFAQActivated(ctx context.Context, faq *entities.FAQ) error {
+	return s.enqueue(ctx, FAQEntity, ActionActivated, faq.ID, faq)
+}
 
-	event := Event{
-		Entity:   FAQEntity,
-		Action:   ActionDeactivated,
-		EntityID: faq.ID,
-		Data:     faq,
-	}
+// NotifyFAQDeactivated сохраняет событие деактивации FAQ
+func (s *NotificationServiceImpl) NotifyFAQDeactivated(ctx context.Context, faq *entities.FAQ) error {
+	return s.enqueue(ctx, FAQEntity, ActionDeactivated, faq.ID, faq)
+}
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, event.EntityID, event.Data)
-	log.Printf("Sent FAQ deactivated notification for ID: %s", faq.ID)
+// NotifyFAQPriorityChanged сохраняет событие изменения приоритета FAQ
+func (s *NotificationServiceImpl) NotifyFAQPriorityChanged(ctx context.Context, faq *entities.FAQ, oldPriority int) error {
+	return s.enqueue(ctx, FAQEntity, ActionPriorityChanged, faq.ID, map[string]interface{}{
+		"faq":         faq,
+		"oldPriority": oldPriority,
+		"newPriority": faq.Priority,
+	})
 }
 
-// NotifyFAQPriorityChanged отправляет уведомление об изменении приоритета FAQ
-func (s *NotificationServiceImpl) NotifyFAQPriorityChanged(ctx context.Context, faq *entities.FAQ, oldPriority int) {
-	if s.hub == nil {
-		return
-	}
+// NotifyFAQCategoryChanged сохраняет событие изменения категории FAQ
+func (s *NotificationServiceImpl) NotifyFAQCategoryChanged(ctx context.Context, faq *entities.FAQ, oldCategory string) error {
+	return s.enqueue(ctx, FAQEntity, ActionCategoryChanged, faq.ID, map[string]interface{}{
+		"faq":         faq,
+		"oldCategory": oldCategory,
+		"newCategory": faq.Category,
+	})
+}
 
-	event := Event{
-		Entity:   FAQEntity,
-		Action:   ActionPriorityChanged,
-		EntityID: faq.ID,
-		Data: map[string]interface{}{
-			"faq":         faq,
-			"oldPriority": oldPriority,
-			"newPriority": faq.Priority,
-		},
-	}
+// NotifyFAQBatchCreated сохраняет событие массового создания FAQ
+func (s *NotificationServiceImpl) NotifyFAQBatchCreated(ctx context.Context, faqs []*entities.FAQ) error {
+	return s.enqueue(ctx, FAQEntity, ActionBatchCreated, "", map[string]interface{}{
+		"count": len(faqs),
+		"faqs":  faqs,
+	})
+}
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, event.EntityID, event.Data)
-	log.Printf("Sent FAQ priority changed notification for ID: %s (from %d to %d)", faq.ID, oldPriority, faq.Priority)
+// NotifyFAQBatchDeleted сохраняет событие массового удаления FAQ
+func (s *NotificationServiceImpl) NotifyFAQBatchDeleted(ctx context.Context, faqIDs []string) error {
+	return s.enqueue(ctx, FAQEntity, ActionBatchDeleted, "", map[string]interface{}{
+		"count": len(faqIDs),
+		"ids":   faqIDs,
+	})
 }
 
-// NotifyFAQCategoryChanged отправляет уведомление об изменении категории FAQ
-func (s *NotificationServiceImpl) NotifyFAQCategoryChanged(ctx context.Context, faq *entities.FAQ, oldCategory string) {
-	if s.hub == nil {
-		return
-	}
+// NotifyFeatureCreated сохраняет событие создания Feature
+func (s *NotificationServiceImpl) NotifyFeatureCreated(ctx context.Context, feature *entities.Feature) error {
+	return s.enqueue(ctx, FeatureEntity, ActionCreated, feature.ID, feature)
+}
 
-	event := Event{
-		Entity:   FAQEntity,
-		Action:   ActionCategoryChanged,
-		EntityID: faq.ID,
-		Data: map[string]interface{}{
-			"faq":         faq,
-			"oldCategory": oldCategory,
-			"newCategory": faq.Category,
-		},
-	}
+// NotifyFeatureUpdated сохраняет событие обновления Feature
+func (s *NotificationServiceImpl) NotifyFeatureUpdated(ctx context.Context, feature *entities.Feature) error {
+	return s.enqueue(ctx, FeatureEntity, ActionUpdated, feature.ID, feature)
+}
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, event.EntityID, event.Data)
-	log.Printf("Sent FAQ category changed notification for ID: %s (from %s to %s)", faq.ID, oldCategory, faq.Category)
+// NotifyFeatureDeleted сохраняет событие удаления Feature
+func (s *NotificationServiceImpl) NotifyFeatureDeleted(ctx context.Context, featureID string) error {
+	return s.enqueue(ctx, FeatureEntity, ActionDeleted, featureID, map[string]interface{}{"id": featureID})
 }
 
-// NotifyFAQBatchCreated отправляет уведомление о массовом создании FAQ
-func (s *NotificationServiceImpl) NotifyFAQBatchCreated(ctx context.Context, faqs []*entities.FAQ) {
-	if s.hub == nil {
-		return
-	}
+// NotifyFeatureActivated сохраняет событие активации Feature
+func (s *NotificationServiceImpl) NotifyFeatureActivated(ctx context.Context, feature *entities.Feature) error {
+	return s.enqueue(ctx, FeatureEntity, ActionActivated, feature.ID, feature)
+}
 
-	event := Event{
-		Entity: FAQEntity,
-		Action: "batch_created",
-		Data: map[string]interface{}{
-			"count": len(faqs),
-			"faqs":  faqs,
-		},
-	}
+// NotifyFeatureDeactivated сохраняет событие деактивации Feature
+func (s *NotificationServiceImpl) NotifyFeatureDeactivated(ctx context.Context, feature *entities.Feature) error {
+	return s.enqueue(ctx, FeatureEntity, ActionDeactivated, feature.ID, feature)
+}
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, "", event.Data)
-	log.Printf("Sent FAQ batch created notification for %d items", len(faqs))
+// NotifyFeaturesReordered сохраняет событие изменения порядка Feature
+func (s *NotificationServiceImpl) NotifyFeaturesReordered(ctx context.Context, featureIDs []string) error {
+	return s.enqueue(ctx, FeatureEntity, ActionReordered, "", map[string]interface{}{
+		"ids": featureIDs,
+	})
 }
 
-// NotifyFAQBatchDeleted отправляет уведомление о массовом удалении FAQ
-func (s *NotificationServiceImpl) NotifyFAQBatchDeleted(ctx context.Context, faqIDs []string) {
-	if s.hub == nil {
-		return
-	}
+// NotifyTestimonialStatusChanged сохраняет событие перехода отзыва между статусами модерации.
+// Подписаться на события может любой клиент WebSocket, поэтому текст отзыва, email и заметки модератора
+// в событие не попадают; статус suspected_spam и причину отклонения HubSink скрывает при рассылке.
+func (s *NotificationServiceImpl) NotifyTestimonialStatusChanged(ctx context.Context, testimonial *entities.Testimonial, previous entities.TestimonialStatus) error {
+	return s.enqueue(ctx, TestimonialEntity, string(testimonial.CurrentStatus()), testimonial.ID, map[string]interface{}{
+		"id":              testimonial.ID,
+		"status":          testimonial.CurrentStatus(),
+		"previousStatus":  previous,
+		"rejectionReason": testimonial.RejectionReason,
+		"statusChangedAt": testimonial.StatusChangedAt,
+		"version":         testimonial.Version,
+	})
+}
 
-	event := Event{
-		Entity: FAQEntity,
-		Action: "batch_deleted",
-		Data: map[string]interface{}{
-			"count": len(faqIDs),
-			"ids":   faqIDs,
-		},
-	}
+// NotifyTestimonialVerificationRequested сохраняет событие для письма со ссылкой подтверждения email.
+// Событие содержит только ID отзыва: адрес и токен получатель берет из отзыва при отправке.
+func (s *NotificationServiceImpl) NotifyTestimonialVerificationRequested(ctx context.Context, testimonial *entities.Testimonial) error {
+	return s.enqueue(ctx, MailEntity, ActionTestimonialVerification, testimonial.ID, nil)
+}
 
-	s.hub.BroadcastEvent(event.Entity, event.Action, "", event.Data)
-	log.Printf("Sent FAQ batch deleted notification for %d items", len(faqIDs))
+// NotifySystemEvent сохраняет системное событие
+func (s *NotificationServiceImpl) NotifySystemEvent(ctx context.Context, event string, data interface{}) error {
+	return s.enqueue(ctx, SystemEntity, event, "", data)
 }
 
-// NotifySystemEvent отправляет системное уведомление
-func (s *NotificationServiceImpl) NotifySystemEvent(ctx context.Context, event string, data interface{}) {
-	if s.hub == nil {
-		return
+// enqueue сохраняет событие в outbox; в транзакции ctx событие фиксируется вместе с изменением
+func (s *NotificationServiceImpl) enqueue(ctx context.Context, entity, action, entityID string, data interface{}) error {
+	message, err := entities.NewOutboxMessage(entity, action, entityID, data)
+	if err != nil {
+		return err
 	}
 
-	message := websocket.Message{
-		Type:      "system",
-		Event:     event,
-		Data:      data,
-		Timestamp: time.Now(),
+	if err := s.outbox.Append(ctx, message); err != nil {
+		return fmt.Errorf("failed to queue %s.%s event: %w", entity, action, err)
 	}
-
-	s.hub.BroadcastToAll(message)
-	log.Printf("Sent system notification: %s", event)
+	return nil
 }
 
 // GetStats возвращает статистику WebSocket подключений
@@ -236,9 +202,13 @@ func (s *NotificationServiceImpl) GetStats() map[string]interface{} {
 	}
 
 	return map[string]interface{}{
-		"enabled":         true,
-		"connections":     s.hub.GetClientCount(),
-		"faq_subscribers": s.hub.GetSubscriptionCount(FAQEntity),
+		"enabled":                 true,
+		"clustered":               s.hub.IsClustered(),
+		"node_id":                 s.hub.NodeID(),
+		"connections":             s.hub.GetClientCount(),
+		"faq_subscribers":         s.hub.GetSubscriptionCount(FAQEntity),
+		"feature_subscribers":     s.hub.GetSubscriptionCount(FeatureEntity),
+		"testimonial_subscribers": s.hub.GetSubscriptionCount(TestimonialEntity),
 	}
 }
 
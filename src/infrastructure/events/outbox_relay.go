@@ -0,0 +1,192 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// outboxCleanupInterval период удаления отправленных событий
+const outboxCleanupInterval = time.Hour
+
+// OutboxSink получатель событий outbox
+type OutboxSink interface {
+	// Name имя получателя для логов
+	Name() string
+	// Publish доставляет событие; ошибка приводит к повторной доставке события этому получателю,
+	// получатели, которым событие уже доставлено, его повторно не получают
+	Publish(ctx context.Context, message *entities.OutboxMessage) error
+}
+
+// OutboxRelayConfig настройки отправки событий outbox
+type OutboxRelayConfig struct {
+	// Interval период опроса outbox
+	Interval time.Duration
+	// BatchSize количество событий, которые экземпляр берет в работу за раз
+	BatchSize int
+	// Lease срок, на который взятые события недоступны другим экземплярам; должен превышать время
+	// доставки пачки. События, до которых relay не дошел за этот срок, откладываются до следующего опроса
+	Lease time.Duration
+	// MaxBackoff максимальная задержка перед повторной отправкой
+	MaxBackoff time.Duration
+	// Retention срок хранения отправленных событий, 0 отключает удаление
+	Retention time.Duration
+}
+
+// NewOutboxRelayConfig создает настройки из окружения
+func NewOutboxRelayConfig() OutboxRelayConfig {
+	return OutboxRelayConfig{
+		Interval:   config.GetEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+		BatchSize:  config.GetEnvInt("OUTBOX_BATCH_SIZE", 100),
+		Lease:      config.GetEnvDuration("OUTBOX_LEASE", 5*time.Minute),
+		MaxBackoff: config.GetEnvDuration("OUTBOX_MAX_BACKOFF", 5*time.Minute),
+		Retention:  config.GetEnvDuration("OUTBOX_RETENTION", 7*24*time.Hour),
+	}
+}
+
+// OutboxRelay отправляет сохраненные в outbox события получателям.
+// Доставка не реже одного раза: доставка получателю отмечается после его публикации, и при сбое
+// между ними событие будет отправлено этому получателю повторно с тем же ID. Ошибка одного получателя
+// не повторяет событие для остальных. Неудачные отправки повторяются с растущей задержкой.
+// Экземпляры API разбирают outbox параллельно: взятые другим экземпляром события пропускаются.
+// Доставка идет вне транзакции, поэтому медленный получатель не держит блокировки строк outbox.
+type OutboxRelay struct {
+	outbox repositories.OutboxRepository
+	sinks  []OutboxSink
+	config OutboxRelayConfig
+}
+
+// NewOutboxRelay создает relay событий outbox
+func NewOutboxRelay(outbox repositories.OutboxRepository, sinks []OutboxSink, config OutboxRelayConfig) *OutboxRelay {
+	return &OutboxRelay{
+		outbox: outbox,
+		sinks:  sinks,
+		config: config,
+	}
+}
+
+// Run отправляет события с заданным периодом до отмены ctx
+func (r *OutboxRelay) Run(ctx context.Context) {
+	if r.config.Interval <= 0 || r.config.BatchSize <= 0 || r.config.Lease <= 0 {
+		log.Println("Outbox relay is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		r.drain(ctx)
+
+		if time.Since(lastCleanup) >= outboxCleanupInterval {
+			r.cleanup(ctx)
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain отправляет события пачками, пока в outbox остаются доступные события
+func (r *OutboxRelay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		claimed, err := r.RelayBatch(ctx)
+		if err != nil {
+			log.Printf("Failed to relay outbox events: %v", err)
+			return
+		}
+		if claimed < r.config.BatchSize {
+			return
+		}
+	}
+}
+
+// RelayBatch отправляет одну пачку событий и возвращает количество взятых в работу событий
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	now := time.Now()
+	leaseUntil := now.Add(r.config.Lease)
+	messages, err := r.outbox.ClaimPending(ctx, now, leaseUntil, r.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, message := range messages {
+		// После срока аренды событие может взять другой экземпляр: оставшиеся события ждут следующего опроса
+		if !time.Now().Before(leaseUntil) {
+			log.Printf("Outbox lease expired, %d events are left for the next poll", len(messages)-i)
+			return len(messages), nil
+		}
+
+		if err := r.publish(ctx, message); err != nil {
+			attempt := message.Attempts + 1
+			log.Printf("Failed to publish event %s (%s.%s), attempt %d: %v", message.ID, message.Entity, message.Action, attempt, err)
+			if err := r.outbox.MarkFailed(ctx, message.ID, err.Error(), time.Now().Add(r.backoff(attempt))); err != nil {
+				return len(messages), err
+			}
+			continue
+		}
+
+		if err := r.outbox.MarkPublished(ctx, message.ID, time.Now()); err != nil {
+			return len(messages), err
+		}
+	}
+	return len(messages), nil
+}
+
+// publish доставляет событие получателям, которые его еще не получили, и сразу отмечает каждую доставку:
+// при ошибке другого получателя или сбое экземпляра доставленное событие не повторяется
+func (r *OutboxRelay) publish(ctx context.Context, message *entities.OutboxMessage) error {
+	var errs []error
+	for _, sink := range r.sinks {
+		name := sink.Name()
+		if message.IsDeliveredTo(name) {
+			continue
+		}
+		if err := sink.Publish(ctx, message); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if err := r.outbox.MarkDelivered(ctx, message.ID, name); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		message.MarkDeliveredTo(name)
+	}
+	return errors.Join(errs...)
+}
+
+// backoff задержка перед попыткой attempt: 1s, 2s, 4s... не больше MaxBackoff
+func (r *OutboxRelay) backoff(attempt int) time.Duration {
+	delay := time.Second << min(attempt-1, 20)
+	if r.config.MaxBackoff > 0 && delay > r.config.MaxBackoff {
+		return r.config.MaxBackoff
+	}
+	return delay
+}
+
+// cleanup удаляет события, отправленные раньше срока хранения
+func (r *OutboxRelay) cleanup(ctx context.Context) {
+	if r.config.Retention <= 0 {
+		return
+	}
+
+	deleted, err := r.outbox.DeletePublished(ctx, time.Now().Add(-r.config.Retention))
+	if err != nil {
+		log.Printf("Failed to delete published outbox events: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("Deleted %d published outbox events", deleted)
+	}
+}
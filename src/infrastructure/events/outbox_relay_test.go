@@ -0,0 +1,174 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"tax-priority-api/src/domain/entities"
+)
+
+// memoryOutbox outbox в памяти с той же семантикой аренды, что у OutboxRepositoryImpl
+type memoryOutbox struct {
+	mu       sync.Mutex
+	messages []*entities.OutboxMessage
+}
+
+func (o *memoryOutbox) Append(_ context.Context, message *entities.OutboxMessage) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.messages = append(o.messages, message)
+	return nil
+}
+
+func (o *memoryOutbox) ClaimPending(_ context.Context, now, leaseUntil time.Time, limit int) ([]*entities.OutboxMessage, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var claimed []*entities.OutboxMessage
+	for _, message := range o.messages {
+		if len(claimed) == limit {
+			break
+		}
+		if message.IsPublished() || message.AvailableAt.After(now) {
+			continue
+		}
+		message.AvailableAt = leaseUntil
+		copied := *message
+		copied.DeliveredSinks = append([]string(nil), message.DeliveredSinks...)
+		claimed = append(claimed, &copied)
+	}
+	return claimed, nil
+}
+
+func (o *memoryOutbox) MarkDelivered(_ context.Context, id string, sink string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.find(id).MarkDeliveredTo(sink)
+	return nil
+}
+
+func (o *memoryOutbox) MarkPublished(_ context.Context, id string, publishedAt time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.find(id).PublishedAt = &publishedAt
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(_ context.Context, id string, lastError string, availableAt time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	message := o.find(id)
+	message.Attempts++
+	message.LastError = lastError
+	message.AvailableAt = availableAt
+	return nil
+}
+
+func (o *memoryOutbox) DeletePublished(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (o *memoryOutbox) find(id string) *entities.OutboxMessage {
+	for _, message := range o.messages {
+		if message.ID == id {
+			return message
+		}
+	}
+	return nil
+}
+
+// countingSink считает доставки и возвращает ошибку, пока failures больше нуля
+type countingSink struct {
+	name      string
+	failures  int
+	delivered map[string]int
+}
+
+func newCountingSink(name string, failures int) *countingSink {
+	return &countingSink{name: name, failures: failures, delivered: make(map[string]int)}
+}
+
+func (s *countingSink) Name() string {
+	return s.name
+}
+
+func (s *countingSink) Publish(_ context.Context, message *entities.OutboxMessage) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("connection refused")
+	}
+	s.delivered[message.ID]++
+	return nil
+}
+
+func newTestRelay(t *testing.T, outbox *memoryOutbox, sinks ...OutboxSink) *OutboxRelay {
+	t.Helper()
+	message, err := entities.NewOutboxMessage(TestimonialEntity, string(entities.TestimonialStatusApproved), "t-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message.ID = "event-1"
+	if err := outbox.Append(context.Background(), message); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewOutboxRelay(outbox, sinks, OutboxRelayConfig{
+		Interval:   time.Second,
+		BatchSize:  10,
+		Lease:      time.Minute,
+		MaxBackoff: time.Millisecond,
+	})
+}
+
+func TestOutboxRelayRetriesOnlyFailedSink(t *testing.T) {
+	outbox := &memoryOutbox{}
+	hub := newCountingSink("websocket", 0)
+	email := newCountingSink("email", 2)
+	relay := newTestRelay(t, outbox, hub, email)
+	ctx := context.Background()
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		// Событие снова доступно после задержки повтора
+		outbox.find("event-1").AvailableAt = time.Now().Add(-time.Second)
+		if _, err := relay.RelayBatch(ctx); err != nil {
+			t.Fatalf("RelayBatch %d: %v", attempt, err)
+		}
+	}
+
+	message := outbox.find("event-1")
+	if !message.IsPublished() {
+		t.Fatalf("event is not published after email recovered: attempts %d, last error %q", message.Attempts, message.LastError)
+	}
+	if message.Attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", message.Attempts)
+	}
+	if hub.delivered["event-1"] != 1 {
+		t.Fatalf("websocket received event %d times, want 1", hub.delivered["event-1"])
+	}
+	if email.delivered["event-1"] != 1 {
+		t.Fatalf("email received event %d times, want 1", email.delivered["event-1"])
+	}
+}
+
+func TestOutboxRelayLeasesClaimedEvents(t *testing.T) {
+	outbox := &memoryOutbox{}
+	email := newCountingSink("email", 1)
+	relay := newTestRelay(t, outbox, email)
+	ctx := context.Background()
+
+	claimed, err := outbox.ClaimPending(ctx, time.Now(), time.Now().Add(time.Minute), 10)
+	if err != nil || len(claimed) != 1 {
+		t.Fatalf("ClaimPending: %d events, %v", len(claimed), err)
+	}
+
+	// Событие, взятое другим экземпляром, не доставляется до окончания аренды
+	if n, err := relay.RelayBatch(ctx); err != nil || n != 0 {
+		t.Fatalf("RelayBatch during lease: %d events, %v", n, err)
+	}
+	if len(email.delivered) != 0 || email.failures != 1 {
+		t.Fatal("leased event was delivered")
+	}
+}
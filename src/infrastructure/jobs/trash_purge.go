@@ -0,0 +1,75 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// Purger репозиторий с корзиной, из которой можно окончательно удалить записи
+type Purger interface {
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
+
+// TrashPurgeJob периодически удаляет записи, которые находятся в корзине дольше срока хранения
+type TrashPurgeJob struct {
+	targets   map[string]Purger
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewTrashPurgeJob создает задачу очистки корзины; targets - репозитории по имени для логов
+func NewTrashPurgeJob(retention, interval time.Duration, targets map[string]Purger) *TrashPurgeJob {
+	return &TrashPurgeJob{
+		targets:   targets,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// NewTrashPurgeJobFromEnv создает задачу с настройками из окружения:
+// TRASH_RETENTION_DAYS - срок хранения в днях (0 отключает очистку), TRASH_PURGE_INTERVAL - период запуска
+func NewTrashPurgeJobFromEnv(targets map[string]Purger) *TrashPurgeJob {
+	retentionDays := config.GetEnvInt("TRASH_RETENTION_DAYS", 30)
+	interval := config.GetEnvDuration("TRASH_PURGE_INTERVAL", time.Hour)
+	return NewTrashPurgeJob(time.Duration(retentionDays)*24*time.Hour, interval, targets)
+}
+
+// Run выполняет очистку сразу и затем с заданным периодом до отмены ctx
+func (j *TrashPurgeJob) Run(ctx context.Context) {
+	if j.retention <= 0 || j.interval <= 0 {
+		log.Println("Trash purge is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.PurgeExpired(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpired окончательно удаляет записи, помещенные в корзину раньше срока хранения
+func (j *TrashPurgeJob) PurgeExpired(ctx context.Context) {
+	deletedBefore := time.Now().Add(-j.retention)
+
+	for name, target := range j.targets {
+		purged, err := target.Purge(ctx, deletedBefore)
+		if err != nil {
+			log.Printf("Failed to purge %s trash: %v", name, err)
+			continue
+		}
+		if purged > 0 {
+			log.Printf("Purged %d %s from trash", purged, name)
+		}
+	}
+}
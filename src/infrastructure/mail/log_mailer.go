@@ -0,0 +1,24 @@
+package mail
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	appMail "tax-priority-api/src/application/mail"
+)
+
+// LogMailer пишет письма в лог вместо отправки; для разработки
+type LogMailer struct{}
+
+func NewLogMailer() appMail.Mailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(_ context.Context, message appMail.Message) error {
+	if len(message.To) == 0 {
+		return appMail.ErrNoRecipients
+	}
+	log.Printf("Mail to %s: %s\n%s", strings.Join(message.To, ", "), message.Subject, message.Body)
+	return nil
+}
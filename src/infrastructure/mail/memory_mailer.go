@@ -0,0 +1,43 @@
+package mail
+
+import (
+	"context"
+	"sync"
+
+	appMail "tax-priority-api/src/application/mail"
+)
+
+// MemoryMailer сохраняет письма в памяти вместо отправки; для тестов и локальной проверки
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []appMail.Message
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(_ context.Context, message appMail.Message) error {
+	if len(message.To) == 0 {
+		return appMail.ErrNoRecipients
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+// Messages - копия отправленных писем в порядке отправки
+func (m *MemoryMailer) Messages() []appMail.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]appMail.Message(nil), m.messages...)
+}
+
+// Reset - удаляет сохраненные письма
+func (m *MemoryMailer) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = nil
+}
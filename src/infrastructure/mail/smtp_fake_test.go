@@ -0,0 +1,213 @@
+package mail
+
+import (
+	"encoding/base64"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// smtpSession команды и письмо одного соединения с фейковым сервером
+type smtpSession struct {
+	helo string
+	// auth данные AUTH PLAIN: authzid, username и password через \x00
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTPServer SMTP сервер в процессе теста на net.Listen: принимает письма и сохраняет
+// конверт и данные; расширения EHLO, отказ в RCPT и молчание при подключении настраиваются
+type fakeSMTPServer struct {
+	t        *testing.T
+	listener net.Listener
+	// extensions расширения в ответе EHLO, например AUTH PLAIN или STARTTLS
+	extensions []string
+	// rejectRcpt адрес, на который RCPT TO отвечает 550
+	rejectRcpt string
+	// silent сервер принимает соединение, но не отправляет приветствие
+	silent bool
+
+	mu       sync.Mutex
+	sessions []*smtpSession
+	wg       sync.WaitGroup
+	done     chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T, configure func(*fakeSMTPServer)) *fakeSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	server := &fakeSMTPServer{t: t, listener: listener, done: make(chan struct{})}
+	if configure != nil {
+		configure(server)
+	}
+
+	server.wg.Add(1)
+	go server.serve()
+	t.Cleanup(func() {
+		close(server.done)
+		listener.Close()
+		server.wg.Wait()
+	})
+	return server
+}
+
+// config настройки отправителя для этого сервера
+func (s *fakeSMTPServer) config() SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return SMTPConfig{
+		Host:    "127.0.0.1",
+		Port:    addr.Port,
+		From:    "Налоговый приоритет <noreply@example.com>",
+		TLSMode: SMTPTLSNone,
+	}
+}
+
+// received возвращает копии завершенных и текущих сессий
+func (s *fakeSMTPServer) received() []smtpSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions := make([]smtpSession, len(s.sessions))
+	for i, session := range s.sessions {
+		sessions[i] = *session
+		sessions[i].to = append([]string(nil), session.to...)
+	}
+	return sessions
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			if s.silent {
+				<-s.done
+				return
+			}
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+// handle ведет диалог SMTP до QUIT или разрыва соединения
+func (s *fakeSMTPServer) handle(conn *textproto.Conn) {
+	session := &smtpSession{}
+	s.mu.Lock()
+	s.sessions = append(s.sessions, session)
+	s.mu.Unlock()
+
+	reply := func(line string) bool {
+		return conn.PrintfLine("%s", line) == nil
+	}
+	if !reply("220 fake.example.com ESMTP") {
+		return
+	}
+
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			s.mu.Lock()
+			session.helo = arg
+			s.mu.Unlock()
+			lines := append([]string{"fake.example.com"}, s.extensions...)
+			for i, ext := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				if !reply("250" + sep + ext) {
+					return
+				}
+			}
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(arg, " ")
+			decoded, err := base64.StdEncoding.DecodeString(initial)
+			if mechanism != "PLAIN" || err != nil {
+				reply("504 unsupported authentication")
+				continue
+			}
+			s.mu.Lock()
+			session.auth = string(decoded)
+			s.mu.Unlock()
+			reply("235 authenticated")
+		case "MAIL":
+			s.mu.Lock()
+			session.from = envelopeAddress(arg, "FROM:")
+			s.mu.Unlock()
+			reply("250 sender ok")
+		case "RCPT":
+			address := envelopeAddress(arg, "TO:")
+			if address == s.rejectRcpt {
+				reply("550 mailbox unavailable")
+				continue
+			}
+			s.mu.Lock()
+			session.to = append(session.to, address)
+			s.mu.Unlock()
+			reply("250 recipient ok")
+		case "DATA":
+			if !reply("354 end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := readData(conn)
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			session.data = data
+			s.mu.Unlock()
+			reply("250 queued")
+		case "RSET", "NOOP":
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+// readData читает данные письма до строки из одной точки и снимает экранирование точек.
+// В отличие от ReadDotBytes сохраняет CRLF, чтобы тест видел письмо как на проводе.
+func readData(conn *textproto.Conn) (string, error) {
+	var data strings.Builder
+	for {
+		line, err := conn.R.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if line == ".\r\n" {
+			return data.String(), nil
+		}
+		data.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
+
+// envelopeAddress извлекает адрес из аргумента MAIL FROM:<...> или RCPT TO:<...>
+func envelopeAddress(arg, prefix string) string {
+	arg = strings.TrimPrefix(arg, prefix)
+	if end := strings.IndexByte(arg, '>'); strings.HasPrefix(arg, "<") && end > 0 {
+		return arg[1:end]
+	}
+	return arg
+}
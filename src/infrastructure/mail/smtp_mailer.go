@@ -0,0 +1,219 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	appMail "tax-priority-api/src/application/mail"
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// Режимы шифрования соединения с SMTP сервером
+const (
+	// SMTPTLSStartTLS - обычное соединение, переключаемое командой STARTTLS; сервер обязан ее поддерживать
+	SMTPTLSStartTLS = "starttls"
+	// SMTPTLSImplicit - TLS с момента подключения, обычно порт 465
+	SMTPTLSImplicit = "tls"
+	// SMTPTLSNone - без шифрования; для локальных и тестовых серверов
+	SMTPTLSNone = "none"
+)
+
+// SMTPConfig настройки отправки писем через SMTP
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username и Password для AUTH PLAIN; пустой Username - без авторизации
+	Username string
+	Password string
+	// From адрес отправителя, можно с именем: "Tax Priority <noreply@example.com>"
+	From    string
+	TLSMode string
+	// Timeout ограничивает подключение и отправку одного письма
+	Timeout time.Duration
+}
+
+// NewSMTPConfig загружает настройки из переменных окружения
+func NewSMTPConfig() SMTPConfig {
+	return SMTPConfig{
+		Host:     config.GetEnv("SMTP_HOST", "localhost"),
+		Port:     config.GetEnvInt("SMTP_PORT", 587),
+		Username: config.GetEnv("SMTP_USERNAME", ""),
+		Password: config.GetEnv("SMTP_PASSWORD", ""),
+		From:     config.GetEnv("SMTP_FROM", ""),
+		TLSMode:  config.GetEnv("SMTP_TLS", SMTPTLSStartTLS),
+		Timeout:  config.GetEnvDuration("SMTP_TIMEOUT", 10*time.Second),
+	}
+}
+
+// SMTPMailer отправляет письма через SMTP сервер; на каждое письмо открывается новое соединение
+type SMTPMailer struct {
+	config SMTPConfig
+	from   *netmail.Address
+}
+
+// NewSMTPMailer проверяет настройки и создает отправителя
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	if cfg.Host == "" {
+		return nil, errors.New("SMTP host is required")
+	}
+	from, err := netmail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP sender %q: %w", cfg.From, err)
+	}
+	switch cfg.TLSMode {
+	case SMTPTLSStartTLS, SMTPTLSImplicit, SMTPTLSNone:
+	default:
+		return nil, fmt.Errorf("unknown SMTP TLS mode %q, expected starttls, tls or none", cfg.TLSMode)
+	}
+	return &SMTPMailer{config: cfg, from: from}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message appMail.Message) error {
+	if len(message.To) == 0 {
+		return appMail.ErrNoRecipients
+	}
+	recipients := make([]*netmail.Address, 0, len(message.To))
+	for _, to := range message.To {
+		address, err := netmail.ParseAddress(to)
+		if err != nil {
+			return fmt.Errorf("invalid recipient %q: %w", to, err)
+		}
+		recipients = append(recipients, address)
+	}
+
+	data, err := m.compose(message, recipients)
+	if err != nil {
+		return err
+	}
+
+	if m.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.config.Timeout)
+		defer cancel()
+	}
+	client, err := m.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Mail(m.from.Address); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM failed: %w", err)
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient.Address); err != nil {
+			return fmt.Errorf("SMTP RCPT TO %s failed: %w", recipient.Address, err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA failed: %w", err)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("SMTP server rejected message: %w", err)
+	}
+	return client.Quit()
+}
+
+// connect подключается к серверу, включает шифрование и авторизуется.
+// Срок ctx переносится на соединение: зависший сервер не блокирует отправку дольше Timeout.
+func (m *SMTPMailer) connect(ctx context.Context) (*smtp.Client, error) {
+	address := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	tlsConfig := &tls.Config{ServerName: m.config.Host}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SMTP server %s: %w", address, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if m.config.TLSMode == SMTPTLSImplicit {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("SMTP handshake with %s failed: %w", address, err)
+	}
+
+	if m.config.TLSMode == SMTPTLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			client.Close()
+			return nil, fmt.Errorf("SMTP server %s does not support STARTTLS", address)
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("SMTP STARTTLS failed: %w", err)
+		}
+	}
+
+	if m.config.Username != "" {
+		auth := smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)
+		if err := client.Auth(auth); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+	return client, nil
+}
+
+// compose собирает письмо: заголовки с закодированной темой и текст в quoted-printable
+func (m *SMTPMailer) compose(message appMail.Message, recipients []*netmail.Address) ([]byte, error) {
+	to := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		to = append(to, recipient.String())
+	}
+
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", m.from.String()},
+		{"To", strings.Join(to, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", strings.Join(strings.Fields(message.Subject), " "))},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", m.messageID()},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=utf-8"},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, header := range headers {
+		buf.WriteString(header[0] + ": " + header[1] + "\r\n")
+	}
+	buf.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&buf)
+	text := strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n")
+	if _, err := body.Write([]byte(text)); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (m *SMTPMailer) messageID() string {
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+	domain := m.from.Address[strings.LastIndex(m.from.Address, "@")+1:]
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">"
+}
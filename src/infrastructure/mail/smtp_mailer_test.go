@@ -0,0 +1,198 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	appMail "tax-priority-api/src/application/mail"
+)
+
+func newTestSMTPMailer(t *testing.T, cfg SMTPConfig) *SMTPMailer {
+	t.Helper()
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	mailer, err := NewSMTPMailer(cfg)
+	if err != nil {
+		t.Fatalf("NewSMTPMailer: %v", err)
+	}
+	return mailer
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	server := newFakeSMTPServer(t, func(s *fakeSMTPServer) {
+		s.extensions = []string{"8BITMIME", "AUTH PLAIN"}
+	})
+	cfg := server.config()
+	cfg.Username = "mailer"
+	cfg.Password = "secret"
+	mailer := newTestSMTPMailer(t, cfg)
+
+	// Длинная строка с кириллицей переносится мягкими переносами, строка с точкой в начале экранируется в DATA
+	longLine := strings.Repeat("Спасибо за консультацию по налоговому вычету! ", 4)
+	body := "Здравствуйте, Иван!\n" + longLine + "\n.точка в начале строки\nЦена = 100%"
+	err := mailer.Send(context.Background(), appMail.Message{
+		To:      []string{"author@example.com", "Модератор <moderator@example.com>"},
+		Subject: "Подтвердите\n email",
+		Body:    body,
+	})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	sessions := server.received()
+	if len(sessions) != 1 {
+		t.Fatalf("sessions = %d, want 1", len(sessions))
+	}
+	session := sessions[0]
+
+	if session.helo != "localhost" {
+		t.Fatalf("EHLO = %q", session.helo)
+	}
+	if session.auth != "\x00mailer\x00secret" {
+		t.Fatalf("AUTH PLAIN = %q", session.auth)
+	}
+	if session.from != "noreply@example.com" {
+		t.Fatalf("MAIL FROM = %q", session.from)
+	}
+	if strings.Join(session.to, ",") != "author@example.com,moderator@example.com" {
+		t.Fatalf("RCPT TO = %v", session.to)
+	}
+
+	message, err := netmail.ReadMessage(strings.NewReader(session.data))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+
+	from, err := message.Header.AddressList("From")
+	if err != nil || len(from) != 1 || from[0].Name != "Налоговый приоритет" || from[0].Address != "noreply@example.com" {
+		t.Fatalf("From = %v (%v)", from, err)
+	}
+	to, err := message.Header.AddressList("To")
+	if err != nil || len(to) != 2 || to[0].Address != "author@example.com" ||
+		to[1].Name != "Модератор" || to[1].Address != "moderator@example.com" {
+		t.Fatalf("To = %v (%v)", to, err)
+	}
+	var decoder mime.WordDecoder
+	// Перевод строки в теме не должен попадать в заголовок
+	subject, err := decoder.DecodeHeader(message.Header.Get("Subject"))
+	if err != nil || subject != "Подтвердите email" {
+		t.Fatalf("Subject = %q (%v)", subject, err)
+	}
+	if !strings.HasPrefix(message.Header.Get("Subject"), "=?utf-8?q?") {
+		t.Fatalf("Subject is not Q-encoded: %q", message.Header.Get("Subject"))
+	}
+	if _, err := message.Header.Date(); err != nil {
+		t.Fatalf("Date: %v", err)
+	}
+	if id := message.Header.Get("Message-ID"); !strings.HasPrefix(id, "<") || !strings.HasSuffix(id, "@example.com>") {
+		t.Fatalf("Message-ID = %q", id)
+	}
+	for header, want := range map[string]string{
+		"MIME-Version":              "1.0",
+		"Content-Type":              "text/plain; charset=utf-8",
+		"Content-Transfer-Encoding": "quoted-printable",
+	} {
+		if got := message.Header.Get(header); got != want {
+			t.Fatalf("%s = %q, want %q", header, got, want)
+		}
+	}
+
+	raw, err := io.ReadAll(message.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(raw), "\r\n") {
+		if len(line) > 76 {
+			t.Fatalf("quoted-printable line longer than 76 bytes: %q", line)
+		}
+	}
+	decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(string(raw))))
+	if err != nil {
+		t.Fatalf("decode quoted-printable: %v", err)
+	}
+	// Клиент SMTP завершает данные без перевода строки в конце CRLF перед точкой
+	if want := strings.ReplaceAll(body, "\n", "\r\n") + "\r\n"; string(decoded) != want {
+		t.Fatalf("body = %q, want %q", decoded, want)
+	}
+}
+
+func TestSMTPMailerRejectedRecipient(t *testing.T) {
+	server := newFakeSMTPServer(t, func(s *fakeSMTPServer) {
+		s.rejectRcpt = "missing@example.com"
+	})
+	mailer := newTestSMTPMailer(t, server.config())
+
+	err := mailer.Send(context.Background(), appMail.Message{
+		To:      []string{"author@example.com", "missing@example.com"},
+		Subject: "Тема",
+		Body:    "Текст",
+	})
+	if err == nil || !strings.Contains(err.Error(), "RCPT TO missing@example.com") {
+		t.Fatalf("Send: %v, want RCPT TO error", err)
+	}
+	if sessions := server.received(); len(sessions) != 1 || sessions[0].data != "" {
+		t.Fatalf("message data was sent after rejected recipient: %+v", sessions)
+	}
+}
+
+func TestSMTPMailerRequiresStartTLS(t *testing.T) {
+	server := newFakeSMTPServer(t, nil)
+	cfg := server.config()
+	cfg.TLSMode = SMTPTLSStartTLS
+	cfg.Username = "mailer"
+	cfg.Password = "secret"
+	mailer := newTestSMTPMailer(t, cfg)
+
+	// Сервер без STARTTLS: письмо и пароль не отправляются открытым текстом
+	err := mailer.Send(context.Background(), appMail.Message{To: []string{"author@example.com"}, Subject: "Тема", Body: "Текст"})
+	if err == nil || !strings.Contains(err.Error(), "does not support STARTTLS") {
+		t.Fatalf("Send: %v, want STARTTLS error", err)
+	}
+	if sessions := server.received(); len(sessions) != 1 || sessions[0].auth != "" || sessions[0].from != "" {
+		t.Fatalf("credentials or envelope sent without TLS: %+v", sessions)
+	}
+}
+
+func TestSMTPMailerTimeout(t *testing.T) {
+	server := newFakeSMTPServer(t, func(s *fakeSMTPServer) {
+		s.silent = true
+	})
+	cfg := server.config()
+	cfg.Timeout = 200 * time.Millisecond
+	mailer := newTestSMTPMailer(t, cfg)
+
+	started := time.Now()
+	err := mailer.Send(context.Background(), appMail.Message{To: []string{"author@example.com"}, Subject: "Тема", Body: "Текст"})
+	if err == nil {
+		t.Fatal("Send to silent server succeeded")
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Fatalf("Send took %v with timeout %v", elapsed, cfg.Timeout)
+	}
+}
+
+func TestSMTPMailerValidation(t *testing.T) {
+	if _, err := NewSMTPMailer(SMTPConfig{Host: "localhost", From: "not an address", TLSMode: SMTPTLSNone}); err == nil {
+		t.Fatal("invalid sender accepted")
+	}
+	if _, err := NewSMTPMailer(SMTPConfig{Host: "localhost", From: "noreply@example.com", TLSMode: "ssl"}); err == nil {
+		t.Fatal("unknown TLS mode accepted")
+	}
+
+	mailer := newTestSMTPMailer(t, SMTPConfig{Host: "127.0.0.1", Port: 1, From: "noreply@example.com", TLSMode: SMTPTLSNone})
+	if err := mailer.Send(context.Background(), appMail.Message{Subject: "Тема"}); !errors.Is(err, appMail.ErrNoRecipients) {
+		t.Fatalf("Send without recipients: %v, want ErrNoRecipients", err)
+	}
+	if err := mailer.Send(context.Background(), appMail.Message{To: []string{"bad address"}}); err == nil ||
+		!strings.Contains(err.Error(), "invalid recipient") {
+		t.Fatalf("Send to invalid address: %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// CursorCodec кодирует и подписывает курсоры пагинации, чтобы клиент не мог их подделать
+type CursorCodec struct {
+	secret []byte
+}
+
+// NewCursorCodec создает кодек курсоров с указанным ключом подписи
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{secret: secret}
+}
+
+// NewCursorCodecFromEnv создает кодек с ключом из CURSOR_SECRET или случайным ключом процесса (см. config.SecretOrRandom)
+func NewCursorCodecFromEnv() *CursorCodec {
+	return NewCursorCodec(config.SecretOrRandom("CURSOR_SECRET"))
+}
+
+// Encode сериализует payload и добавляет HMAC подпись
+func (c *CursorCodec) Encode(payload any) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(c.sign(encoded)), nil
+}
+
+// Decode проверяет подпись курсора и десериализует payload
+func (c *CursorCodec) Decode(cursor string, payload any) error {
+	encoded, signature, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return errors.New("malformed cursor")
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, c.sign(encoded)) {
+		return errors.New("invalid cursor signature")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return errors.New("malformed cursor")
+	}
+
+	return json.Unmarshal(data, payload)
+}
+
+func (c *CursorCodec) sign(data string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -1,10 +1,13 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"tax-priority-api/src/infrastructure/persistence/migrations"
+
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -44,6 +47,8 @@ func Connect(config *DatabaseConfig) (*gorm.DB, error) {
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		// Ошибки драйвера (например, нарушение уникальности) приводятся к gorm.Err*
+		TranslateError: true,
 		NowFunc: func() time.Time {
 			return time.Now().UTC()
 		},
@@ -68,21 +73,34 @@ func Connect(config *DatabaseConfig) (*gorm.DB, error) {
 	return db, nil
 }
 
-// Migrate выполняет миграции базы данных
-func Migrate(db *gorm.DB) error {
+// Migrate применяет недостающие миграции схемы.
+// Если база новее бинарника, возвращает ошибку migrations.ErrSchemaTooNew.
+func Migrate(ctx context.Context, db *gorm.DB) error {
 	log.Println("Starting database migration...")
 
-	// Автоматическая миграция всех моделей
-	err := db.AutoMigrate()
-
+	migrator, err := migrations.NewMigrator(db)
 	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	if err := migrator.Up(ctx); err != nil {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
 
-	log.Println("Database migration completed successfully")
+	log.Printf("Database schema is at version %d", migrator.Latest())
 	return nil
 }
 
+// CheckSchemaVersion проверяет, что схема базы совпадает с версией бинарника, не изменяя ее
+func CheckSchemaVersion(ctx context.Context, db *gorm.DB) error {
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	return migrator.CheckVersion(ctx)
+}
+
 // Close закрывает соединение с базой данных
 func Close(db *gorm.DB) error {
 	sqlDB, err := db.DB()
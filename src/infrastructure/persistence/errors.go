@@ -26,6 +26,7 @@ const (
 	ErrCodeConstraint    = "CONSTRAINT_VIOLATION"
 	ErrCodeTimeout       = "TIMEOUT"
 	ErrCodeTransaction   = "TRANSACTION_ERROR"
+	ErrCodeConflict      = "VERSION_CONFLICT"
 )
 
 // Фабричные функции для ошибок
@@ -44,3 +45,7 @@ func NewInvalidInputError(message string, cause error) *RepositoryError {
 func NewInternalError(message string, cause error) *RepositoryError {
 	return &RepositoryError{Code: ErrCodeInternal, Message: message, Cause: cause}
 }
+
+func NewVersionConflictError(message string, cause error) *RepositoryError {
+	return &RepositoryError{Code: ErrCodeConflict, Message: message, Cause: cause}
+}
@@ -0,0 +1,17 @@
+-- IF NOT EXISTS: базы, созданные через AutoMigrate, принимают миграцию без изменений
+CREATE TABLE IF NOT EXISTS faqs (
+    id          varchar(36) PRIMARY KEY,
+    question    text NOT NULL,
+    answer      text NOT NULL,
+    category    varchar(100) NOT NULL,
+    is_active   boolean DEFAULT true,
+    priority    bigint DEFAULT 0,
+    created_at  timestamptz,
+    updated_at  timestamptz,
+    deleted_at  timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_faqs_category ON faqs (category);
+CREATE INDEX IF NOT EXISTS idx_faqs_is_active ON faqs (is_active);
+CREATE INDEX IF NOT EXISTS idx_faqs_priority ON faqs (priority);
+CREATE INDEX IF NOT EXISTS idx_faqs_deleted_at ON faqs (deleted_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_faqs_search_vector;
+ALTER TABLE faqs DROP COLUMN IF EXISTS search_vector;
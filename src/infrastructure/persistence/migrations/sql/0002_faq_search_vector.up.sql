@@ -0,0 +1,12 @@
+-- Полнотекстовый поиск: русский и английский стемминг, вопрос весит больше ответа и категории
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(question, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(question, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(answer, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(answer, '')), 'B') ||
+        setweight(to_tsvector('russian', coalesce(category, '')), 'C') ||
+        setweight(to_tsvector('english', coalesce(category, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_faqs_search_vector ON faqs USING GIN (search_vector);
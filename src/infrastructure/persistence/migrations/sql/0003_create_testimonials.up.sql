@@ -0,0 +1,38 @@
+-- IF NOT EXISTS: базы, созданные через AutoMigrate, принимают миграцию без пересоздания таблицы
+CREATE TABLE IF NOT EXISTS testimonials (
+    id            varchar(255) PRIMARY KEY,
+    content       text NOT NULL,
+    author        varchar(100) NOT NULL,
+    author_email  varchar(255) NOT NULL,
+    rating        bigint NOT NULL,
+    file_path     varchar(500),
+    file_name     varchar(255),
+    file_type     varchar(50),
+    file_size     bigint,
+    is_approved   boolean DEFAULT false,
+    is_active     boolean DEFAULT true,
+    approved_at   timestamptz,
+    approved_by   varchar(255),
+    company       varchar(255),
+    position      varchar(255),
+    created_at    timestamptz,
+    updated_at    timestamptz,
+    CONSTRAINT chk_testimonials_rating CHECK (rating >= 1 AND rating <= 5)
+);
+
+-- AutoMigrate создавал даты отзывов как timestamp без часового пояса; приложение писало их в UTC
+DO $$
+DECLARE
+    col text;
+BEGIN
+    FOREACH col IN ARRAY ARRAY['approved_at', 'created_at', 'updated_at'] LOOP
+        IF EXISTS (
+            SELECT 1 FROM information_schema.columns
+            WHERE table_schema = current_schema() AND table_name = 'testimonials'
+              AND column_name = col AND data_type = 'timestamp without time zone'
+        ) THEN
+            EXECUTE format('ALTER TABLE testimonials ALTER COLUMN %I TYPE timestamptz USING %I AT TIME ZONE ''UTC''', col, col);
+        END IF;
+    END LOOP;
+END;
+$$;
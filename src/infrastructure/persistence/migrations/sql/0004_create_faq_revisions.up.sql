@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS faq_revisions (
+    id             varchar(36) PRIMARY KEY,
+    faq_id         varchar(36) NOT NULL,
+    revision       bigint NOT NULL,
+    action         varchar(20) NOT NULL,
+    "before"       jsonb,
+    "after"        jsonb,
+    changed_by     varchar(255),
+    restored_from  integer,
+    created_at     timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_faq_revisions_faq_revision ON faq_revisions (faq_id, revision);
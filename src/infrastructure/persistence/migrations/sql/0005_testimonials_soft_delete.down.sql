@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_testimonials_deleted_at;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,3 @@
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS idx_testimonials_deleted_at ON testimonials (deleted_at);
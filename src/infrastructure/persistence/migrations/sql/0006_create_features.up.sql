@@ -0,0 +1,16 @@
+-- IF NOT EXISTS: базы, созданные через AutoMigrate, принимают миграцию без изменений
+CREATE TABLE IF NOT EXISTS features (
+    id           varchar(36) PRIMARY KEY,
+    name         varchar(200) NOT NULL,
+    description  text,
+    icon         varchar(100),
+    sort_order   bigint DEFAULT 0,
+    is_active    boolean DEFAULT true,
+    created_at   timestamptz,
+    updated_at   timestamptz,
+    deleted_at   timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_features_sort_order ON features (sort_order);
+CREATE INDEX IF NOT EXISTS idx_features_is_active ON features (is_active);
+CREATE INDEX IF NOT EXISTS idx_features_deleted_at ON features (deleted_at);
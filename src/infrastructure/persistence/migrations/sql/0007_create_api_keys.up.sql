@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+    id            varchar(36) PRIMARY KEY,
+    name          varchar(100) NOT NULL,
+    prefix        varchar(16) NOT NULL,
+    key_hash      varchar(64) NOT NULL,
+    scopes        varchar(255) NOT NULL,
+    expires_at    timestamptz,
+    last_used_at  timestamptz,
+    revoked_at    timestamptz,
+    created_by    varchar(255),
+    created_at    timestamptz,
+    updated_at    timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_prefix ON api_keys (prefix);
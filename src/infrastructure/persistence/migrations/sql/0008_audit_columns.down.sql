@@ -0,0 +1,5 @@
+ALTER TABLE testimonials DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS created_by;
+
+ALTER TABLE faqs DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE faqs DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,6 @@
+-- Автор и последний редактор записи; заполняются из токена запроса, старые записи остаются без автора
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS created_by varchar(255);
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS updated_by varchar(255);
+
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS created_by varchar(255);
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS updated_by varchar(255);
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id           varchar(36) PRIMARY KEY,
+    actor        varchar(255),
+    action       varchar(40) NOT NULL,
+    entity_type  varchar(40) NOT NULL,
+    entity_id    varchar(36),
+    "before"     jsonb,
+    "after"      jsonb,
+    request_id   varchar(128),
+    client_ip    varchar(45),
+    created_at   timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON audit_log (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_actor ON audit_log (actor);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log (created_at);
+
+-- Журнал только дополняется: изменение и удаление записей запрещены на уровне базы
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id            varchar(36) PRIMARY KEY,
+    entity        varchar(40) NOT NULL,
+    action        varchar(60) NOT NULL,
+    entity_id     varchar(36),
+    payload       jsonb,
+    attempts      integer NOT NULL DEFAULT 0,
+    last_error    text,
+    available_at  timestamptz NOT NULL,
+    published_at  timestamptz,
+    created_at    timestamptz NOT NULL
+);
+
+-- Выборка ожидающих отправки событий
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (available_at, created_at) WHERE published_at IS NULL;
+-- Удаление отправленных событий после срока хранения
+CREATE INDEX IF NOT EXISTS idx_outbox_published_at ON outbox (published_at) WHERE published_at IS NOT NULL;
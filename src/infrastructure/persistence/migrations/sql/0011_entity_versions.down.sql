@@ -0,0 +1,3 @@
+ALTER TABLE testimonials DROP COLUMN IF EXISTS version;
+
+ALTER TABLE faqs DROP COLUMN IF EXISTS version;
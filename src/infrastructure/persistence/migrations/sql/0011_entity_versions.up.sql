@@ -0,0 +1,4 @@
+-- Версия записи для оптимистичной блокировки: растет при каждом обновлении и отдается клиенту как ETag
+ALTER TABLE faqs ADD COLUMN IF NOT EXISTS version integer NOT NULL DEFAULT 1;
+
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS version integer NOT NULL DEFAULT 1;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_testimonials_moderation_queue;
+
+ALTER TABLE testimonials DROP CONSTRAINT IF EXISTS chk_testimonials_status;
+
+ALTER TABLE testimonials DROP COLUMN IF EXISTS moderator_notes;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS rejection_reason;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS reviewed_by;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS status_changed_at;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS status;
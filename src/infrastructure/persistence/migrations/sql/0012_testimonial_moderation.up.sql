@@ -0,0 +1,17 @@
+-- Статус модерации отзыва: submitted -> in_review -> approved/rejected -> archived
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS status varchar(20) NOT NULL DEFAULT 'submitted';
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS status_changed_at timestamptz;
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS reviewed_by varchar(255);
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS rejection_reason varchar(50);
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS moderator_notes text;
+
+-- Одобренные до появления статусов отзывы сохраняют одобрение
+UPDATE testimonials SET status = 'approved', status_changed_at = approved_at WHERE is_approved AND status = 'submitted';
+
+ALTER TABLE testimonials DROP CONSTRAINT IF EXISTS chk_testimonials_status;
+ALTER TABLE testimonials ADD CONSTRAINT chk_testimonials_status
+    CHECK (status IN ('submitted', 'in_review', 'approved', 'rejected', 'archived'));
+
+-- Очередь модерации: ожидающие решения отзывы от старых к новым
+CREATE INDEX IF NOT EXISTS idx_testimonials_moderation_queue ON testimonials (created_at)
+    WHERE status IN ('submitted', 'in_review') AND deleted_at IS NULL;
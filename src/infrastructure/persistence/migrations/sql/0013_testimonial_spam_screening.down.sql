@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_testimonials_moderation_queue;
+CREATE INDEX IF NOT EXISTS idx_testimonials_moderation_queue ON testimonials (created_at)
+    WHERE status IN ('submitted', 'in_review') AND deleted_at IS NULL;
+
+-- Подозрительные отправки возвращаются в общую очередь
+UPDATE testimonials SET status = 'submitted' WHERE status = 'suspected_spam';
+
+ALTER TABLE testimonials DROP CONSTRAINT IF EXISTS chk_testimonials_status;
+ALTER TABLE testimonials ADD CONSTRAINT chk_testimonials_status
+    CHECK (status IN ('submitted', 'in_review', 'approved', 'rejected', 'archived'));
+
+ALTER TABLE testimonials DROP COLUMN IF EXISTS spam_signals;
+ALTER TABLE testimonials DROP COLUMN IF EXISTS spam_score;
@@ -0,0 +1,12 @@
+-- Результат автоматической проверки отправки на спам
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS spam_score int NOT NULL DEFAULT 0;
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS spam_signals jsonb;
+
+-- Отправки с высокой оценкой ждут модератора в отдельной очереди suspected_spam
+ALTER TABLE testimonials DROP CONSTRAINT IF EXISTS chk_testimonials_status;
+ALTER TABLE testimonials ADD CONSTRAINT chk_testimonials_status
+    CHECK (status IN ('submitted', 'in_review', 'approved', 'rejected', 'archived', 'suspected_spam'));
+
+DROP INDEX IF EXISTS idx_testimonials_moderation_queue;
+CREATE INDEX IF NOT EXISTS idx_testimonials_moderation_queue ON testimonials (created_at)
+    WHERE status IN ('submitted', 'in_review', 'suspected_spam') AND deleted_at IS NULL;
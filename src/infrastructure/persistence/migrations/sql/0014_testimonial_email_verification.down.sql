@@ -0,0 +1 @@
+ALTER TABLE testimonials DROP COLUMN IF EXISTS email_verified_at;
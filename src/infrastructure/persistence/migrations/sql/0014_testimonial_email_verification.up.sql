@@ -0,0 +1,5 @@
+-- Подтверждение email автора отзыва по подписанной ссылке
+ALTER TABLE testimonials ADD COLUMN IF NOT EXISTS email_verified_at timestamptz;
+
+-- Отзывы, отправленные до появления подтверждения, считаются подтвержденными: ссылки для них не отправлялись
+UPDATE testimonials SET email_verified_at = created_at WHERE email_verified_at IS NULL;
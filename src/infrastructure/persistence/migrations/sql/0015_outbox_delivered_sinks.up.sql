@@ -0,0 +1,3 @@
+-- Получатели, которым событие уже доставлено: при повторе после ошибки другого получателя
+-- событие отправляется только тем, кто его не получил
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS delivered_sinks jsonb;
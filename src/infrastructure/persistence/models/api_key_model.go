@@ -0,0 +1,68 @@
+package models
+
+import (
+	"strings"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// APIKeyModel GORM модель API ключа
+type APIKeyModel struct {
+	ID     string `gorm:"primaryKey;type:varchar(36)"`
+	Name   string `gorm:"type:varchar(100);not null"`
+	Prefix string `gorm:"type:varchar(16);not null;uniqueIndex:idx_api_keys_prefix"`
+	// KeyHash SHA-256 ключа в hex
+	KeyHash string `gorm:"type:varchar(64);not null"`
+	// Scopes разделены пробелом, как в claim scope токена
+	Scopes     string     `gorm:"type:varchar(255);not null"`
+	ExpiresAt  *time.Time `gorm:"type:timestamptz"`
+	LastUsedAt *time.Time `gorm:"type:timestamptz"`
+	RevokedAt  *time.Time `gorm:"type:timestamptz"`
+	CreatedBy  string     `gorm:"type:varchar(255)"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName возвращает имя таблицы для GORM
+func (*APIKeyModel) TableName() string {
+	return "api_keys"
+}
+
+// ToEntity преобразует GORM модель в domain entity
+func (m *APIKeyModel) ToEntity() *entities.APIKey {
+	return &entities.APIKey{
+		ID:         m.ID,
+		Name:       m.Name,
+		Prefix:     m.Prefix,
+		KeyHash:    m.KeyHash,
+		Scopes:     strings.Fields(m.Scopes),
+		ExpiresAt:  m.ExpiresAt,
+		LastUsedAt: m.LastUsedAt,
+		RevokedAt:  m.RevokedAt,
+		CreatedBy:  m.CreatedBy,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+// FromEntity заполняет GORM модель из domain entity
+func (m *APIKeyModel) FromEntity(key *entities.APIKey) {
+	m.ID = key.ID
+	m.Name = key.Name
+	m.Prefix = key.Prefix
+	m.KeyHash = key.KeyHash
+	m.Scopes = strings.Join(key.Scopes, " ")
+	m.ExpiresAt = key.ExpiresAt
+	m.LastUsedAt = key.LastUsedAt
+	m.RevokedAt = key.RevokedAt
+	m.CreatedBy = key.CreatedBy
+	m.CreatedAt = key.CreatedAt
+	m.UpdatedAt = key.UpdatedAt
+}
+
+// NewAPIKeyModelFromEntity создает новую GORM модель из domain entity
+func NewAPIKeyModelFromEntity(key *entities.APIKey) *APIKeyModel {
+	model := &APIKeyModel{}
+	model.FromEntity(key)
+	return model
+}
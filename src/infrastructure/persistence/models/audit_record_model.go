@@ -0,0 +1,77 @@
+package models
+
+import (
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// AuditRecordModel GORM модель записи журнала аудита
+type AuditRecordModel struct {
+	ID         string    `gorm:"primaryKey;type:varchar(36)"`
+	Actor      string    `gorm:"type:varchar(255);index"`
+	Action     string    `gorm:"type:varchar(40);not null"`
+	EntityType string    `gorm:"type:varchar(40);not null;index:idx_audit_log_entity,priority:1"`
+	EntityID   string    `gorm:"type:varchar(36);index:idx_audit_log_entity,priority:2"`
+	Before     *string   `gorm:"type:jsonb"`
+	After      *string   `gorm:"type:jsonb"`
+	RequestID  string    `gorm:"type:varchar(128)"`
+	ClientIP   string    `gorm:"type:varchar(45)"`
+	CreatedAt  time.Time `gorm:"autoCreateTime;index"`
+}
+
+// TableName возвращает имя таблицы для GORM
+func (*AuditRecordModel) TableName() string {
+	return "audit_log"
+}
+
+// ToEntity преобразует GORM модель в domain entity
+func (m *AuditRecordModel) ToEntity() *entities.AuditRecord {
+	return &entities.AuditRecord{
+		ID:         m.ID,
+		Actor:      m.Actor,
+		Action:     entities.AuditAction(m.Action),
+		EntityType: entities.AuditEntityType(m.EntityType),
+		EntityID:   m.EntityID,
+		Before:     rawJSON(m.Before),
+		After:      rawJSON(m.After),
+		RequestID:  m.RequestID,
+		ClientIP:   m.ClientIP,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// FromEntity заполняет GORM модель из domain entity
+func (m *AuditRecordModel) FromEntity(record *entities.AuditRecord) {
+	m.ID = record.ID
+	m.Actor = record.Actor
+	m.Action = string(record.Action)
+	m.EntityType = string(record.EntityType)
+	m.EntityID = record.EntityID
+	m.Before = jsonString(record.Before)
+	m.After = jsonString(record.After)
+	m.RequestID = record.RequestID
+	m.ClientIP = record.ClientIP
+	m.CreatedAt = record.CreatedAt
+}
+
+// NewAuditRecordModelFromEntity создает новую GORM модель из domain entity
+func NewAuditRecordModelFromEntity(record *entities.AuditRecord) *AuditRecordModel {
+	model := &AuditRecordModel{}
+	model.FromEntity(record)
+	return model
+}
+
+func jsonString(data []byte) *string {
+	if len(data) == 0 {
+		return nil
+	}
+	value := string(data)
+	return &value
+}
+
+func rawJSON(data *string) []byte {
+	if data == nil || *data == "" {
+		return nil
+	}
+	return []byte(*data)
+}
@@ -16,9 +16,14 @@ type FAQModel struct {
 	Category  string         `gorm:"type:varchar(100);not null;index"`
 	IsActive  bool           `gorm:"default:true;index"`
 	Priority  int            `gorm:"default:0;index"`
+	CreatedBy string         `gorm:"type:varchar(255)"`
+	UpdatedBy string         `gorm:"type:varchar(255)"`
+	Version   int            `gorm:"not null;default:1"`
 	CreatedAt time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
 	DeletedAt gorm.DeletedAt `gorm:"index"`
+	// SearchVector поддерживается PostgreSQL как генерируемая колонка (миграция 0002_faq_search_vector)
+	SearchVector string `gorm:"column:search_vector;->:false;-:migration"`
 }
 
 // TableName возвращает имя таблицы для GORM
@@ -26,6 +31,11 @@ func (*FAQModel) TableName() string {
 	return "faqs"
 }
 
+// CursorSortFields поля, по которым разрешена keyset-пагинация; значение поля попадает в курсор открытым текстом
+func (*FAQModel) CursorSortFields() []string {
+	return []string{"createdAt", "updatedAt", "priority", "category", "question"}
+}
+
 // ToEntity преобразует GORM модель в domain entity
 func (m *FAQModel) ToEntity() *entities.FAQ {
 	return &entities.FAQ{
@@ -35,8 +45,12 @@ func (m *FAQModel) ToEntity() *entities.FAQ {
 		Category:  m.Category,
 		IsActive:  m.IsActive,
 		Priority:  m.Priority,
+		CreatedBy: m.CreatedBy,
+		UpdatedBy: m.UpdatedBy,
+		Version:   m.Version,
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
+		DeletedAt: deletedAtToTime(m.DeletedAt),
 	}
 }
 
@@ -48,8 +62,12 @@ func (m *FAQModel) FromEntity(faq *entities.FAQ) {
 	m.Category = faq.Category
 	m.IsActive = faq.IsActive
 	m.Priority = faq.Priority
+	m.CreatedBy = faq.CreatedBy
+	m.UpdatedBy = faq.UpdatedBy
+	m.Version = faq.Version
 	m.CreatedAt = faq.CreatedAt
 	m.UpdatedAt = faq.UpdatedAt
+	m.DeletedAt = timeToDeletedAt(faq.DeletedAt)
 }
 
 // NewFAQModelFromEntity создает новую GORM модель из domain entity
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"encoding/json"
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// FAQRevisionModel GORM модель ревизии FAQ
+type FAQRevisionModel struct {
+	ID           string    `gorm:"primaryKey;type:varchar(36)"`
+	FAQID        string    `gorm:"column:faq_id;type:varchar(36);not null;uniqueIndex:idx_faq_revisions_faq_revision,priority:1"`
+	Revision     int       `gorm:"not null;uniqueIndex:idx_faq_revisions_faq_revision,priority:2"`
+	Action       string    `gorm:"type:varchar(20);not null"`
+	Before       *string   `gorm:"type:jsonb"`
+	After        *string   `gorm:"type:jsonb"`
+	ChangedBy    string    `gorm:"type:varchar(255)"`
+	RestoredFrom *int      `gorm:"type:int"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для GORM
+func (*FAQRevisionModel) TableName() string {
+	return "faq_revisions"
+}
+
+// ToEntity преобразует GORM модель в domain entity
+func (m *FAQRevisionModel) ToEntity() *entities.FAQRevision {
+	return &entities.FAQRevision{
+		ID:           m.ID,
+		FAQID:        m.FAQID,
+		Revision:     m.Revision,
+		Action:       entities.FAQRevisionAction(m.Action),
+		Before:       unmarshalSnapshot(m.Before),
+		After:        unmarshalSnapshot(m.After),
+		ChangedBy:    m.ChangedBy,
+		ChangedAt:    m.CreatedAt,
+		RestoredFrom: m.RestoredFrom,
+	}
+}
+
+// FromEntity заполняет GORM модель из domain entity
+func (m *FAQRevisionModel) FromEntity(revision *entities.FAQRevision) {
+	m.ID = revision.ID
+	m.FAQID = revision.FAQID
+	m.Revision = revision.Revision
+	m.Action = string(revision.Action)
+	m.Before = marshalSnapshot(revision.Before)
+	m.After = marshalSnapshot(revision.After)
+	m.ChangedBy = revision.ChangedBy
+	m.RestoredFrom = revision.RestoredFrom
+	m.CreatedAt = revision.ChangedAt
+}
+
+// NewFAQRevisionModelFromEntity создает новую GORM модель из domain entity
+func NewFAQRevisionModelFromEntity(revision *entities.FAQRevision) *FAQRevisionModel {
+	model := &FAQRevisionModel{}
+	model.FromEntity(revision)
+	return model
+}
+
+func marshalSnapshot(snapshot *entities.FAQSnapshot) *string {
+	if snapshot == nil {
+		return nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil
+	}
+	value := string(data)
+	return &value
+}
+
+func unmarshalSnapshot(data *string) *entities.FAQSnapshot {
+	if data == nil || *data == "" {
+		return nil
+	}
+	var snapshot entities.FAQSnapshot
+	if err := json.Unmarshal([]byte(*data), &snapshot); err != nil {
+		return nil
+	}
+	return &snapshot
+}
@@ -7,14 +7,17 @@ import (
 	"gorm.io/gorm"
 )
 
-// FeatureModel GORM модель для FAQ
+// FeatureModel GORM модель для Feature
 type FeatureModel struct {
-	ID        string         `gorm:"primaryKey;type:varchar(36)"`
-	Name      string         `gorm:"type:text;not null"`
-	IsActive  bool           `gorm:"default:true;index"`
-	CreatedAt time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID          string         `gorm:"primaryKey;type:varchar(36)"`
+	Name        string         `gorm:"type:varchar(200);not null"`
+	Description string         `gorm:"type:text"`
+	Icon        string         `gorm:"type:varchar(100)"`
+	SortOrder   int            `gorm:"default:0;index"`
+	IsActive    bool           `gorm:"default:true;index"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
 
 // TableName возвращает имя таблицы для GORM
@@ -22,14 +25,23 @@ func (*FeatureModel) TableName() string {
 	return "features"
 }
 
+// CursorSortFields поля, по которым разрешена keyset-пагинация; значение поля попадает в курсор открытым текстом
+func (*FeatureModel) CursorSortFields() []string {
+	return []string{"createdAt", "updatedAt", "sortOrder", "name"}
+}
+
 // ToEntity преобразует GORM модель в domain entity
 func (m *FeatureModel) ToEntity() *entities.Feature {
 	return &entities.Feature{
-		ID:        m.ID,
-		Name:      m.Name,
-		IsActive:  m.IsActive,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Icon:        m.Icon,
+		SortOrder:   m.SortOrder,
+		IsActive:    m.IsActive,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		DeletedAt:   deletedAtToTime(m.DeletedAt),
 	}
 }
 
@@ -37,9 +49,13 @@ func (m *FeatureModel) ToEntity() *entities.Feature {
 func (m *FeatureModel) FromEntity(feature *entities.Feature) {
 	m.ID = feature.ID
 	m.Name = feature.Name
+	m.Description = feature.Description
+	m.Icon = feature.Icon
+	m.SortOrder = feature.SortOrder
 	m.IsActive = feature.IsActive
 	m.CreatedAt = feature.CreatedAt
 	m.UpdatedAt = feature.UpdatedAt
+	m.DeletedAt = timeToDeletedAt(feature.DeletedAt)
 }
 
 // NewFeatureModelFromEntity создает новую GORM модель из domain entity
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"tax-priority-api/src/domain/entities"
+	"time"
+)
+
+// OutboxMessageModel GORM модель события outbox
+type OutboxMessageModel struct {
+	ID        string  `gorm:"primaryKey;type:varchar(36)"`
+	Entity    string  `gorm:"type:varchar(40);not null"`
+	Action    string  `gorm:"type:varchar(60);not null"`
+	EntityID  string  `gorm:"type:varchar(36)"`
+	Payload   *string `gorm:"type:jsonb"`
+	Attempts  int     `gorm:"not null;default:0"`
+	LastError string  `gorm:"type:text"`
+	// DeliveredSinks JSON массив имен получателей, которым событие доставлено
+	DeliveredSinks *string    `gorm:"type:jsonb"`
+	AvailableAt    time.Time  `gorm:"not null"`
+	PublishedAt    *time.Time `gorm:"index"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для GORM
+func (*OutboxMessageModel) TableName() string {
+	return "outbox"
+}
+
+// ToEntity преобразует GORM модель в domain entity
+func (m *OutboxMessageModel) ToEntity() *entities.OutboxMessage {
+	return &entities.OutboxMessage{
+		ID:             m.ID,
+		Entity:         m.Entity,
+		Action:         m.Action,
+		EntityID:       m.EntityID,
+		Payload:        rawJSON(m.Payload),
+		Attempts:       m.Attempts,
+		LastError:      m.LastError,
+		DeliveredSinks: unmarshalStrings(m.DeliveredSinks),
+		AvailableAt:    m.AvailableAt,
+		PublishedAt:    m.PublishedAt,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+// FromEntity заполняет GORM модель из domain entity
+func (m *OutboxMessageModel) FromEntity(message *entities.OutboxMessage) {
+	m.ID = message.ID
+	m.Entity = message.Entity
+	m.Action = message.Action
+	m.EntityID = message.EntityID
+	m.Payload = jsonString(message.Payload)
+	m.Attempts = message.Attempts
+	m.LastError = message.LastError
+	m.DeliveredSinks = marshalStrings(message.DeliveredSinks)
+	m.AvailableAt = message.AvailableAt
+	m.PublishedAt = message.PublishedAt
+	m.CreatedAt = message.CreatedAt
+}
+
+// NewOutboxMessageModelFromEntity создает новую GORM модель из domain entity
+func NewOutboxMessageModelFromEntity(message *entities.OutboxMessage) *OutboxMessageModel {
+	model := &OutboxMessageModel{}
+	model.FromEntity(message)
+	return model
+}
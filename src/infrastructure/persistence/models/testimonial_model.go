@@ -1,74 +1,143 @@
 package models
 
 import (
+	"encoding/json"
 	"tax-priority-api/src/domain/entities"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type TestimonialModel struct {
-	ID          string     `gorm:"primaryKey;type:varchar(255)" json:"id"`
-	Content     string     `gorm:"type:text;not null" json:"content"`
-	Author      string     `gorm:"type:varchar(100);not null" json:"author"`
-	AuthorEmail string     `gorm:"type:varchar(255);not null" json:"authorEmail"`
-	Rating      int        `gorm:"type:int;not null;check:rating >= 1 AND rating <= 5" json:"rating"`
-	FilePath    string     `gorm:"type:varchar(500)" json:"filePath"`
-	FileName    string     `gorm:"type:varchar(255)" json:"fileName"`
-	FileType    string     `gorm:"type:varchar(50)" json:"fileType"`
-	FileSize    int64      `gorm:"type:bigint" json:"fileSize"`
-	IsApproved  bool       `gorm:"type:boolean;default:false" json:"isApproved"`
-	IsActive    bool       `gorm:"type:boolean;default:true" json:"isActive"`
-	ApprovedAt  *time.Time `gorm:"type:timestamp" json:"approvedAt"`
-	ApprovedBy  string     `gorm:"type:varchar(255)" json:"approvedBy"`
-	Company     string     `gorm:"type:varchar(255)" json:"company"`
-	Position    string     `gorm:"type:varchar(255)" json:"position"`
-	CreatedAt   time.Time  `gorm:"type:timestamp;autoCreateTime" json:"createdAt"`
-	UpdatedAt   time.Time  `gorm:"type:timestamp;autoUpdateTime" json:"updatedAt"`
+	ID              string         `gorm:"primaryKey;type:varchar(255)" json:"id"`
+	Content         string         `gorm:"type:text;not null" json:"content"`
+	Author          string         `gorm:"type:varchar(100);not null" json:"author"`
+	AuthorEmail     string         `gorm:"type:varchar(255);not null" json:"authorEmail"`
+	EmailVerifiedAt *time.Time     `gorm:"type:timestamptz" json:"emailVerifiedAt"`
+	Rating          int            `gorm:"type:int;not null;check:rating >= 1 AND rating <= 5" json:"rating"`
+	FilePath        string         `gorm:"type:varchar(500)" json:"filePath"`
+	FileName        string         `gorm:"type:varchar(255)" json:"fileName"`
+	FileType        string         `gorm:"type:varchar(50)" json:"fileType"`
+	FileSize        int64          `gorm:"type:bigint" json:"fileSize"`
+	Status          string         `gorm:"type:varchar(20);not null;default:submitted" json:"status"`
+	StatusChangedAt *time.Time     `gorm:"type:timestamptz" json:"statusChangedAt"`
+	IsApproved      bool           `gorm:"type:boolean;default:false" json:"isApproved"`
+	IsActive        bool           `gorm:"type:boolean;default:true" json:"isActive"`
+	ApprovedAt      *time.Time     `gorm:"type:timestamptz" json:"approvedAt"`
+	ApprovedBy      string         `gorm:"type:varchar(255)" json:"approvedBy"`
+	ReviewedBy      string         `gorm:"type:varchar(255)" json:"reviewedBy"`
+	RejectionReason string         `gorm:"type:varchar(50)" json:"rejectionReason"`
+	ModeratorNotes  string         `gorm:"type:text" json:"moderatorNotes"`
+	SpamScore       int            `gorm:"type:int;not null;default:0" json:"spamScore"`
+	SpamSignals     *string        `gorm:"type:jsonb" json:"spamSignals"`
+	Company         string         `gorm:"type:varchar(255)" json:"company"`
+	Position        string         `gorm:"type:varchar(255)" json:"position"`
+	CreatedBy       string         `gorm:"type:varchar(255)" json:"createdBy"`
+	UpdatedBy       string         `gorm:"type:varchar(255)" json:"updatedBy"`
+	Version         int            `gorm:"not null;default:1" json:"version"`
+	CreatedAt       time.Time      `gorm:"type:timestamptz;autoCreateTime" json:"createdAt"`
+	UpdatedAt       time.Time      `gorm:"type:timestamptz;autoUpdateTime" json:"updatedAt"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deletedAt"`
 }
 
 func (*TestimonialModel) TableName() string {
 	return "testimonials"
 }
 
+// CursorSortFields поля, по которым разрешена keyset-пагинация; значение поля попадает в курсор открытым текстом
+func (*TestimonialModel) CursorSortFields() []string {
+	return []string{"createdAt", "updatedAt", "rating", "author"}
+}
+
 func (m *TestimonialModel) ToEntity() *entities.Testimonial {
 	return &entities.Testimonial{
-		ID:          m.ID,
-		Content:     m.Content,
-		Author:      m.Author,
-		AuthorEmail: m.AuthorEmail,
-		Rating:      m.Rating,
-		FilePath:    m.FilePath,
-		FileName:    m.FileName,
-		FileType:    m.FileType,
-		FileSize:    m.FileSize,
-		IsApproved:  m.IsApproved,
-		IsActive:    m.IsActive,
-		ApprovedAt:  m.ApprovedAt,
-		ApprovedBy:  m.ApprovedBy,
-		Company:     m.Company,
-		Position:    m.Position,
-		CreatedAt:   m.CreatedAt,
-		UpdatedAt:   m.UpdatedAt,
+		ID:              m.ID,
+		Content:         m.Content,
+		Author:          m.Author,
+		AuthorEmail:     m.AuthorEmail,
+		EmailVerifiedAt: m.EmailVerifiedAt,
+		Rating:          m.Rating,
+		FilePath:        m.FilePath,
+		FileName:        m.FileName,
+		FileType:        m.FileType,
+		FileSize:        m.FileSize,
+		Status:          entities.TestimonialStatus(m.Status),
+		StatusChangedAt: m.StatusChangedAt,
+		IsApproved:      m.IsApproved,
+		IsActive:        m.IsActive,
+		ApprovedAt:      m.ApprovedAt,
+		ApprovedBy:      m.ApprovedBy,
+		ReviewedBy:      m.ReviewedBy,
+		RejectionReason: entities.RejectionReason(m.RejectionReason),
+		ModeratorNotes:  m.ModeratorNotes,
+		SpamScore:       m.SpamScore,
+		SpamSignals:     unmarshalStrings(m.SpamSignals),
+		Company:         m.Company,
+		Position:        m.Position,
+		CreatedBy:       m.CreatedBy,
+		UpdatedBy:       m.UpdatedBy,
+		Version:         m.Version,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+		DeletedAt:       deletedAtToTime(m.DeletedAt),
 	}
 }
 
 func NewTestimonialModelFromEntity(entity *entities.Testimonial) *TestimonialModel {
 	return &TestimonialModel{
-		ID:          entity.ID,
-		Content:     entity.Content,
-		Author:      entity.Author,
-		AuthorEmail: entity.AuthorEmail,
-		Rating:      entity.Rating,
-		FilePath:    entity.FilePath,
-		FileName:    entity.FileName,
-		FileType:    entity.FileType,
-		FileSize:    entity.FileSize,
-		IsApproved:  entity.IsApproved,
-		IsActive:    entity.IsActive,
-		ApprovedAt:  entity.ApprovedAt,
-		ApprovedBy:  entity.ApprovedBy,
-		Company:     entity.Company,
-		Position:    entity.Position,
-		CreatedAt:   entity.CreatedAt,
-		UpdatedAt:   entity.UpdatedAt,
+		ID:              entity.ID,
+		Content:         entity.Content,
+		Author:          entity.Author,
+		AuthorEmail:     entity.AuthorEmail,
+		EmailVerifiedAt: entity.EmailVerifiedAt,
+		Rating:          entity.Rating,
+		FilePath:        entity.FilePath,
+		FileName:        entity.FileName,
+		FileType:        entity.FileType,
+		FileSize:        entity.FileSize,
+		Status:          string(entity.CurrentStatus()),
+		StatusChangedAt: entity.StatusChangedAt,
+		IsApproved:      entity.IsApproved,
+		IsActive:        entity.IsActive,
+		ApprovedAt:      entity.ApprovedAt,
+		ApprovedBy:      entity.ApprovedBy,
+		ReviewedBy:      entity.ReviewedBy,
+		RejectionReason: string(entity.RejectionReason),
+		ModeratorNotes:  entity.ModeratorNotes,
+		SpamScore:       entity.SpamScore,
+		SpamSignals:     marshalStrings(entity.SpamSignals),
+		Company:         entity.Company,
+		Position:        entity.Position,
+		CreatedBy:       entity.CreatedBy,
+		UpdatedBy:       entity.UpdatedBy,
+		Version:         entity.Version,
+		CreatedAt:       entity.CreatedAt,
+		UpdatedAt:       entity.UpdatedAt,
+		DeletedAt:       timeToDeletedAt(entity.DeletedAt),
+	}
+}
+
+// marshalStrings сохраняет список строк в колонку jsonb; пустой список - NULL
+func marshalStrings(values []string) *string {
+	if len(values) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil
+	}
+	value := string(data)
+	return &value
+}
+
+// unmarshalStrings читает список строк из колонки jsonb
+func unmarshalStrings(data *string) []string {
+	if data == nil || *data == "" {
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(*data), &values); err != nil {
+		return nil
 	}
+	return values
 }
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	sharedModels "tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"tax-priority-api/src/infrastructure/persistence/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type APIKeyRepositoryImpl struct {
+	repositories.GenericRepository[*entities.APIKey, string]
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB, generic repositories.GenericRepository[*entities.APIKey, string]) repositories.APIKeyRepository {
+	return &APIKeyRepositoryImpl{GenericRepository: generic, db: db}
+}
+
+func (r *APIKeyRepositoryImpl) FindByPrefix(ctx context.Context, prefix string) (*entities.APIKey, error) {
+	key, err := r.GenericRepository.FindOne(ctx, sharedModels.NewQueryOptionsWithFilters(map[string]any{
+		"prefix": prefix,
+	}))
+	var repoErr *persistence.RepositoryError
+	if errors.As(err, &repoErr) && repoErr.Code == persistence.ErrCodeNotFound {
+		return nil, nil
+	}
+	return key, err
+}
+
+func (r *APIKeyRepositoryImpl) TouchLastUsed(ctx context.Context, id string, usedAt, staleBefore time.Time) error {
+	// UpdateColumn не меняет updated_at: использование ключа - не изменение его настроек
+	err := persistence.Conn(ctx, r.db).
+		Model(new(models.APIKeyModel)).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, staleBefore).
+		UpdateColumn("last_used_at", usedAt).Error
+	if err != nil {
+		return persistence.NewInternalError("failed to update API key last used time", err)
+	}
+	return nil
+}
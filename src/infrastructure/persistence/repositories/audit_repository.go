@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"context"
+	sharedModels "tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// AuditRepositoryImpl журнал аудита поверх обобщенного репозитория; изменение и удаление записей
+// не доступны через интерфейс и запрещены триггером таблицы audit_log
+type AuditRepositoryImpl struct {
+	generic repositories.GenericRepository[*entities.AuditRecord, string]
+}
+
+func NewAuditRepository(generic repositories.GenericRepository[*entities.AuditRecord, string]) repositories.AuditRepository {
+	return &AuditRepositoryImpl{generic: generic}
+}
+
+func (r *AuditRepositoryImpl) Append(ctx context.Context, record *entities.AuditRecord) error {
+	if record.ID == "" {
+		record.SetID(uuid.New().String())
+	}
+	return r.generic.Create(ctx, record)
+}
+
+func (r *AuditRepositoryImpl) FindWithPagination(ctx context.Context, opts *sharedModels.QueryOptions) (*sharedModels.PaginatedResult[*entities.AuditRecord], error) {
+	return r.generic.FindWithPagination(ctx, opts)
+}
+
+func (r *AuditRepositoryImpl) FindAll(ctx context.Context, opts *sharedModels.QueryOptions) ([]*entities.AuditRecord, error) {
+	return r.generic.FindAll(ctx, opts)
+}
@@ -30,7 +30,7 @@ func NewCachedFAQRepository(
 	return &CachedFAQRepositoryImpl{
 		GenericRepository: NewCachedGenericRepository(baseRepo, cacheManager, keyGen, config),
 		faqRepo:           faqRepo,
-		cacheManager:      cacheManager,
+		cacheManager:      newTransactionalCacheManager(cacheManager),
 		keyGen:            keyGen,
 		config:            config,
 	}
@@ -61,6 +61,18 @@ func (r *CachedFAQRepositoryImpl) GetCategories(ctx context.Context, withCounts
 	return result.Categories, result.CategoryCounts, nil
 }
 
+// Search выполняет полнотекстовый поиск FAQ с кешированием результатов
+func (r *CachedFAQRepositoryImpl) Search(ctx context.Context, text string, opts *models.QueryOptions) (*models.PaginatedResult[models.SearchHit[*entities.FAQ]], error) {
+	cacheKey := r.keyGen.GenerateQueryKey("search", struct {
+		Text string               `json:"text"`
+		Opts *models.QueryOptions `json:"opts"`
+	}{text, opts})
+
+	return cache.GetTypedQuery(ctx, r.cacheManager, cacheKey, func() (*models.PaginatedResult[models.SearchHit[*entities.FAQ]], error) {
+		return r.faqRepo.Search(ctx, text, opts)
+	}, r.config.ShortTTL)
+}
+
 func (r *CachedFAQRepositoryImpl) invalidateCategoriesCache(ctx context.Context) error {
 	return r.cacheManager.InvalidatePattern(ctx, FAQCategoriesPattern)
 }
@@ -117,6 +129,16 @@ func (r *CachedFAQRepositoryImpl) SoftDelete(ctx context.Context, id string) err
 	return nil
 }
 
+func (r *CachedFAQRepositoryImpl) Restore(ctx context.Context, id string) error {
+	err := r.GenericRepository.Restore(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_ = r.invalidateCategoriesCache(ctx)
+	return nil
+}
+
 func (r *CachedFAQRepositoryImpl) CreateBatch(ctx context.Context, entities []*entities.FAQ) (*models.BulkOperationResult, error) {
 	result, err := r.GenericRepository.CreateBatch(ctx, entities)
 	if err != nil {
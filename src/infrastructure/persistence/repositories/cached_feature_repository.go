@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	appCache "tax-priority-api/src/application/cache"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
@@ -9,24 +10,35 @@ import (
 
 type CachedFeatureRepositoryImpl struct {
 	repositories.GenericRepository[*entities.Feature, string]
-	faqRepo      repositories.FAQRepository
+	featureRepo  repositories.FeatureRepository
 	cacheManager cache.CacheManager[*entities.Feature, string]
 	keyGen       appCache.KeyGenerator[*entities.Feature, string]
 	config       *appCache.CacheConfig
 }
 
+// NewCachedFeatureRepository создает кешированный Feature репозиторий
 func NewCachedFeatureRepository(
 	baseRepo repositories.GenericRepository[*entities.Feature, string],
-	faqRepo repositories.FAQRepository,
+	featureRepo repositories.FeatureRepository,
 	cacheManager cache.CacheManager[*entities.Feature, string],
 	keyGen appCache.KeyGenerator[*entities.Feature, string],
 	config *appCache.CacheConfig,
 ) repositories.CachedFeatureRepository {
 	return &CachedFeatureRepositoryImpl{
 		GenericRepository: NewCachedGenericRepository(baseRepo, cacheManager, keyGen, config),
-		faqRepo:           faqRepo,
-		cacheManager:      cacheManager,
+		featureRepo:       featureRepo,
+		cacheManager:      newTransactionalCacheManager(cacheManager),
 		keyGen:            keyGen,
 		config:            config,
 	}
 }
+
+// Reorder меняет порядок Feature; позиции меняются у многих записей сразу, поэтому кеш сбрасывается целиком
+func (r *CachedFeatureRepositoryImpl) Reorder(ctx context.Context, ids []string) error {
+	if err := r.featureRepo.Reorder(ctx, ids); err != nil {
+		return err
+	}
+
+	_ = r.cacheManager.InvalidateAll(ctx)
+	return nil
+}
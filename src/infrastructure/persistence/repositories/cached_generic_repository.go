@@ -26,7 +26,7 @@ func NewCachedGenericRepository[T entities.Entity[ID], ID comparable](
 
 	return &CachedGenericRepositoryImpl[T, ID]{
 		genericRepo:  genericRepo,
-		cacheManager: cacheManager,
+		cacheManager: newTransactionalCacheManager(cacheManager),
 		keyGen:       keyGen,
 		config:       config,
 	}
@@ -161,10 +161,41 @@ func (r *CachedGenericRepositoryImpl[T, ID]) SoftDelete(ctx context.Context, id
 	if !isZero(entity) {
 		_ = r.cacheManager.Invalidate(ctx, entity)
 	}
+	_ = r.invalidateAggregatedQueries(ctx)
 
 	return nil
 }
 
+func (r *CachedGenericRepositoryImpl[T, ID]) Restore(ctx context.Context, id ID) error {
+	err := r.genericRepo.Restore(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_ = r.cacheManager.InvalidateByID(ctx, id)
+	_ = r.invalidateAggregatedQueries(ctx)
+
+	return nil
+}
+
+func (r *CachedGenericRepositoryImpl[T, ID]) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	purged, err := r.genericRepo.Purge(ctx, deletedBefore)
+	if err != nil {
+		return purged, err
+	}
+
+	if purged > 0 {
+		_ = r.invalidateAggregatedQueries(ctx)
+	}
+
+	return purged, nil
+}
+
+// FindDeletedBefore не кешируется: выборка нужна только очистке корзины
+func (r *CachedGenericRepositoryImpl[T, ID]) FindDeletedBefore(ctx context.Context, deletedBefore time.Time) ([]T, error) {
+	return r.genericRepo.FindDeletedBefore(ctx, deletedBefore)
+}
+
 func (r *CachedGenericRepositoryImpl[T, ID]) FindAll(ctx context.Context, opts *models.QueryOptions) ([]T, error) {
 	cacheKey := r.keyGen.GenerateQueryKey("all", opts)
 	ttl := r.determineTTL(opts)
@@ -184,8 +215,9 @@ func (r *CachedGenericRepositoryImpl[T, ID]) FindAll(ctx context.Context, opts *
 	}
 
 	// Кешируем отдельные сущности асинхронно
+	// WithoutCancel сохраняет признак транзакции: незафиксированные данные в кеш не попадают
 	go func() {
-		bgCtx := context.Background()
+		bgCtx := context.WithoutCancel(ctx)
 		for _, entity := range foundEntities {
 			_ = r.cacheManager.Set(bgCtx, entity, r.config.DefaultTTL)
 		}
@@ -230,8 +262,9 @@ func (r *CachedGenericRepositoryImpl[T, ID]) FindWithPagination(ctx context.Cont
 	}
 
 	// Кешируем отдельные сущности асинхронно
+	// WithoutCancel сохраняет признак транзакции: незафиксированные данные в кеш не попадают
 	go func() {
-		bgCtx := context.Background()
+		bgCtx := context.WithoutCancel(ctx)
 		for _, entity := range result.Items {
 			_ = r.cacheManager.Set(bgCtx, entity, r.config.DefaultTTL)
 		}
@@ -241,6 +274,22 @@ func (r *CachedGenericRepositoryImpl[T, ID]) FindWithPagination(ctx context.Cont
 
 }
 
+func (r *CachedGenericRepositoryImpl[T, ID]) FindWithCursor(ctx context.Context, opts *models.QueryOptions) (*models.CursorPaginatedResult[T], error) {
+	cacheKey := r.keyGen.GenerateQueryKey("cursor", opts)
+
+	return cache.GetTypedQuery(ctx, r.cacheManager, cacheKey, func() (*models.CursorPaginatedResult[T], error) {
+		return r.genericRepo.FindWithCursor(ctx, opts)
+	}, r.config.ShortTTL)
+}
+
+func (r *CachedGenericRepositoryImpl[T, ID]) FindDeleted(ctx context.Context, opts *models.QueryOptions) (*models.PaginatedResult[T], error) {
+	cacheKey := r.keyGen.GenerateQueryKey("trash", opts)
+
+	return cache.GetTypedQuery(ctx, r.cacheManager, cacheKey, func() (*models.PaginatedResult[T], error) {
+		return r.genericRepo.FindDeleted(ctx, opts)
+	}, r.config.ShortTTL)
+}
+
 func (r *CachedGenericRepositoryImpl[T, ID]) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	cacheKey := r.keyGen.GenerateQueryKey("count", filters)
 
@@ -281,8 +330,8 @@ func (r *CachedGenericRepositoryImpl[T, ID]) WithTransaction(ctx context.Context
 			return err
 		}
 
-		// После транзакции инвалидируем агрегированные запросы
-		_ = r.invalidateAggregatedQueries(ctx)
+		// Инвалидация агрегированных запросов отложена до фиксации транзакции
+		_ = r.invalidateAggregatedQueries(txCtx)
 
 		return nil
 	})
@@ -320,6 +369,9 @@ func (r *CachedGenericRepositoryImpl[T, ID]) invalidateAggregatedQueries(ctx con
 		fmt.Sprintf("%s:count:*", prefix),
 		fmt.Sprintf("%s:paginated:*", prefix),
 		fmt.Sprintf("%s:one:*", prefix),
+		fmt.Sprintf("%s:search:*", prefix),
+		fmt.Sprintf("%s:cursor:*", prefix),
+		fmt.Sprintf("%s:trash:*", prefix),
 	}
 
 	for _, pattern := range patterns {
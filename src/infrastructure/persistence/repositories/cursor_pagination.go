@@ -0,0 +1,218 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	sharedModels "tax-priority-api/src/application/models"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"time"
+
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+const (
+	cursorDirectionNext = "next"
+	cursorDirectionPrev = "prev"
+)
+
+// cursorPayload содержимое курсора: ключ сортировки и ID граничной записи
+type cursorPayload struct {
+	Field     string `json:"f"`
+	Desc      bool   `json:"o"`
+	Value     string `json:"v"`
+	ID        string `json:"i"`
+	Direction string `json:"d"`
+}
+
+// defaultCursorSortFields поля сортировки курсора для моделей без собственного списка
+var defaultCursorSortFields = []string{"createdAt"}
+
+// cursorSortable модель, объявляющая поля сортировки для курсора. Курсор подписан, но не зашифрован,
+// поэтому в списке только поля, которые видны любому клиенту списка.
+type cursorSortable interface {
+	CursorSortFields() []string
+}
+
+// cursorSortField проверяет поле сортировки курсора по белому списку модели
+func cursorSortField(model any, field string) bool {
+	fields := defaultCursorSortFields
+	if sortable, ok := model.(cursorSortable); ok {
+		fields = sortable.CursorSortFields()
+	}
+	for _, allowed := range fields {
+		if allowed == field {
+			return true
+		}
+	}
+	return false
+}
+
+// FindWithCursor выполняет keyset-пагинацию по (поле сортировки, id).
+// Сортировка берется из первого элемента opts.SortBy, по умолчанию createdAt desc;
+// поле должно входить в CursorSortFields модели.
+func (r *GenericRepositoryImpl[T, M, ID]) FindWithCursor(ctx context.Context, opts *sharedModels.QueryOptions) (*sharedModels.CursorPaginatedResult[T], error) {
+	if opts == nil || opts.Cursor == nil || opts.Cursor.Limit <= 0 {
+		return nil, persistence.NewInvalidInputError("cursor options with positive limit are required", nil)
+	}
+	if r.cursors == nil {
+		return nil, persistence.NewInternalError("cursor codec is not configured", nil)
+	}
+
+	columns, err := filterColumns(r.db, new(M))
+	if err != nil {
+		return nil, err
+	}
+
+	sortField, desc := "createdAt", true
+	if len(opts.SortBy) > 0 {
+		sortField = opts.SortBy[0].Field
+		desc = opts.SortBy[0].Order.ToUpper() == sharedModels.DESC
+	}
+
+	if !cursorSortField(new(M), sortField) {
+		return nil, persistence.NewInvalidInputError(fmt.Sprintf("sort field %q is not supported with cursor pagination", sortField), nil)
+	}
+	sortColumn, ok := columns[sortField]
+	if !ok {
+		return nil, persistence.NewInvalidInputError(fmt.Sprintf("unknown sort field %q", sortField), nil)
+	}
+	idColumn, ok := columns["id"]
+	if !ok {
+		return nil, persistence.NewInternalError("model has no id column", nil)
+	}
+
+	query, err := applyFilters(persistence.Conn(ctx, r.db), new(M), opts.Filters, opts.Where)
+	if err != nil {
+		return nil, err
+	}
+
+	direction := cursorDirectionNext
+	if opts.Cursor.Cursor != "" {
+		var payload cursorPayload
+		if err := r.cursors.Decode(opts.Cursor.Cursor, &payload); err != nil {
+			return nil, persistence.NewInvalidInputError("invalid cursor", err)
+		}
+		if payload.Field != sortColumn.DBName || payload.Desc != desc {
+			return nil, persistence.NewInvalidInputError("cursor does not match requested sort order", nil)
+		}
+
+		sortValue, err := coerceFilterValue(sortColumn, payload.Value)
+		if err != nil {
+			return nil, persistence.NewInvalidInputError("invalid cursor", err)
+		}
+		idValue, err := coerceFilterValue(idColumn, payload.ID)
+		if err != nil {
+			return nil, persistence.NewInvalidInputError("invalid cursor", err)
+		}
+
+		direction = payload.Direction
+		// Для следующей страницы идем дальше в порядке сортировки, для предыдущей - в обратную сторону
+		forward := direction == cursorDirectionNext
+		comparison := ">"
+		if desc == forward {
+			comparison = "<"
+		}
+		query = query.Where(
+			fmt.Sprintf("(%s, %s) %s (?, ?)", sortColumn.DBName, idColumn.DBName, comparison),
+			sortValue, idValue,
+		)
+	}
+
+	// Для предыдущей страницы читаем в обратном порядке, затем разворачиваем
+	reverse := direction == cursorDirectionPrev
+	orderDesc := desc != reverse
+	query = query.
+		Order(clause.OrderByColumn{Column: clause.Column{Name: sortColumn.DBName}, Desc: orderDesc}).
+		Order(clause.OrderByColumn{Column: clause.Column{Name: idColumn.DBName}, Desc: orderDesc}).
+		Limit(opts.Cursor.Limit + 1)
+	query = r.applyIncludes(query, opts.Includes)
+
+	var models []M
+	if err := query.Find(&models).Error; err != nil {
+		return nil, persistence.NewInternalError("failed to find entities with cursor", err)
+	}
+
+	hasMore := len(models) > opts.Cursor.Limit
+	if hasMore {
+		models = models[:opts.Cursor.Limit]
+	}
+	if reverse {
+		for i, j := 0, len(models)-1; i < j; i, j = i+1, j-1 {
+			models[i], models[j] = models[j], models[i]
+		}
+	}
+
+	result := &sharedModels.CursorPaginatedResult[T]{
+		Items: make([]T, len(models)),
+		Limit: opts.Cursor.Limit,
+	}
+	for i := range models {
+		result.Items[i] = r.modelToDomain(&models[i])
+	}
+
+	if reverse {
+		result.HasPrev = hasMore
+		result.HasNext = true
+	} else {
+		result.HasNext = hasMore
+		result.HasPrev = opts.Cursor.Cursor != ""
+	}
+
+	if len(models) == 0 {
+		return result, nil
+	}
+
+	if result.HasNext {
+		if result.NextCursor, err = r.encodeCursor(ctx, &models[len(models)-1], sortColumn, idColumn, desc, cursorDirectionNext); err != nil {
+			return nil, err
+		}
+	}
+	if result.HasPrev {
+		if result.PrevCursor, err = r.encodeCursor(ctx, &models[0], sortColumn, idColumn, desc, cursorDirectionPrev); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *GenericRepositoryImpl[T, M, ID]) encodeCursor(ctx context.Context, model *M, sortColumn, idColumn *schema.Field, desc bool, direction string) (string, error) {
+	modelValue := reflect.ValueOf(model)
+
+	sortValue, isZero := sortColumn.ValueOf(ctx, modelValue)
+	if isZero && sortColumn.FieldType.Kind() == reflect.Ptr {
+		return "", persistence.NewInvalidInputError(fmt.Sprintf("cursor pagination does not support NULL values in %q", sortColumn.Name), nil)
+	}
+	idValue, _ := idColumn.ValueOf(ctx, modelValue)
+
+	cursor, err := r.cursors.Encode(cursorPayload{
+		Field:     sortColumn.DBName,
+		Desc:      desc,
+		Value:     formatCursorValue(sortValue),
+		ID:        formatCursorValue(idValue),
+		Direction: direction,
+	})
+	if err != nil {
+		return "", persistence.NewInternalError("failed to encode cursor", err)
+	}
+	return cursor, nil
+}
+
+func formatCursorValue(value interface{}) string {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return ""
+	}
+
+	switch v := rv.Interface().(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"testing"
+
+	"tax-priority-api/src/infrastructure/persistence/models"
+)
+
+func TestCursorSortFieldAllowsOnlyPublicColumns(t *testing.T) {
+	tests := []struct {
+		name  string
+		model any
+		field string
+		want  bool
+	}{
+		{name: "testimonial created at", model: new(models.TestimonialModel), field: "createdAt", want: true},
+		{name: "testimonial rating", model: new(models.TestimonialModel), field: "rating", want: true},
+		{name: "testimonial moderator notes", model: new(models.TestimonialModel), field: "moderatorNotes", want: false},
+		{name: "testimonial spam signals", model: new(models.TestimonialModel), field: "spamSignals", want: false},
+		{name: "testimonial reviewer", model: new(models.TestimonialModel), field: "reviewedBy", want: false},
+		{name: "testimonial author email", model: new(models.TestimonialModel), field: "authorEmail", want: false},
+		{name: "faq priority", model: new(models.FAQModel), field: "priority", want: true},
+		{name: "faq editor", model: new(models.FAQModel), field: "updatedBy", want: false},
+		{name: "model without list", model: new(models.AuditRecordModel), field: "createdAt", want: true},
+		{name: "model without list other field", model: new(models.AuditRecordModel), field: "actor", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cursorSortField(tt.model, tt.field); got != tt.want {
+				t.Fatalf("cursorSortField(%q) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,14 +5,100 @@ import (
 	sharedModels "tax-priority-api/src/application/models"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"tax-priority-api/src/infrastructure/persistence/models"
+
+	"gorm.io/gorm"
 )
 
+// faqSearchQueryJoin строит tsquery из пользовательского ввода сразу для двух языков
+const faqSearchQueryJoin = "CROSS JOIN (SELECT websearch_to_tsquery('russian', ?) || websearch_to_tsquery('english', ?) AS query) AS q"
+
+// faqHeadlineOptions параметры подсветки совпадений
+const faqHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10"
+
 type FAQRepositoryImpl struct {
 	repositories.GenericRepository[*entities.FAQ, string]
+	db *gorm.DB
+}
+
+func NewFAQRepository(db *gorm.DB, generic repositories.GenericRepository[*entities.FAQ, string]) repositories.FAQRepository {
+	return &FAQRepositoryImpl{GenericRepository: generic, db: db}
+}
+
+// faqSearchRow строка результата поиска: модель и вычисленные поля
+type faqSearchRow struct {
+	models.FAQModel
+	SearchRank        float64
+	QuestionHighlight string
+	AnswerHighlight   string
+}
+
+func (r *FAQRepositoryImpl) Search(ctx context.Context, text string, opts *sharedModels.QueryOptions) (*sharedModels.PaginatedResult[sharedModels.SearchHit[*entities.FAQ]], error) {
+	if opts == nil || opts.Pagination == nil {
+		return nil, persistence.NewInvalidInputError("pagination options are required", nil)
+	}
+
+	var total int64
+	countQuery, err := applyFilters(r.searchScope(ctx, text), new(models.FAQModel), opts.Filters, opts.Where)
+	if err != nil {
+		return nil, err
+	}
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, persistence.NewInternalError("failed to count search results", err)
+	}
+
+	var rows []faqSearchRow
+	query := r.searchScope(ctx, text).
+		Select(
+			"faqs.*, ts_rank_cd(faqs.search_vector, q.query) AS search_rank, "+
+				"ts_headline('russian', faqs.question, q.query, ?) AS question_highlight, "+
+				"ts_headline('russian', faqs.answer, q.query, ?) AS answer_highlight",
+			faqHeadlineOptions, faqHeadlineOptions,
+		)
+	query, err = applyFilters(query, new(models.FAQModel), opts.Filters, opts.Where)
+	if err != nil {
+		return nil, err
+	}
+	query = query.Order("search_rank DESC")
+	query = applySorting(query, opts.SortBy)
+	query = query.Offset(opts.Pagination.Offset).Limit(opts.Pagination.Limit)
+
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, persistence.NewInternalError("failed to search faqs", err)
+	}
+
+	hits := make([]sharedModels.SearchHit[*entities.FAQ], len(rows))
+	for i := range rows {
+		hits[i] = sharedModels.SearchHit[*entities.FAQ]{
+			Item: rows[i].FAQModel.ToEntity(),
+			Rank: rows[i].SearchRank,
+			Highlights: map[string]string{
+				"question": rows[i].QuestionHighlight,
+				"answer":   rows[i].AnswerHighlight,
+			},
+		}
+	}
+
+	totalPages := int((total + int64(opts.Pagination.Limit) - 1) / int64(opts.Pagination.Limit))
+
+	return &sharedModels.PaginatedResult[sharedModels.SearchHit[*entities.FAQ]]{
+		Items:      hits,
+		Total:      total,
+		Offset:     opts.Pagination.Offset,
+		Limit:      opts.Pagination.Limit,
+		HasNext:    opts.Pagination.Offset+opts.Pagination.Limit < int(total),
+		HasPrev:    opts.Pagination.Offset > 0,
+		TotalPages: totalPages,
+	}, nil
 }
 
-func NewFAQRepository(generic repositories.GenericRepository[*entities.FAQ, string]) repositories.FAQRepository {
-	return &FAQRepositoryImpl{generic}
+// searchScope базовый запрос поиска: совпадение по tsvector без удаленных записей
+func (r *FAQRepositoryImpl) searchScope(ctx context.Context, text string) *gorm.DB {
+	return persistence.Conn(ctx, r.db).
+		Model(new(models.FAQModel)).
+		Joins(faqSearchQueryJoin, text, text).
+		Where("faqs.search_vector @@ q.query")
 }
 
 func (r *FAQRepositoryImpl) GetCategories(ctx context.Context, withCounts bool) ([]string, map[string]int64, error) {
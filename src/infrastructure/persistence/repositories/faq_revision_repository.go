@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	sharedModels "tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"tax-priority-api/src/infrastructure/persistence/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FAQRevisionRepositoryImpl struct {
+	repositories.GenericRepository[*entities.FAQRevision, string]
+	db *gorm.DB
+}
+
+func NewFAQRevisionRepository(db *gorm.DB, generic repositories.GenericRepository[*entities.FAQRevision, string]) repositories.FAQRevisionRepository {
+	return &FAQRevisionRepositoryImpl{GenericRepository: generic, db: db}
+}
+
+func (r *FAQRevisionRepositoryImpl) Append(ctx context.Context, revision *entities.FAQRevision) error {
+	if revision.ID == "" {
+		revision.SetID(uuid.New().String())
+	}
+
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// FOR UPDATE на строке FAQ выстраивает параллельные записи ревизий в очередь:
+		// следующая транзакция читает MAX(revision) только после фиксации предыдущей.
+		// Unscoped: ревизия пишется и при перемещении FAQ в корзину.
+		var locked []string
+		err := tx.Unscoped().
+			Model(new(models.FAQModel)).
+			Where("id = ?", revision.FAQID).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Pluck("id", &locked).Error
+		if err != nil {
+			return persistence.NewInternalError("failed to lock FAQ for revision", err)
+		}
+
+		var last int
+		err = tx.Model(new(models.FAQRevisionModel)).
+			Where("faq_id = ?", revision.FAQID).
+			Select("COALESCE(MAX(revision), 0)").
+			Scan(&last).Error
+		if err != nil {
+			return persistence.NewInternalError("failed to get last revision number", err)
+		}
+
+		revision.Revision = last + 1
+		if err := tx.Create(models.NewFAQRevisionModelFromEntity(revision)).Error; err != nil {
+			return persistence.NewInternalError("failed to append FAQ revision", err)
+		}
+		return nil
+	})
+}
+
+func (r *FAQRevisionRepositoryImpl) FindByFAQ(ctx context.Context, faqID string, opts *sharedModels.QueryOptions) (*sharedModels.PaginatedResult[*entities.FAQRevision], error) {
+	if opts == nil {
+		opts = &sharedModels.QueryOptions{}
+	}
+	if opts.Filters == nil {
+		opts.Filters = make(map[string]interface{})
+	}
+	opts.Filters["faq_id"] = faqID
+	if len(opts.SortBy) == 0 {
+		opts.SortBy = []sharedModels.SortBy{{Field: "revision", Order: sharedModels.DESC}}
+	}
+
+	return r.GenericRepository.FindWithPagination(ctx, opts)
+}
+
+func (r *FAQRevisionRepositoryImpl) FindByNumber(ctx context.Context, faqID string, revision int) (*entities.FAQRevision, error) {
+	return r.GenericRepository.FindOne(ctx, sharedModels.NewQueryOptionsWithFilters(map[string]any{
+		"faq_id":   faqID,
+		"revision": revision,
+	}))
+}
@@ -1,14 +1,87 @@
 package repositories
 
 import (
+	"context"
+	"fmt"
+	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"tax-priority-api/src/infrastructure/persistence/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
-import "tax-priority-api/src/application/repositories"
 
 type FeatureRepositoryImpl struct {
 	repositories.GenericRepository[*entities.Feature, string]
+	db *gorm.DB
+}
+
+func NewFeatureRepository(db *gorm.DB, generic repositories.GenericRepository[*entities.Feature, string]) repositories.FeatureRepository {
+	return &FeatureRepositoryImpl{GenericRepository: generic, db: db}
 }
 
-func NewFeatureRepository(generic repositories.GenericRepository[*entities.Feature, string]) repositories.FeatureRepository {
-	return &FeatureRepositoryImpl{generic}
+// featureOrderRow текущая позиция Feature
+type featureOrderRow struct {
+	ID        string
+	SortOrder int
+}
+
+func (r *FeatureRepositoryImpl) Reorder(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return persistence.NewInvalidInputError("ids cannot be empty", nil)
+	}
+
+	listed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if listed[id] {
+			return persistence.NewInvalidInputError(fmt.Sprintf("duplicate id %s", id), nil)
+		}
+		listed[id] = true
+	}
+
+	return persistence.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var rows []featureOrderRow
+		// FOR UPDATE не дает параллельной перестановке перемешать позиции
+		err := tx.Model(new(models.FeatureModel)).
+			Select("id", "sort_order").
+			Order("sort_order ASC").
+			Order("created_at ASC").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Find(&rows).Error
+		if err != nil {
+			return persistence.NewInternalError("failed to load features order", err)
+		}
+
+		current := make(map[string]int, len(rows))
+		for _, row := range rows {
+			current[row.ID] = row.SortOrder
+		}
+		for _, id := range ids {
+			if _, ok := current[id]; !ok {
+				return persistence.NewNotFoundError(fmt.Sprintf("feature with id %s not found", id), nil)
+			}
+		}
+
+		order := append([]string{}, ids...)
+		for _, row := range rows {
+			if !listed[row.ID] {
+				order = append(order, row.ID)
+			}
+		}
+
+		for position, id := range order {
+			if current[id] == position {
+				continue
+			}
+			err := tx.Model(new(models.FeatureModel)).
+				Where("id = ?", id).
+				Update("sort_order", position).Error
+			if err != nil {
+				return persistence.NewInternalError("failed to update feature order", err)
+			}
+		}
+
+		return nil
+	})
 }
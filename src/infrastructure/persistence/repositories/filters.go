@@ -0,0 +1,298 @@
+package repositories
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	sharedModels "tax-priority-api/src/application/models"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// filterColumnsCache кеш колонок модели по типу модели
+var filterColumnsCache sync.Map
+
+// filterColumns сопоставляет имена полей (json/camelCase) и имена колонок с колонками модели.
+// Это не проверка доступа: поля из запроса клиента ограничивает белый список обработчика списка.
+func filterColumns(db *gorm.DB, model any) (map[string]*schema.Field, error) {
+	modelType := reflect.TypeOf(model)
+	if cached, ok := filterColumnsCache.Load(modelType); ok {
+		return cached.(map[string]*schema.Field), nil
+	}
+
+	parsed, err := schema.Parse(model, &sync.Map{}, db.NamingStrategy)
+	if err != nil {
+		return nil, persistence.NewInternalError("failed to parse model schema", err)
+	}
+
+	columns := make(map[string]*schema.Field)
+	for _, field := range parsed.Fields {
+		// Недоступные для чтения и служебные колонки не фильтруются
+		if field.DBName == "" || !field.Readable || field.DBName == "deleted_at" {
+			continue
+		}
+		columns[field.DBName] = field
+		columns[fieldFilterName(field)] = field
+	}
+
+	filterColumnsCache.Store(modelType, columns)
+	return columns, nil
+}
+
+func fieldFilterName(field *schema.Field) string {
+	if tag := field.Tag.Get("json"); tag != "" && tag != "-" {
+		if name := strings.Split(tag, ",")[0]; name != "" {
+			return name
+		}
+	}
+
+	if strings.ToUpper(field.Name) == field.Name {
+		return strings.ToLower(field.Name)
+	}
+	return strings.ToLower(field.Name[:1]) + field.Name[1:]
+}
+
+// applyFilters применяет к запросу простые фильтры (равенство) и группу условий where.
+// Поля должны быть колонками модели, значения приводятся к типу колонки.
+func applyFilters(query *gorm.DB, model any, filters map[string]interface{}, where *sharedModels.FilterGroup) (*gorm.DB, error) {
+	if len(filters) == 0 && where.IsEmpty() {
+		return query, nil
+	}
+
+	columns, err := filterColumns(query, model)
+	if err != nil {
+		return nil, err
+	}
+
+	root := sharedModels.NewFilterGroup(sharedModels.FilterAnd)
+
+	// Сортируем ключи, чтобы SQL был детерминированным
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := filters[key]; value != nil {
+			root.Add(key, sharedModels.FilterEq, value)
+		}
+	}
+
+	if !where.IsEmpty() {
+		root.AddGroup(where)
+	}
+
+	sql, args, err := buildFilterGroup(columns, root)
+	if err != nil {
+		return nil, err
+	}
+	if sql == "" {
+		return query, nil
+	}
+
+	return query.Where(sql, args...), nil
+}
+
+func buildFilterGroup(columns map[string]*schema.Field, group *sharedModels.FilterGroup) (string, []interface{}, error) {
+	separator := " AND "
+	switch group.Logic {
+	case sharedModels.FilterAnd, "":
+	case sharedModels.FilterOr:
+		separator = " OR "
+	default:
+		return "", nil, persistence.NewInvalidInputError(fmt.Sprintf("unsupported filter logic %q", group.Logic), nil)
+	}
+
+	var parts []string
+	var args []interface{}
+
+	for _, condition := range group.Conditions {
+		sql, conditionArgs, err := buildFilterCondition(columns, condition)
+		if err != nil {
+			return "", nil, err
+		}
+		parts = append(parts, sql)
+		args = append(args, conditionArgs...)
+	}
+
+	for _, nested := range group.Groups {
+		if nested.IsEmpty() {
+			continue
+		}
+		sql, nestedArgs, err := buildFilterGroup(columns, nested)
+		if err != nil {
+			return "", nil, err
+		}
+		parts = append(parts, sql)
+		args = append(args, nestedArgs...)
+	}
+
+	if len(parts) == 0 {
+		return "", nil, nil
+	}
+
+	return "(" + strings.Join(parts, separator) + ")", args, nil
+}
+
+func buildFilterCondition(columns map[string]*schema.Field, condition sharedModels.FilterCondition) (string, []interface{}, error) {
+	field, ok := columns[condition.Field]
+	if !ok {
+		return "", nil, persistence.NewInvalidInputError(fmt.Sprintf("unknown filter field %q", condition.Field), nil)
+	}
+	column := field.DBName
+
+	switch condition.Operator {
+	case sharedModels.FilterEq, sharedModels.FilterNe, sharedModels.FilterGt,
+		sharedModels.FilterGte, sharedModels.FilterLt, sharedModels.FilterLte:
+		value, err := coerceFilterValue(field, condition.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		return column + " " + comparisonOperators[condition.Operator] + " ?", []interface{}{value}, nil
+
+	case sharedModels.FilterIn, sharedModels.FilterNotIn:
+		values, err := coerceFilterValues(field, condition.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(values) == 0 {
+			return "", nil, persistence.NewInvalidInputError(fmt.Sprintf("filter %q requires at least one value", condition.Field), nil)
+		}
+		if condition.Operator == sharedModels.FilterNotIn {
+			return column + " NOT IN ?", []interface{}{values}, nil
+		}
+		return column + " IN ?", []interface{}{values}, nil
+
+	case sharedModels.FilterLike, sharedModels.FilterILike:
+		if indirectType(field.FieldType).Kind() != reflect.String {
+			return "", nil, persistence.NewInvalidInputError(fmt.Sprintf("filter %q does not support %s", condition.Field, condition.Operator), nil)
+		}
+		pattern := fmt.Sprint(condition.Value)
+		// Без явных шаблонов ищем вхождение подстроки
+		if !strings.Contains(pattern, "%") {
+			pattern = "%" + pattern + "%"
+		}
+		return column + " " + strings.ToUpper(string(condition.Operator)) + " ?", []interface{}{pattern}, nil
+
+	case sharedModels.FilterBetween:
+		values, err := coerceFilterValues(field, condition.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(values) != 2 {
+			return "", nil, persistence.NewInvalidInputError(fmt.Sprintf("filter %q between requires exactly two values", condition.Field), nil)
+		}
+		return column + " BETWEEN ? AND ?", values, nil
+
+	case sharedModels.FilterIsNull:
+		isNull, err := parseBoolValue(condition.Value)
+		if err != nil {
+			return "", nil, persistence.NewInvalidInputError(fmt.Sprintf("filter %q null requires true or false", condition.Field), err)
+		}
+		if isNull {
+			return column + " IS NULL", nil, nil
+		}
+		return column + " IS NOT NULL", nil, nil
+	}
+
+	return "", nil, persistence.NewInvalidInputError(fmt.Sprintf("unsupported filter operator %q", condition.Operator), nil)
+}
+
+var comparisonOperators = map[sharedModels.FilterOperator]string{
+	sharedModels.FilterEq:  "=",
+	sharedModels.FilterNe:  "<>",
+	sharedModels.FilterGt:  ">",
+	sharedModels.FilterGte: ">=",
+	sharedModels.FilterLt:  "<",
+	sharedModels.FilterLte: "<=",
+}
+
+// coerceFilterValues приводит срез (или одно значение) к типу колонки
+func coerceFilterValues(field *schema.Field, value interface{}) ([]interface{}, error) {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		return nil, nil
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		coerced, err := coerceFilterValue(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{coerced}, nil
+	}
+
+	values := make([]interface{}, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		coerced, err := coerceFilterValue(field, rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		values[i] = coerced
+	}
+	return values, nil
+}
+
+// coerceFilterValue приводит строковое значение из запроса к типу колонки; прочие значения не меняются
+func coerceFilterValue(field *schema.Field, value interface{}) (interface{}, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	var (
+		result interface{}
+		err    error
+	)
+
+	fieldType := indirectType(field.FieldType)
+	switch {
+	case fieldType == reflect.TypeOf(time.Time{}):
+		result, err = parseTimeValue(str)
+	case fieldType.Kind() == reflect.Bool:
+		result, err = strconv.ParseBool(str)
+	case fieldType.Kind() >= reflect.Int && fieldType.Kind() <= reflect.Int64:
+		result, err = strconv.ParseInt(str, 10, 64)
+	case fieldType.Kind() >= reflect.Uint && fieldType.Kind() <= reflect.Uint64:
+		result, err = strconv.ParseUint(str, 10, 64)
+	case fieldType.Kind() == reflect.Float32 || fieldType.Kind() == reflect.Float64:
+		result, err = strconv.ParseFloat(str, 64)
+	default:
+		result = str
+	}
+
+	if err != nil {
+		return nil, persistence.NewInvalidInputError(fmt.Sprintf("invalid value %q for filter field %q", str, field.Name), err)
+	}
+	return result, nil
+}
+
+func parseTimeValue(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+func parseBoolValue(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(v)
+	}
+	return false, fmt.Errorf("unexpected value type %T", value)
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -18,30 +18,36 @@ import (
 
 type GenericRepositoryImpl[T entities.Entity[ID], M any, ID comparable] struct {
 	db            *gorm.DB
+	cursors       *persistence.CursorCodec
 	domainToModel func(T) *M
 	modelToDomain func(*M) T
 }
 
 func NewGenericRepository[T entities.Entity[ID], M any, ID comparable](
 	db *gorm.DB,
+	cursors *persistence.CursorCodec,
 	domainToModel func(T) *M,
 	modelToDomain func(*M) T,
 ) repositories.GenericRepository[T, ID] {
 	return &GenericRepositoryImpl[T, M, ID]{
 		db:            db,
+		cursors:       cursors,
 		domainToModel: domainToModel,
 		modelToDomain: modelToDomain,
 	}
 }
 
 func (r *GenericRepositoryImpl[T, M, ID]) Create(ctx context.Context, entity T) error {
+	if versioned, ok := any(entity).(entities.Versioned); ok && versioned.GetVersion() == 0 {
+		versioned.SetVersion(1)
+	}
 	model := r.domainToModel(entity)
 
 	now := time.Now()
 	entity.SetCreatedAt(now)
 	entity.SetUpdatedAt(now)
 
-	result := r.db.WithContext(ctx).Create(model)
+	result := persistence.Conn(ctx, r.db).Create(model)
 	if result.Error != nil {
 		return persistence.NewInternalError("failed to create entity", result.Error)
 	}
@@ -63,7 +69,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) CreateBatch(ctx context.Context, entit
 		models[i] = *r.domainToModel(entity)
 	}
 
-	result := r.db.WithContext(ctx).CreateInBatches(models, 100)
+	result := persistence.Conn(ctx, r.db).CreateInBatches(models, 100)
 
 	if result.Error != nil {
 		return &sharedModels.BulkOperationResult{
@@ -83,7 +89,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) FindByID(ctx context.Context, id ID) (
 	var model M
 	var zero T
 
-	result := r.db.WithContext(ctx).First(&model, "id = ?", id)
+	result := persistence.Conn(ctx, r.db).First(&model, "id = ?", id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return zero, persistence.NewNotFoundError(fmt.Sprintf("entity with id %v not found", id), result.Error)
@@ -101,7 +107,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) FindByIDs(ctx context.Context, ids []I
 	}
 
 	var models []M
-	result := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&models)
+	result := persistence.Conn(ctx, r.db).Where("id IN ?", ids).Find(&models)
 	if result.Error != nil {
 		return nil, persistence.NewInternalError("failed to find entities by ids", result.Error)
 	}
@@ -115,10 +121,15 @@ func (r *GenericRepositoryImpl[T, M, ID]) FindByIDs(ctx context.Context, ids []I
 }
 
 func (r *GenericRepositoryImpl[T, M, ID]) Update(ctx context.Context, entity T) error {
+	if versioned, ok := any(entity).(entities.Versioned); ok {
+		entity.SetUpdatedAt(time.Now())
+		return r.updateVersioned(ctx, entity, versioned)
+	}
+
 	model := r.domainToModel(entity)
 	entity.SetUpdatedAt(time.Now())
 
-	result := r.db.WithContext(ctx).Save(model)
+	result := persistence.Conn(ctx, r.db).Save(model)
 	if result.Error != nil {
 		return persistence.NewInternalError("failed to update entity", result.Error)
 	}
@@ -131,6 +142,10 @@ func (r *GenericRepositoryImpl[T, M, ID]) UpdateBatch(ctx context.Context, entit
 		return &sharedModels.BulkOperationResult{SuccessCount: 0, FailureCount: 0}, nil
 	}
 
+	if isVersioned[T]() {
+		return r.updateBatchVersioned(ctx, entities)
+	}
+
 	models := make([]*M, len(entities))
 	now := time.Now()
 
@@ -139,7 +154,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) UpdateBatch(ctx context.Context, entit
 		models[i] = r.domainToModel(entity)
 	}
 
-	result := r.db.WithContext(ctx).Save(models)
+	result := persistence.Conn(ctx, r.db).Save(models)
 	if result.Error != nil {
 		return &sharedModels.BulkOperationResult{
 			SuccessCount: 0,
@@ -156,8 +171,11 @@ func (r *GenericRepositoryImpl[T, M, ID]) UpdateBatch(ctx context.Context, entit
 
 func (r *GenericRepositoryImpl[T, M, ID]) UpdateFields(ctx context.Context, id ID, fields map[string]interface{}) error {
 	fields["updated_at"] = time.Now()
+	if isVersioned[T]() {
+		return r.updateFieldsVersioned(ctx, id, fields)
+	}
 
-	result := r.db.WithContext(ctx).Model(new(M)).Where("id = ?", id).Updates(fields)
+	result := persistence.Conn(ctx, r.db).Model(new(M)).Where("id = ?", id).Updates(fields)
 	if result.Error != nil {
 		return persistence.NewInternalError("failed to update entity fields", result.Error)
 	}
@@ -170,7 +188,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) UpdateFields(ctx context.Context, id I
 }
 
 func (r *GenericRepositoryImpl[T, M, ID]) Delete(ctx context.Context, id ID) error {
-	result := r.db.WithContext(ctx).Delete(new(M), "id = ?", id)
+	result := persistence.Conn(ctx, r.db).Delete(new(M), "id = ?", id)
 	if result.Error != nil {
 		return persistence.NewInternalError("failed to delete entity", result.Error)
 	}
@@ -187,7 +205,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) DeleteBatch(ctx context.Context, ids [
 		return &sharedModels.BulkOperationResult{SuccessCount: 0, FailureCount: 0}, nil
 	}
 
-	result := r.db.WithContext(ctx).Delete(new(M), "id IN ?", ids)
+	result := persistence.Conn(ctx, r.db).Delete(new(M), "id IN ?", ids)
 	if result.Error != nil {
 		return &sharedModels.BulkOperationResult{
 			SuccessCount: 0,
@@ -202,27 +220,17 @@ func (r *GenericRepositoryImpl[T, M, ID]) DeleteBatch(ctx context.Context, ids [
 	}, nil
 }
 
-func (r *GenericRepositoryImpl[T, M, ID]) SoftDelete(ctx context.Context, id ID) error {
-	result := r.db.WithContext(ctx).Model(new(M)).Where("id = ?", id).Update("deleted_at", time.Now())
-	if result.Error != nil {
-		return persistence.NewInternalError("failed to soft delete entity", result.Error)
-	}
-
-	if result.RowsAffected == 0 {
-		return persistence.NewNotFoundError(fmt.Sprintf("entity with id %v not found", id), nil)
-	}
-
-	return nil
-}
-
 func (r *GenericRepositoryImpl[T, M, ID]) FindAll(ctx context.Context, opts *sharedModels.QueryOptions) ([]T, error) {
 	var models []M
-	query := r.db.WithContext(ctx)
+	query := persistence.Conn(ctx, r.db)
 
 	// Применяем фильтры
 	if opts != nil {
-		query = r.applyFilters(query, opts.Filters)
-		query = r.applySorting(query, opts.SortBy)
+		var err error
+		if query, err = applyFilters(query, new(M), opts.Filters, opts.Where); err != nil {
+			return nil, err
+		}
+		query = applySorting(query, opts.SortBy)
 		query = r.applyIncludes(query, opts.Includes)
 
 		if opts.Pagination != nil {
@@ -247,11 +255,14 @@ func (r *GenericRepositoryImpl[T, M, ID]) FindOne(ctx context.Context, opts *sha
 	var model M
 	var zero T
 
-	query := r.db.WithContext(ctx)
+	query := persistence.Conn(ctx, r.db)
 
 	if opts != nil {
-		query = r.applyFilters(query, opts.Filters)
-		query = r.applySorting(query, opts.SortBy)
+		var err error
+		if query, err = applyFilters(query, new(M), opts.Filters, opts.Where); err != nil {
+			return zero, err
+		}
+		query = applySorting(query, opts.SortBy)
 		query = r.applyIncludes(query, opts.Includes)
 	}
 
@@ -268,6 +279,11 @@ func (r *GenericRepositoryImpl[T, M, ID]) FindOne(ctx context.Context, opts *sha
 }
 
 func (r *GenericRepositoryImpl[T, M, ID]) FindWithPagination(ctx context.Context, opts *sharedModels.QueryOptions) (*sharedModels.PaginatedResult[T], error) {
+	return r.findWithPagination(persistence.Conn(ctx, r.db), opts)
+}
+
+// findWithPagination выполняет постраничный поиск поверх базового запроса base
+func (r *GenericRepositoryImpl[T, M, ID]) findWithPagination(base *gorm.DB, opts *sharedModels.QueryOptions) (*sharedModels.PaginatedResult[T], error) {
 	if opts == nil || opts.Pagination == nil {
 		return nil, persistence.NewInvalidInputError("pagination options are required", nil)
 	}
@@ -275,15 +291,19 @@ func (r *GenericRepositoryImpl[T, M, ID]) FindWithPagination(ctx context.Context
 	var models []M
 	var total int64
 
-	countQuery := r.db.WithContext(ctx).Model(new(M))
-	countQuery = r.applyFilters(countQuery, opts.Filters)
+	countQuery, err := applyFilters(base.Model(new(M)), new(M), opts.Filters, opts.Where)
+	if err != nil {
+		return nil, err
+	}
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, persistence.NewInternalError("failed to count _entities", err)
 	}
 
-	query := r.db.WithContext(ctx)
-	query = r.applyFilters(query, opts.Filters)
-	query = r.applySorting(query, opts.SortBy)
+	query, err := applyFilters(base, new(M), opts.Filters, opts.Where)
+	if err != nil {
+		return nil, err
+	}
+	query = applySorting(query, opts.SortBy)
 	query = r.applyIncludes(query, opts.Includes)
 	query = query.Offset(opts.Pagination.Offset).Limit(opts.Pagination.Limit)
 
@@ -314,9 +334,10 @@ func (r *GenericRepositoryImpl[T, M, ID]) FindWithPagination(ctx context.Context
 
 func (r *GenericRepositoryImpl[T, M, ID]) Count(ctx context.Context, filters map[string]interface{}) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(new(M))
-
-	query = r.applyFilters(query, filters)
+	query, err := applyFilters(persistence.Conn(ctx, r.db).Model(new(M)), new(M), filters, nil)
+	if err != nil {
+		return 0, err
+	}
 
 	result := query.Count(&count)
 	if result.Error != nil {
@@ -328,7 +349,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) Count(ctx context.Context, filters map
 
 func (r *GenericRepositoryImpl[T, M, ID]) Exists(ctx context.Context, id ID) (bool, error) {
 	var count int64
-	result := r.db.WithContext(ctx).Model(new(M)).Where("id = ?", id).Count(&count)
+	result := persistence.Conn(ctx, r.db).Model(new(M)).Where("id = ?", id).Count(&count)
 	if result.Error != nil {
 		return false, persistence.NewInternalError("failed to check entity existence", result.Error)
 	}
@@ -338,9 +359,10 @@ func (r *GenericRepositoryImpl[T, M, ID]) Exists(ctx context.Context, id ID) (bo
 
 func (r *GenericRepositoryImpl[T, M, ID]) ExistsByFields(ctx context.Context, filters map[string]interface{}) (bool, error) {
 	var count int64
-	query := r.db.WithContext(ctx).Model(new(M))
-
-	query = r.applyFilters(query, filters)
+	query, err := applyFilters(persistence.Conn(ctx, r.db).Model(new(M)), new(M), filters, nil)
+	if err != nil {
+		return false, err
+	}
 
 	result := query.Count(&count)
 	if result.Error != nil {
@@ -351,17 +373,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) ExistsByFields(ctx context.Context, fi
 }
 
 func (r *GenericRepositoryImpl[T, M, ID]) WithTransaction(ctx context.Context, fn repositories.TransactionFunc) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		txRepo := &GenericRepositoryImpl[T, M, ID]{
-			db:            tx,
-			domainToModel: r.domainToModel,
-			modelToDomain: r.modelToDomain,
-		}
-
-		txCtx := context.WithValue(ctx, "tx_repo", txRepo)
-
-		return fn(txCtx)
-	})
+	return persistence.NewTransactor(r.db).WithinTransaction(ctx, fn)
 }
 
 func (r *GenericRepositoryImpl[T, M, ID]) Refresh(ctx context.Context, entity T) error {
@@ -378,7 +390,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) Refresh(ctx context.Context, entity T)
 }
 
 func (r *GenericRepositoryImpl[T, M, ID]) Clear(ctx context.Context) error {
-	result := r.db.WithContext(ctx).Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(new(M))
+	result := persistence.Conn(ctx, r.db).Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(new(M))
 	if result.Error != nil {
 		return persistence.NewInternalError("failed to clear entities", result.Error)
 	}
@@ -386,7 +398,7 @@ func (r *GenericRepositoryImpl[T, M, ID]) Clear(ctx context.Context) error {
 	return nil
 }
 
-func (r *GenericRepositoryImpl[T, M, ID]) mapFieldToColumn(field string) string {
+func mapFieldToColumn(field string) string {
 	fieldMappings := map[string]string{
 		"createdAt": "created_at",
 		"updatedAt": "updated_at",
@@ -397,10 +409,10 @@ func (r *GenericRepositoryImpl[T, M, ID]) mapFieldToColumn(field string) string
 		return dbColumn
 	}
 
-	return r.camelToSnake(field)
+	return camelToSnake(field)
 }
 
-func (r *GenericRepositoryImpl[T, M, ID]) camelToSnake(str string) string {
+func camelToSnake(str string) string {
 	var result strings.Builder
 
 	for i, char := range str {
@@ -413,28 +425,13 @@ func (r *GenericRepositoryImpl[T, M, ID]) camelToSnake(str string) string {
 	return strings.ToLower(result.String())
 }
 
-func (r *GenericRepositoryImpl[T, M, ID]) applyFilters(query *gorm.DB, filters map[string]interface{}) *gorm.DB {
-	if filters == nil {
-		return query
-	}
-
-	for key, value := range filters {
-		if value != nil {
-			dbColumn := r.mapFieldToColumn(key)
-			query = query.Where(dbColumn+" = ?", value)
-		}
-	}
-
-	return query
-}
-
-func (r *GenericRepositoryImpl[T, M, ID]) applySorting(query *gorm.DB, sortBy []sharedModels.SortBy) *gorm.DB {
+func applySorting(query *gorm.DB, sortBy []sharedModels.SortBy) *gorm.DB {
 	if sortBy == nil {
 		return query
 	}
 
 	for _, sort := range sortBy {
-		dbColumn := r.mapFieldToColumn(sort.Field)
+		dbColumn := mapFieldToColumn(sort.Field)
 		desc := sort.Order.ToUpper() == sharedModels.DESC
 		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: dbColumn}, Desc: desc})
 	}
@@ -0,0 +1,118 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"tax-priority-api/src/infrastructure/persistence/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) repositories.OutboxRepository {
+	return &OutboxRepositoryImpl{db: db}
+}
+
+func (r *OutboxRepositoryImpl) Append(ctx context.Context, message *entities.OutboxMessage) error {
+	if message.ID == "" {
+		message.ID = uuid.New().String()
+	}
+
+	model := models.NewOutboxMessageModelFromEntity(message)
+	if err := persistence.Conn(ctx, r.db).Create(model).Error; err != nil {
+		return persistence.NewInternalError("failed to append outbox message", err)
+	}
+	return nil
+}
+
+// ClaimPending выбирает и откладывает события одним UPDATE: блокировка строк держится только
+// на время этого запроса, а не на время доставки получателям
+func (r *OutboxRepositoryImpl) ClaimPending(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entities.OutboxMessage, error) {
+	conn := persistence.Conn(ctx, r.db)
+	pending := conn.Model(new(models.OutboxMessageModel)).
+		Select("id").
+		Where("published_at IS NULL AND available_at <= ?", now).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+
+	var rows []*models.OutboxMessageModel
+	err := conn.Model(&rows).
+		Clauses(clause.Returning{}).
+		Where("id IN (?)", pending).
+		Update("available_at", leaseUntil).Error
+	if err != nil {
+		return nil, persistence.NewInternalError("failed to claim outbox messages", err)
+	}
+
+	// RETURNING не сохраняет порядок подзапроса
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].CreatedAt.Equal(rows[j].CreatedAt) {
+			return rows[i].CreatedAt.Before(rows[j].CreatedAt)
+		}
+		return rows[i].ID < rows[j].ID
+	})
+
+	messages := make([]*entities.OutboxMessage, len(rows))
+	for i, row := range rows {
+		messages[i] = row.ToEntity()
+	}
+	return messages, nil
+}
+
+func (r *OutboxRepositoryImpl) MarkDelivered(ctx context.Context, id string, sink string) error {
+	delivered := "COALESCE(delivered_sinks, '[]'::jsonb)"
+	err := persistence.Conn(ctx, r.db).
+		Model(new(models.OutboxMessageModel)).
+		Where("id = ? AND NOT "+delivered+" @> jsonb_build_array(?::text)", id, sink).
+		Update("delivered_sinks", gorm.Expr(delivered+" || jsonb_build_array(?::text)", sink)).Error
+	if err != nil {
+		return persistence.NewInternalError("failed to record outbox delivery", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	err := persistence.Conn(ctx, r.db).
+		Model(new(models.OutboxMessageModel)).
+		Where("id = ?", id).
+		Update("published_at", publishedAt).Error
+	if err != nil {
+		return persistence.NewInternalError("failed to mark outbox message as published", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, id string, lastError string, availableAt time.Time) error {
+	err := persistence.Conn(ctx, r.db).
+		Model(new(models.OutboxMessageModel)).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   lastError,
+			"available_at": availableAt,
+		}).Error
+	if err != nil {
+		return persistence.NewInternalError("failed to record outbox delivery failure", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepositoryImpl) DeletePublished(ctx context.Context, publishedBefore time.Time) (int64, error) {
+	result := persistence.Conn(ctx, r.db).
+		Where("published_at IS NOT NULL AND published_at < ?", publishedBefore).
+		Delete(new(models.OutboxMessageModel))
+	if result.Error != nil {
+		return 0, persistence.NewInternalError("failed to delete published outbox messages", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	sharedModels "tax-priority-api/src/application/models"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// softDeleteColumnsCache кеш колонок мягкого удаления по типу модели
+var softDeleteColumnsCache sync.Map
+
+var deletedAtType = reflect.TypeOf(gorm.DeletedAt{})
+
+// softDeleteColumn возвращает колонку gorm.DeletedAt модели.
+// Без нее gorm удаляет записи физически, поэтому операции с корзиной для такой модели недоступны.
+func softDeleteColumn(db *gorm.DB, model any) (string, error) {
+	modelType := reflect.TypeOf(model)
+	if cached, ok := softDeleteColumnsCache.Load(modelType); ok {
+		return cached.(string), nil
+	}
+
+	parsed, err := schema.Parse(model, &sync.Map{}, db.NamingStrategy)
+	if err != nil {
+		return "", persistence.NewInternalError("failed to parse model schema", err)
+	}
+
+	for _, field := range parsed.Fields {
+		if field.FieldType == deletedAtType && field.DBName != "" {
+			softDeleteColumnsCache.Store(modelType, field.DBName)
+			return field.DBName, nil
+		}
+	}
+
+	return "", persistence.NewInternalError(fmt.Sprintf("model %s does not support soft delete", parsed.Name), nil)
+}
+
+func (r *GenericRepositoryImpl[T, M, ID]) SoftDelete(ctx context.Context, id ID) error {
+	if _, err := softDeleteColumn(r.db, new(M)); err != nil {
+		return err
+	}
+
+	// gorm заполняет deleted_at и не трогает записи, которые уже в корзине
+	result := persistence.Conn(ctx, r.db).Delete(new(M), "id = ?", id)
+	if result.Error != nil {
+		return persistence.NewInternalError("failed to soft delete entity", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return persistence.NewNotFoundError(fmt.Sprintf("entity with id %v not found", id), nil)
+	}
+
+	return nil
+}
+
+func (r *GenericRepositoryImpl[T, M, ID]) Restore(ctx context.Context, id ID) error {
+	column, err := softDeleteColumn(r.db, new(M))
+	if err != nil {
+		return err
+	}
+
+	result := persistence.Conn(ctx, r.db).Unscoped().
+		Model(new(M)).
+		Where("id = ?", id).
+		Where(column+" IS NOT NULL").
+		Update(column, nil)
+	if result.Error != nil {
+		return persistence.NewInternalError("failed to restore entity", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return persistence.NewNotFoundError(fmt.Sprintf("entity with id %v not found in trash", id), nil)
+	}
+
+	return nil
+}
+
+func (r *GenericRepositoryImpl[T, M, ID]) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	column, err := softDeleteColumn(r.db, new(M))
+	if err != nil {
+		return 0, err
+	}
+
+	result := persistence.Conn(ctx, r.db).Unscoped().
+		Where(column+" < ?", deletedBefore).
+		Delete(new(M))
+	if result.Error != nil {
+		return 0, persistence.NewInternalError("failed to purge deleted entities", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *GenericRepositoryImpl[T, M, ID]) FindDeletedBefore(ctx context.Context, deletedBefore time.Time) ([]T, error) {
+	column, err := softDeleteColumn(r.db, new(M))
+	if err != nil {
+		return nil, err
+	}
+
+	// Условие совпадает с Purge: выборка возвращает ровно те записи, которые очистка удалит
+	var models []M
+	result := persistence.Conn(ctx, r.db).Unscoped().
+		Where(column+" < ?", deletedBefore).
+		Order("id").
+		Find(&models)
+	if result.Error != nil {
+		return nil, persistence.NewInternalError("failed to find expired deleted entities", result.Error)
+	}
+
+	_entities := make([]T, len(models))
+	for i, model := range models {
+		_entities[i] = r.modelToDomain(&model)
+	}
+
+	return _entities, nil
+}
+
+func (r *GenericRepositoryImpl[T, M, ID]) FindDeleted(ctx context.Context, opts *sharedModels.QueryOptions) (*sharedModels.PaginatedResult[T], error) {
+	column, err := softDeleteColumn(r.db, new(M))
+	if err != nil {
+		return nil, err
+	}
+
+	// Session делает базовый запрос переиспользуемым для подсчета и выборки
+	base := persistence.Conn(ctx, r.db).Unscoped().
+		Where(column + " IS NOT NULL").
+		Session(&gorm.Session{})
+
+	return r.findWithPagination(base, opts)
+}
@@ -5,7 +5,6 @@ import (
 	"tax-priority-api/src/application/models"
 	"tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
-	"time"
 )
 
 type TestimonialRepositoryImpl struct {
@@ -144,100 +143,3 @@ func (r *TestimonialRepositoryImpl) CountByApprovalStatus(ctx context.Context, i
 		"isApproved": isApproved,
 	})
 }
-
-func (r *TestimonialRepositoryImpl) ApproveMany(ctx context.Context, ids []string, approvedBy string) (*models.BulkOperationResult, error) {
-	if len(ids) == 0 {
-		return &models.BulkOperationResult{SuccessCount: 0, FailureCount: 0}, nil
-	}
-
-	now := time.Now()
-	fields := map[string]interface{}{
-		"isApproved": true,
-		"approvedAt": now,
-		"approvedBy": approvedBy,
-		"updatedAt":  now,
-	}
-
-	var successCount, failureCount int
-	var errors []error
-
-	for _, id := range ids {
-		err := r.GenericRepository.UpdateFields(ctx, id, fields)
-		if err != nil {
-			failureCount++
-			errors = append(errors, err)
-		} else {
-			successCount++
-		}
-	}
-
-	return &models.BulkOperationResult{
-		SuccessCount: successCount,
-		FailureCount: failureCount,
-		Errors:       errors,
-	}, nil
-}
-
-func (r *TestimonialRepositoryImpl) DeactivateMany(ctx context.Context, ids []string) (*models.BulkOperationResult, error) {
-	if len(ids) == 0 {
-		return &models.BulkOperationResult{SuccessCount: 0, FailureCount: 0}, nil
-	}
-
-	fields := map[string]interface{}{
-		"isActive":  false,
-		"updatedAt": time.Now(),
-	}
-
-	var successCount, failureCount int
-	var errors []error
-
-	for _, id := range ids {
-		err := r.GenericRepository.UpdateFields(ctx, id, fields)
-		if err != nil {
-			failureCount++
-			errors = append(errors, err)
-		} else {
-			successCount++
-		}
-	}
-
-	return &models.BulkOperationResult{
-		SuccessCount: successCount,
-		FailureCount: failureCount,
-		Errors:       errors,
-	}, nil
-}
-
-func (r *TestimonialRepositoryImpl) ActivateMany(ctx context.Context, ids []string) (*models.BulkOperationResult, error) {
-	if len(ids) == 0 {
-		return &models.BulkOperationResult{SuccessCount: 0, FailureCount: 0}, nil
-	}
-
-	fields := map[string]interface{}{
-		"isActive":  true,
-		"updatedAt": time.Now(),
-	}
-
-	var successCount, failureCount int
-	var errors []error
-
-	for _, id := range ids {
-		err := r.GenericRepository.UpdateFields(ctx, id, fields)
-		if err != nil {
-			failureCount++
-			errors = append(errors, err)
-		} else {
-			successCount++
-		}
-	}
-
-	return &models.BulkOperationResult{
-		SuccessCount: successCount,
-		FailureCount: failureCount,
-		Errors:       errors,
-	}, nil
-}
-
-func (r *TestimonialRepositoryImpl) DeleteMany(ctx context.Context, ids []string) (*models.BulkOperationResult, error) {
-	return r.GenericRepository.DeleteBatch(ctx, ids)
-}
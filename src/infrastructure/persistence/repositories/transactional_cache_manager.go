@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"tax-priority-api/src/infrastructure/cache"
+	"tax-priority-api/src/infrastructure/persistence"
+	"time"
+)
+
+// errCacheBypassed промах кеша внутри транзакции: чтение должно идти в базу
+var errCacheBypassed = errors.New("cache bypassed inside transaction")
+
+// transactionalCacheManager согласует кеш с единицей работы persistence.Transactor.
+// Внутри транзакции чтения идут мимо кеша, чтобы не видеть и не публиковать незафиксированные данные,
+// а инвалидация откладывается до фиксации и при откате не выполняется.
+type transactionalCacheManager[T any, ID comparable] struct {
+	cache.CacheManager[T, ID]
+}
+
+// newTransactionalCacheManager оборачивает cacheManager; повторная обертка не создается
+func newTransactionalCacheManager[T any, ID comparable](cacheManager cache.CacheManager[T, ID]) cache.CacheManager[T, ID] {
+	if _, ok := cacheManager.(*transactionalCacheManager[T, ID]); ok {
+		return cacheManager
+	}
+	return &transactionalCacheManager[T, ID]{CacheManager: cacheManager}
+}
+
+func (m *transactionalCacheManager[T, ID]) Get(ctx context.Context, id ID) (T, error) {
+	if persistence.InTransaction(ctx) {
+		var zero T
+		return zero, errCacheBypassed
+	}
+	return m.CacheManager.Get(ctx, id)
+}
+
+func (m *transactionalCacheManager[T, ID]) GetMultiple(
+	ctx context.Context,
+	ids []ID,
+	loader func([]ID) (map[ID]T, error),
+) ([]T, error) {
+	if !persistence.InTransaction(ctx) {
+		return m.CacheManager.GetMultiple(ctx, ids, loader)
+	}
+
+	loaded, err := loader(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0, len(ids))
+	for _, id := range ids {
+		if entity, ok := loaded[id]; ok {
+			result = append(result, entity)
+		}
+	}
+	return result, nil
+}
+
+func (m *transactionalCacheManager[T, ID]) GetQuery(
+	ctx context.Context,
+	queryKey string,
+	loader func() (interface{}, error),
+	ttl time.Duration,
+) (interface{}, error) {
+	if persistence.InTransaction(ctx) {
+		return loader()
+	}
+	return m.CacheManager.GetQuery(ctx, queryKey, loader, ttl)
+}
+
+func (m *transactionalCacheManager[T, ID]) GetOrLoad(ctx context.Context, id ID, loader func() (T, error)) (T, error) {
+	if persistence.InTransaction(ctx) {
+		return loader()
+	}
+	return m.CacheManager.GetOrLoad(ctx, id, loader)
+}
+
+func (m *transactionalCacheManager[T, ID]) Set(ctx context.Context, entity T, ttl time.Duration) error {
+	if persistence.InTransaction(ctx) {
+		return nil
+	}
+	return m.CacheManager.Set(ctx, entity, ttl)
+}
+
+func (m *transactionalCacheManager[T, ID]) Invalidate(ctx context.Context, entity T) error {
+	return m.afterCommit(ctx, func(ctx context.Context) error {
+		return m.CacheManager.Invalidate(ctx, entity)
+	})
+}
+
+func (m *transactionalCacheManager[T, ID]) InvalidateMultiple(ctx context.Context, entities []T) error {
+	return m.afterCommit(ctx, func(ctx context.Context) error {
+		return m.CacheManager.InvalidateMultiple(ctx, entities)
+	})
+}
+
+func (m *transactionalCacheManager[T, ID]) InvalidateByID(ctx context.Context, id ID) error {
+	return m.afterCommit(ctx, func(ctx context.Context) error {
+		return m.CacheManager.InvalidateByID(ctx, id)
+	})
+}
+
+func (m *transactionalCacheManager[T, ID]) InvalidateQuery(ctx context.Context, queryKey string) error {
+	return m.afterCommit(ctx, func(ctx context.Context) error {
+		return m.CacheManager.InvalidateQuery(ctx, queryKey)
+	})
+}
+
+func (m *transactionalCacheManager[T, ID]) InvalidatePattern(ctx context.Context, pattern string) error {
+	return m.afterCommit(ctx, func(ctx context.Context) error {
+		return m.CacheManager.InvalidatePattern(ctx, pattern)
+	})
+}
+
+func (m *transactionalCacheManager[T, ID]) InvalidateAll(ctx context.Context) error {
+	return m.afterCommit(ctx, m.CacheManager.InvalidateAll)
+}
+
+// afterCommit выполняет инвалидацию сразу вне транзакции или откладывает ее до фиксации.
+// Ошибка отложенной инвалидации не влияет на уже зафиксированную транзакцию.
+func (m *transactionalCacheManager[T, ID]) afterCommit(ctx context.Context, invalidate func(context.Context) error) error {
+	if !persistence.InTransaction(ctx) {
+		return invalidate(ctx)
+	}
+
+	persistence.AfterCommit(ctx, func(ctx context.Context) {
+		_ = invalidate(ctx)
+	})
+	return nil
+}
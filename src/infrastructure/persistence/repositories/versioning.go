@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	sharedModels "tax-priority-api/src/application/models"
+	"tax-priority-api/src/domain/entities"
+	persistence "tax-priority-api/src/infrastructure/persistence"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// versionColumn колонка версии моделей entities.Versioned
+const versionColumn = "version"
+
+// isVersioned сообщает, поддерживает ли тип сущности оптимистичную блокировку
+func isVersioned[T any]() bool {
+	var zero T
+	_, ok := any(zero).(entities.Versioned)
+	return ok
+}
+
+// updateBatchVersioned сохраняет сущности по одной условным UPDATE, как Update.
+// Запись, измененная после чтения, останавливает пакет с конфликтом версий: уже сохраненные
+// записи откатывает транзакция вызывающего кода, а чужое изменение не перезаписывается.
+func (r *GenericRepositoryImpl[T, M, ID]) updateBatchVersioned(ctx context.Context, batch []T) (*sharedModels.BulkOperationResult, error) {
+	now := time.Now()
+	for i, entity := range batch {
+		entity.SetUpdatedAt(now)
+		if err := r.updateVersioned(ctx, entity, any(entity).(entities.Versioned)); err != nil {
+			return &sharedModels.BulkOperationResult{
+				SuccessCount: i,
+				FailureCount: len(batch) - i,
+				Errors:       []error{err},
+			}, err
+		}
+	}
+
+	return &sharedModels.BulkOperationResult{
+		SuccessCount: len(batch),
+		FailureCount: 0,
+	}, nil
+}
+
+// updateVersioned сохраняет сущность условным UPDATE ... WHERE version = ? и увеличивает ее версию.
+// Если запись изменили после чтения, версия сущности не меняется и возвращается конфликт версий.
+func (r *GenericRepositoryImpl[T, M, ID]) updateVersioned(ctx context.Context, entity T, versioned entities.Versioned) error {
+	expected := versioned.GetVersion()
+	if expected == 0 {
+		// Версия 0 у сущностей из кеша, записанного до появления версий: сверяемся с текущей версией в базе
+		current, err := r.currentVersion(ctx, entity.GetID())
+		if err != nil {
+			return err
+		}
+		expected = current
+	}
+	versioned.SetVersion(expected + 1)
+	model := r.domainToModel(entity)
+
+	// Select("*") обновляет все колонки, как Save, но без вставки записи при RowsAffected == 0
+	result := persistence.Conn(ctx, r.db).Select("*").Where(versionColumn+" = ?", expected).Updates(model)
+	if result.Error != nil {
+		versioned.SetVersion(expected)
+		return persistence.NewInternalError("failed to update entity", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		versioned.SetVersion(expected)
+		return r.versionMismatch(ctx, entity.GetID(), expected)
+	}
+
+	return nil
+}
+
+// updateFieldsVersioned обновляет поля и увеличивает версию; ключ "version" в fields задает ожидаемую версию
+func (r *GenericRepositoryImpl[T, M, ID]) updateFieldsVersioned(ctx context.Context, id ID, fields map[string]interface{}) error {
+	query := persistence.Conn(ctx, r.db).Model(new(M)).Where("id = ?", id)
+
+	expected, checked := fields[versionColumn]
+	if checked {
+		query = query.Where(versionColumn+" = ?", expected)
+	}
+	fields[versionColumn] = gorm.Expr(versionColumn + " + 1")
+
+	result := query.Updates(fields)
+	if result.Error != nil {
+		return persistence.NewInternalError("failed to update entity fields", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		if !checked {
+			return persistence.NewNotFoundError(fmt.Sprintf("entity with id %v not found", id), nil)
+		}
+		return r.versionMismatch(ctx, id, expected)
+	}
+
+	return nil
+}
+
+// versionMismatch различает причины пустого условного UPDATE: запись удалена или изменена другим клиентом
+func (r *GenericRepositoryImpl[T, M, ID]) versionMismatch(ctx context.Context, id ID, expected any) error {
+	current, err := r.currentVersion(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return persistence.NewVersionConflictError(
+		fmt.Sprintf("entity with id %v has version %d, expected %v", id, current, expected),
+		entities.ErrVersionConflict,
+	)
+}
+
+// currentVersion читает версию записи из базы
+func (r *GenericRepositoryImpl[T, M, ID]) currentVersion(ctx context.Context, id ID) (int, error) {
+	var current struct{ Version int }
+	result := persistence.Conn(ctx, r.db).Model(new(M)).Select(versionColumn).Where("id = ?", id).Limit(1).Find(&current)
+	if result.Error != nil {
+		return 0, persistence.NewInternalError("failed to check entity version", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return 0, persistence.NewNotFoundError(fmt.Sprintf("entity with id %v not found", id), nil)
+	}
+
+	return current.Version, nil
+}
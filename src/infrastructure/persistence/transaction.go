@@ -0,0 +1,87 @@
+package persistence
+
+import (
+	"context"
+	"sync"
+
+	"tax-priority-api/src/application/repositories"
+
+	"gorm.io/gorm"
+)
+
+// txKey ключ context.Context, под которым хранится открытая единица работы
+type txKey struct{}
+
+// unitOfWork открытая транзакция и действия, отложенные до ее фиксации
+type unitOfWork struct {
+	tx *gorm.DB
+
+	mu          sync.Mutex
+	afterCommit []func(context.Context)
+}
+
+func currentUnit(ctx context.Context) (*unitOfWork, bool) {
+	unit, ok := ctx.Value(txKey{}).(*unitOfWork)
+	return unit, ok
+}
+
+// Conn возвращает соединение для запроса с ctx: открытую Transactor транзакцию или db.
+// Репозитории получают соединение только через Conn, чтобы участвовать в транзакции вызывающего.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if unit, ok := currentUnit(ctx); ok {
+		return unit.tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// InTransaction сообщает, выполняется ли ctx внутри транзакции Transactor
+func InTransaction(ctx context.Context) bool {
+	_, ok := currentUnit(ctx)
+	return ok
+}
+
+// AfterCommit откладывает fn до фиксации транзакции из ctx; при откате fn не вызывается.
+// Вне транзакции fn выполняется сразу.
+func AfterCommit(ctx context.Context, fn func(context.Context)) {
+	unit, ok := currentUnit(ctx)
+	if !ok {
+		fn(ctx)
+		return
+	}
+
+	unit.mu.Lock()
+	unit.afterCommit = append(unit.afterCommit, fn)
+	unit.mu.Unlock()
+}
+
+// Transactor открывает транзакции PostgreSQL и передает их репозиториям через context.Context
+type Transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) repositories.Transactor {
+	return &Transactor{db: db}
+}
+
+// WithinTransaction выполняет fn в транзакции. Во вложенном вызове новая транзакция не открывается:
+// fn работает во внешней, и ее ошибка откатывает внешнюю транзакцию целиком.
+// Действия AfterCommit выполняются после успешной фиксации внешней транзакции.
+func (t *Transactor) WithinTransaction(ctx context.Context, fn repositories.TransactionFunc) error {
+	if InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	unit := &unitOfWork{}
+	err := t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		unit.tx = tx
+		return fn(context.WithValue(ctx, txKey{}, unit))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range unit.afterCommit {
+		hook(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	appStorage "tax-priority-api/src/application/storage"
+)
+
+// Параметры подписанной ссылки локального хранилища; имена совпадают с S3, чтобы клиенты не различали хранилища
+const (
+	queryExpires             = "X-Expires"
+	querySignature           = "X-Signature"
+	queryResponseType        = "response-content-type"
+	queryResponseDisposition = "response-content-disposition"
+)
+
+// LocalBlobStore хранит объекты в каталоге локальной файловой системы.
+// Подписанные ссылки указывают на baseURL приложения и проверяются HMAC ключом secret.
+type LocalBlobStore struct {
+	root    string
+	baseURL string
+	secret  []byte
+}
+
+// NewLocalBlobStore создает хранилище в каталоге root
+func NewLocalBlobStore(root, baseURL string, secret []byte) *LocalBlobStore {
+	return &LocalBlobStore{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  secret,
+	}
+}
+
+// path возвращает путь объекта, не допуская выхода за пределы root
+func (s *LocalBlobStore) path(key string) (string, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, cleaned), nil
+}
+
+func (s *LocalBlobStore) Put(ctx context.Context, key string, content io.Reader, opts appStorage.PutOptions) (*appStorage.BlobInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	// Пишем во временный файл и переименовываем, чтобы не оставлять оборванные файлы под ключом
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	size, err := io.Copy(tmp, content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, fmt.Errorf("failed to save file: %w", err)
+	}
+
+	return &appStorage.BlobInfo{
+		Key:         key,
+		Size:        size,
+		ContentType: opts.ContentType,
+		ModTime:     time.Now(),
+	}, nil
+}
+
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *appStorage.BlobInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, appStorage.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	// *os.File реализует io.Seeker, поэтому файл можно отдавать с поддержкой Range
+	return file, s.info(key, stat), nil
+}
+
+func (s *LocalBlobStore) Stat(ctx context.Context, key string) (*appStorage.BlobInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, appStorage.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+	return s.info(key, stat), nil
+}
+
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove file: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalBlobStore) SignedURL(ctx context.Context, key string, ttl time.Duration, opts appStorage.URLOptions) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+
+	query := url.Values{}
+	query.Set(queryExpires, expires)
+	if opts.ContentType != "" {
+		query.Set(queryResponseType, opts.ContentType)
+	}
+	if opts.ContentDisposition != "" {
+		query.Set(queryResponseDisposition, opts.ContentDisposition)
+	}
+	query.Set(querySignature, s.sign(key, expires, opts))
+
+	return s.baseURL + "/" + escapeKey(key) + "?" + query.Encode(), nil
+}
+
+func (s *LocalBlobStore) VerifyURL(key string, query url.Values) (*appStorage.URLOptions, error) {
+	expires := query.Get(queryExpires)
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return nil, appStorage.ErrInvalidSignature
+	}
+
+	opts := appStorage.URLOptions{
+		ContentType:        query.Get(queryResponseType),
+		ContentDisposition: query.Get(queryResponseDisposition),
+	}
+
+	signature, err := hex.DecodeString(query.Get(querySignature))
+	if err != nil {
+		return nil, appStorage.ErrInvalidSignature
+	}
+	expected, _ := hex.DecodeString(s.sign(key, expires, opts))
+	if !hmac.Equal(signature, expected) {
+		return nil, appStorage.ErrInvalidSignature
+	}
+
+	return &opts, nil
+}
+
+// sign подписывает ключ вместе со сроком действия и заголовками ответа, чтобы их нельзя было подменить
+func (s *LocalBlobStore) sign(key, expires string, opts appStorage.URLOptions) string {
+	mac := hmac.New(sha256.New, s.secret)
+	for _, part := range []string{key, expires, opts.ContentType, opts.ContentDisposition} {
+		mac.Write([]byte(part))
+		mac.Write([]byte{0})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalBlobStore) info(key string, stat fs.FileInfo) *appStorage.BlobInfo {
+	return &appStorage.BlobInfo{
+		Key:         key,
+		Size:        stat.Size(),
+		ContentType: mime.TypeByExtension(filepath.Ext(key)),
+		ModTime:     stat.ModTime(),
+	}
+}
+
+// escapeKey экранирует сегменты ключа для подстановки в путь URL
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
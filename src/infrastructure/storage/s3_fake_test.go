@@ -0,0 +1,309 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	fakeS3AccessKey = "AKIDFAKE"
+	fakeS3SecretKey = "fake-secret-key"
+	fakeS3Region    = "eu-test-1"
+	fakeS3Bucket    = "attachments"
+)
+
+// fakeS3Object объект в памяти фейкового хранилища
+type fakeS3Object struct {
+	data        []byte
+	contentType string
+	modTime     time.Time
+}
+
+// fakeS3 минимальный S3 API с адресацией бакета в пути: PUT, GET, HEAD и DELETE объектов.
+// Подпись каждого запроса проверяется по тому, что пришло на сервер, а не по тому, что подписал клиент.
+type fakeS3 struct {
+	t      *testing.T
+	server *httptest.Server
+	now    func() time.Time
+
+	mu      sync.Mutex
+	objects map[string]*fakeS3Object
+}
+
+func newFakeS3(t *testing.T) *fakeS3 {
+	t.Helper()
+
+	fake := &fakeS3{t: t, now: time.Now, objects: make(map[string]*fakeS3Object)}
+	fake.server = httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(fake.server.Close)
+	return fake
+}
+
+// store возвращает хранилище, настроенное на фейковый сервер
+func (f *fakeS3) store(t *testing.T) *S3BlobStore {
+	t.Helper()
+
+	store, err := NewS3BlobStore(S3Config{
+		Endpoint:        f.server.URL,
+		Region:          fakeS3Region,
+		Bucket:          fakeS3Bucket,
+		AccessKeyID:     fakeS3AccessKey,
+		SecretAccessKey: fakeS3SecretKey,
+		UsePathStyle:    true,
+		HTTPClient:      f.server.Client(),
+	})
+	if err != nil {
+		t.Fatalf("NewS3BlobStore: %v", err)
+	}
+	return store
+}
+
+func (f *fakeS3) serve(w http.ResponseWriter, r *http.Request) {
+	prefix := "/" + fakeS3Bucket + "/"
+	path, err := url.PathUnescape(r.URL.EscapedPath())
+	if err != nil || !strings.HasPrefix(path, prefix) {
+		writeFakeS3Error(w, http.StatusNotFound, "NoSuchBucket", "bucket does not exist")
+		return
+	}
+	key := strings.TrimPrefix(path, prefix)
+
+	if err := f.verify(r); err != nil {
+		f.t.Logf("fake s3: %s %s rejected: %v", r.Method, r.URL, err)
+		writeFakeS3Error(w, http.StatusForbidden, "SignatureDoesNotMatch", err.Error())
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeFakeS3Error(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		if r.URL.Query().Get("X-Amz-Signature") == "" && sha256Hex(data) != r.Header.Get("X-Amz-Content-Sha256") {
+			writeFakeS3Error(w, http.StatusBadRequest, "XAmzContentSHA256Mismatch", "payload hash does not match body")
+			return
+		}
+		object := &fakeS3Object{data: data, contentType: r.Header.Get("Content-Type"), modTime: f.now().UTC().Truncate(time.Second)}
+		f.objects[key] = object
+		w.Header().Set("ETag", object.etag())
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet, http.MethodHead:
+		object, ok := f.objects[key]
+		if !ok {
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeFakeS3Error(w, http.StatusNotFound, "NoSuchKey", "the specified key does not exist")
+			return
+		}
+		contentType := object.contentType
+		if override := r.URL.Query().Get(queryResponseType); override != "" {
+			contentType = override
+		}
+		if disposition := r.URL.Query().Get(queryResponseDisposition); disposition != "" {
+			w.Header().Set("Content-Disposition", disposition)
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.data)))
+		w.Header().Set("ETag", object.etag())
+		w.Header().Set("Last-Modified", object.modTime.Format(http.TimeFormat))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(object.data)
+		}
+
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeFakeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+// verify проверяет подпись из заголовка Authorization или из параметров подписанной ссылки
+func (f *fakeS3) verify(r *http.Request) error {
+	query := r.URL.Query()
+	if signature := query.Get("X-Amz-Signature"); signature != "" {
+		return f.verifyPresigned(r, query, signature)
+	}
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 ") {
+		return fmt.Errorf("missing sigv4 authorization")
+	}
+	fields := make(map[string]string)
+	for _, part := range strings.Split(strings.TrimPrefix(auth, "AWS4-HMAC-SHA256 "), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		fields[name] = value
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	scope, err := f.checkCredential(fields["Credential"], amzDate)
+	if err != nil {
+		return err
+	}
+
+	signedHeaders := strings.Split(fields["SignedHeaders"], ";")
+	for _, required := range []string{"host", "x-amz-content-sha256", "x-amz-date"} {
+		if !containsString(signedHeaders, required) {
+			return fmt.Errorf("header %s is not signed", required)
+		}
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		fakeCanonicalQuery(query, ""),
+		fakeCanonicalHeaders(r, signedHeaders),
+		fields["SignedHeaders"],
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	return checkSignature(fields["Signature"], amzDate, scope, canonicalRequest)
+}
+
+func (f *fakeS3) verifyPresigned(r *http.Request, query url.Values, signature string) error {
+	if query.Get("X-Amz-Algorithm") != "AWS4-HMAC-SHA256" {
+		return fmt.Errorf("unexpected algorithm %q", query.Get("X-Amz-Algorithm"))
+	}
+	amzDate := query.Get("X-Amz-Date")
+	scope, err := f.checkCredential(query.Get("X-Amz-Credential"), amzDate)
+	if err != nil {
+		return err
+	}
+
+	signedAt, err := time.Parse(amzDateFormat, amzDate)
+	if err != nil {
+		return fmt.Errorf("invalid X-Amz-Date: %w", err)
+	}
+	expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+	if err != nil {
+		return fmt.Errorf("invalid X-Amz-Expires: %w", err)
+	}
+	if f.now().After(signedAt.Add(time.Duration(expires) * time.Second)) {
+		return fmt.Errorf("request has expired")
+	}
+
+	signedHeaders := strings.Split(query.Get("X-Amz-SignedHeaders"), ";")
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		r.URL.EscapedPath(),
+		fakeCanonicalQuery(query, "X-Amz-Signature"),
+		fakeCanonicalHeaders(r, signedHeaders),
+		query.Get("X-Amz-SignedHeaders"),
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	return checkSignature(signature, amzDate, scope, canonicalRequest)
+}
+
+// checkCredential проверяет ключ доступа и область подписи; возвращает область
+func (f *fakeS3) checkCredential(credential, amzDate string) (string, error) {
+	accessKey, scope, ok := strings.Cut(credential, "/")
+	if !ok || accessKey != fakeS3AccessKey {
+		return "", fmt.Errorf("unknown access key in credential %q", credential)
+	}
+	if len(amzDate) < 8 {
+		return "", fmt.Errorf("invalid X-Amz-Date %q", amzDate)
+	}
+	expected := amzDate[:8] + "/" + fakeS3Region + "/s3/aws4_request"
+	if scope != expected {
+		return "", fmt.Errorf("credential scope %q, expected %q", scope, expected)
+	}
+	return scope, nil
+}
+
+func checkSignature(signature, amzDate, scope, canonicalRequest string) error {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+
+	key := []byte("AWS4" + fakeS3SecretKey)
+	for _, part := range strings.Split(scope, "/") {
+		key = fakeHMAC(key, part)
+	}
+	expected := hex.EncodeToString(fakeHMAC(key, stringToSign))
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return fmt.Errorf("signature mismatch, canonical request:\n%s", canonicalRequest)
+	}
+	return nil
+}
+
+func fakeHMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// fakeCanonicalQuery кодирует параметры через url.QueryEscape с заменой '+' на %20, как требует AWS
+func fakeCanonicalQuery(query url.Values, skip string) string {
+	var parts []string
+	for key, values := range query {
+		if key == skip {
+			continue
+		}
+		for _, value := range values {
+			parts = append(parts, awsEscape(key)+"="+awsEscape(value))
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, "&")
+}
+
+func awsEscape(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+func fakeCanonicalHeaders(r *http.Request, names []string) string {
+	var canonical strings.Builder
+	for _, name := range names {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		canonical.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	return canonical.String()
+}
+
+func (o *fakeS3Object) etag() string {
+	sum := md5.Sum(o.data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func writeFakeS3Error(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<Error><Code>%s</Code><Message>%s</Message></Error>", code, message)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
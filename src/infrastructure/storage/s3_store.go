@@ -0,0 +1,309 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	appStorage "tax-priority-api/src/application/storage"
+)
+
+// maxPresignTTL максимальный срок действия подписанной ссылки в S3
+const maxPresignTTL = 7 * 24 * time.Hour
+
+// S3Config параметры подключения к S3 совместимому хранилищу
+type S3Config struct {
+	// Endpoint адрес API, например https://s3.eu-central-1.amazonaws.com или http://minio:9000
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle адресует бакет в пути (endpoint/bucket/key), а не в имени хоста;
+	// нужно для MinIO и других совместимых хранилищ
+	UsePathStyle bool
+	// HTTPClient клиент для запросов к хранилищу; по умолчанию http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// S3BlobStore хранит объекты в бакете S3 совместимого хранилища.
+// Работает поверх net/http, поэтому его можно проверять против любого сервера с S3 API, в том числе httptest.
+type S3BlobStore struct {
+	endpoint     *url.URL
+	bucket       string
+	usePathStyle bool
+	client       *http.Client
+	signer       *sigV4Signer
+	now          func() time.Time
+}
+
+// NewS3BlobStore создает хранилище по конфигурации
+func NewS3BlobStore(config S3Config) (*S3BlobStore, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("s3 bucket is required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, errors.New("s3 access key and secret key are required")
+	}
+
+	region := config.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = "https://s3." + region + ".amazonaws.com"
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", endpoint)
+	}
+
+	client := config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &S3BlobStore{
+		endpoint:     parsed,
+		bucket:       config.Bucket,
+		usePathStyle: config.UsePathStyle,
+		client:       client,
+		signer: &sigV4Signer{
+			accessKeyID:     config.AccessKeyID,
+			secretAccessKey: config.SecretAccessKey,
+			region:          region,
+		},
+		now: time.Now,
+	}, nil
+}
+
+func (s *S3BlobStore) Put(ctx context.Context, key string, content io.Reader, opts appStorage.PutOptions) (*appStorage.BlobInfo, error) {
+	// S3 требует длину тела заранее, поэтому содержимое сначала пишется во временный файл вместе с хешем
+	spool, size, payloadHash, err := spoolContent(content)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}()
+
+	req, err := s.newRequest(ctx, http.MethodPut, key, spool)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+	if size == 0 {
+		req.Body = http.NoBody
+	}
+	if opts.ContentType != "" {
+		req.Header.Set("Content-Type", opts.ContentType)
+	}
+
+	resp, err := s.do(req, payloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, key)
+	}
+
+	return &appStorage.BlobInfo{
+		Key:         key,
+		Size:        size,
+		ContentType: opts.ContentType,
+		ModTime:     s.now(),
+		ETag:        strings.Trim(resp.Header.Get("ETag"), `"`),
+	}, nil
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, *appStorage.BlobInfo, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, nil, responseError(resp, key)
+	}
+
+	return resp.Body, blobInfoFromHeaders(key, resp), nil
+}
+
+func (s *S3BlobStore) Stat(ctx context.Context, key string) (*appStorage.BlobInfo, error) {
+	req, err := s.newRequest(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp, key)
+	}
+
+	return blobInfoFromHeaders(key, resp), nil
+}
+
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// S3 отвечает 204 и для отсутствующих объектов, совместимые хранилища могут вернуть 404
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return responseError(resp, key)
+	}
+	return nil
+}
+
+func (s *S3BlobStore) SignedURL(ctx context.Context, key string, ttl time.Duration, opts appStorage.URLOptions) (string, error) {
+	if ttl <= 0 || ttl > maxPresignTTL {
+		return "", fmt.Errorf("signed url ttl must be between 1s and %s", maxPresignTTL)
+	}
+
+	target, err := s.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	if opts.ContentType != "" {
+		query.Set(queryResponseType, opts.ContentType)
+	}
+	if opts.ContentDisposition != "" {
+		query.Set(queryResponseDisposition, opts.ContentDisposition)
+	}
+	target.RawQuery = query.Encode()
+
+	return s.signer.presign(http.MethodGet, target, ttl, s.now()), nil
+}
+
+// objectURL возвращает адрес объекта с учетом способа адресации бакета
+func (s *S3BlobStore) objectURL(key string) (*url.URL, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return nil, fmt.Errorf("invalid blob key %q", key)
+	}
+
+	target := *s.endpoint
+	basePath := strings.TrimRight(target.Path, "/")
+	if s.usePathStyle {
+		target.Path = basePath + "/" + s.bucket + "/" + key
+	} else {
+		target.Host = s.bucket + "." + target.Host
+		target.Path = basePath + "/" + key
+	}
+	// Путь кодируется так же, как при подписи, иначе хранилище вычислит другую подпись
+	target.RawPath = uriEncode(target.Path, false)
+	target.RawQuery = ""
+	return &target, nil
+}
+
+func (s *S3BlobStore) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	target, err := s.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+	return http.NewRequestWithContext(ctx, method, target.String(), body)
+}
+
+func (s *S3BlobStore) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	s.signer.signRequest(req, payloadHash, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s request failed: %w", req.Method, err)
+	}
+	return resp, nil
+}
+
+// spoolContent копирует содержимое во временный файл и возвращает его размер и SHA-256
+func spoolContent(content io.Reader) (*os.File, int64, string, error) {
+	spool, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(spool, hash), content)
+	if err == nil {
+		_, err = spool.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		spool.Close()
+		os.Remove(spool.Name())
+		return nil, 0, "", err
+	}
+
+	return spool, size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func blobInfoFromHeaders(key string, resp *http.Response) *appStorage.BlobInfo {
+	info := &appStorage.BlobInfo{
+		Key:         key,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		ETag:        strings.Trim(resp.Header.Get("ETag"), `"`),
+	}
+	if size, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64); err == nil {
+		info.Size = size
+	}
+	if modTime, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.ModTime = modTime
+	}
+	return info
+}
+
+// s3Error тело ответа S3 с ошибкой
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// responseError преобразует ответ с ошибкой; отсутствие объекта возвращается как ErrBlobNotFound
+func responseError(resp *http.Response, key string) error {
+	var body s3Error
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+	_ = xml.Unmarshal(data, &body)
+
+	// На HEAD тело не приходит; отсутствие бакета - ошибка конфигурации, а не объекта
+	if resp.StatusCode == http.StatusNotFound && body.Code != "NoSuchBucket" {
+		return appStorage.ErrBlobNotFound
+	}
+
+	if body.Code == "" {
+		return fmt.Errorf("s3 %s %s: unexpected status %s", resp.Request.Method, key, resp.Status)
+	}
+	return fmt.Errorf("s3 %s %s: %s: %s", resp.Request.Method, key, body.Code, body.Message)
+}
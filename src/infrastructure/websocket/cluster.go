@@ -0,0 +1,125 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// HubMode режим работы хаба
+type HubMode string
+
+const (
+	// HubModeSingle события получают только клиенты этого экземпляра API
+	HubModeSingle HubMode = "single"
+	// HubModeCluster события пересылаются через Redis Pub/Sub клиентам всех экземпляров
+	HubModeCluster HubMode = "cluster"
+)
+
+// clusterPublishTimeout ограничение времени публикации сообщения в Redis
+const clusterPublishTimeout = 5 * time.Second
+
+// ClusterConfig настройки хаба
+type ClusterConfig struct {
+	Mode HubMode
+	// Channel канал Redis, общий для всех экземпляров API
+	Channel string
+	// NodeID идентификатор экземпляра; свои сообщения из канала не пересылаются повторно
+	NodeID string
+}
+
+// NewClusterConfig создает настройки хаба из окружения:
+// WS_HUB_MODE - single или cluster, WS_CLUSTER_CHANNEL - канал Redis, WS_NODE_ID - идентификатор экземпляра
+func NewClusterConfig() *ClusterConfig {
+	return &ClusterConfig{
+		Mode:    HubMode(config.GetEnv("WS_HUB_MODE", string(HubModeSingle))),
+		Channel: config.GetEnv("WS_CLUSTER_CHANNEL", "tax-priority:ws-events"),
+		NodeID:  config.GetEnv("WS_NODE_ID", defaultNodeID()),
+	}
+}
+
+// defaultNodeID идентификатор процесса: имя хоста и случайный суффикс, один на все хабы процесса
+var defaultNodeID = sync.OnceValue(func() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "node"
+	}
+	return host + "-" + uuid.New().String()[:8]
+})
+
+// clusterEnvelope сообщение между экземплярами API; заполнено одно из полей Event и Message
+type clusterEnvelope struct {
+	Origin  string        `json:"origin"`
+	Event   *EventMessage `json:"event,omitempty"`
+	Message *Message      `json:"message,omitempty"`
+}
+
+// cluster пересылает сообщения хаба другим экземплярам API через Redis Pub/Sub
+type cluster struct {
+	client  *redis.Client
+	channel string
+	nodeID  string
+}
+
+// publish отправляет сообщение другим экземплярам
+func (c *cluster) publish(envelope clusterEnvelope) error {
+	envelope.Origin = c.nodeID
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode cluster message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), clusterPublishTimeout)
+	defer cancel()
+	if err := c.client.Publish(ctx, c.channel, data).Err(); err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", c.channel, err)
+	}
+	return nil
+}
+
+// listen рассылает локальным клиентам сообщения других экземпляров до отмены ctx.
+// Переподключение к Redis выполняет go-redis; сообщения, опубликованные во время разрыва, теряются.
+func (c *cluster) listen(ctx context.Context, hub *Hub) {
+	pubsub := c.client.Subscribe(ctx, c.channel)
+	defer pubsub.Close()
+
+	log.Printf("WebSocket hub %s joined cluster channel %s", c.nodeID, c.channel)
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var envelope clusterEnvelope
+			if err := json.Unmarshal([]byte(msg.Payload), &envelope); err != nil {
+				log.Printf("Invalid cluster message: %v", err)
+				continue
+			}
+			// Свои сообщения уже отправлены локальным клиентам
+			if envelope.Origin == c.nodeID {
+				continue
+			}
+
+			if envelope.Event != nil {
+				hub.publishEventLocal(*envelope.Event)
+			}
+			if envelope.Message != nil {
+				hub.broadcastLocal(*envelope.Message)
+			}
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/redis/go-redis/v9"
 )
 
 // Client представляет WebSocket клиента
@@ -28,10 +29,14 @@ type Hub struct {
 	unregister chan *Client
 	broadcast  chan []byte
 	mu         sync.RWMutex
+	// cluster пересылка сообщений другим экземплярам API, nil в режиме single
+	cluster *cluster
 }
 
 // Message представляет сообщение WebSocket
 type Message struct {
+	// ID события; при повторной доставке клиент получает тот же ID и может отбросить дубликат
+	ID        string      `json:"id,omitempty"`
 	Type      string      `json:"type"`
 	Event     string      `json:"event,omitempty"`
 	Data      interface{} `json:"data,omitempty"`
@@ -46,6 +51,8 @@ type SubscriptionMessage struct {
 
 // EventMessage сообщение о событии
 type EventMessage struct {
+	// ID события; при повторной доставке клиент получает тот же ID и может отбросить дубликат
+	ID        string      `json:"id,omitempty"`
 	Type      string      `json:"type"`
 	Event     string      `json:"event"`
 	Entity    string      `json:"entity"`
@@ -64,7 +71,7 @@ var upgrader = websocket.Upgrader{
 	WriteBufferSize: 1024,
 }
 
-// NewHub создает новый WebSocket хаб
+// NewHub создает новый WebSocket хаб, работающий в пределах одного экземпляра API
 func NewHub() *Hub {
 	return &Hub{
 		clients:    make(map[string]*Client),
@@ -74,8 +81,49 @@ func NewHub() *Hub {
 	}
 }
 
-// Run запускает хаб
+// NewClusteredHub создает хаб, который пересылает события через канал Redis клиентам всех экземпляров API
+func NewClusteredHub(client *redis.Client, channel, nodeID string) *Hub {
+	hub := NewHub()
+	hub.cluster = &cluster{
+		client:  client,
+		channel: channel,
+		nodeID:  nodeID,
+	}
+	return hub
+}
+
+// NewHubFromConfig создает хаб в режиме из настроек
+func NewHubFromConfig(config *ClusterConfig, client *redis.Client) *Hub {
+	switch config.Mode {
+	case HubModeCluster:
+		return NewClusteredHub(client, config.Channel, config.NodeID)
+	case HubModeSingle, "":
+		return NewHub()
+	default:
+		log.Printf("Unknown WS_HUB_MODE %q, running single-node hub", config.Mode)
+		return NewHub()
+	}
+}
+
+// IsClustered проверяет, пересылает ли хаб события другим экземплярам API
+func (h *Hub) IsClustered() bool {
+	return h.cluster != nil
+}
+
+// NodeID возвращает идентификатор экземпляра в кластере или пустую строку в режиме single
+func (h *Hub) NodeID() string {
+	if h.cluster == nil {
+		return ""
+	}
+	return h.cluster.nodeID
+}
+
+// Run запускает хаб; в режиме cluster также принимает сообщения других экземпляров
 func (h *Hub) Run(ctx context.Context) {
+	if h.cluster != nil {
+		go h.cluster.listen(ctx, h)
+	}
+
 	for {
 		select {
 		case client := <-h.register:
@@ -144,8 +192,18 @@ func (h *Hub) ServeWS(w http.ResponseWriter, r *http.Request, clientID string) {
 	go client.readPump()
 }
 
-// BroadcastToAll отправляет сообщение всем подключенным клиентам
-func (h *Hub) BroadcastToAll(message Message) {
+// BroadcastToAll отправляет сообщение всем подключенным клиентам, в режиме cluster - клиентам всех экземпляров.
+// Локальные клиенты получают сообщение и при ошибке пересылки в кластер.
+func (h *Hub) BroadcastToAll(message Message) error {
+	h.broadcastLocal(message)
+	if h.cluster != nil {
+		return h.cluster.publish(clusterEnvelope{Message: &message})
+	}
+	return nil
+}
+
+// broadcastLocal отправляет сообщение клиентам этого экземпляра
+func (h *Hub) broadcastLocal(message Message) {
 	data, err := json.Marshal(message)
 	if err != nil {
 		log.Printf("Error marshaling broadcast message: %v", err)
@@ -180,10 +238,9 @@ func (h *Hub) BroadcastToSubscribers(subscription string, message Message) {
 	}
 }
 
-// BroadcastEvent отправляет событие подписчикам
-func (h *Hub) BroadcastEvent(entity, action, entityID string, data interface{}) {
-	subscription := entity // Подписка по типу сущности
-	eventMessage := EventMessage{
+// NewEventMessage создает сообщение о событии сущности
+func NewEventMessage(entity, action, entityID string, data interface{}) EventMessage {
+	return EventMessage{
 		Type:      "event",
 		Event:     entity + "." + action,
 		Entity:    entity,
@@ -192,18 +249,37 @@ func (h *Hub) BroadcastEvent(entity, action, entityID string, data interface{})
 		Data:      data,
 		Timestamp: time.Now(),
 	}
+}
+
+// BroadcastEvent отправляет событие подписчикам
+func (h *Hub) BroadcastEvent(entity, action, entityID string, data interface{}) error {
+	return h.PublishEvent(NewEventMessage(entity, action, entityID, data))
+}
+
+// PublishEvent отправляет событие подписчикам типа сущности и подписчикам конкретной сущности,
+// в режиме cluster - подписчикам всех экземпляров. Локальные подписчики получают событие
+// и при ошибке пересылки в кластер.
+func (h *Hub) PublishEvent(eventMessage EventMessage) error {
+	h.publishEventLocal(eventMessage)
+	if h.cluster != nil {
+		return h.cluster.publish(clusterEnvelope{Event: &eventMessage})
+	}
+	return nil
+}
 
-	if entityID != "" {
+// publishEventLocal отправляет событие подписчикам этого экземпляра
+func (h *Hub) publishEventLocal(eventMessage EventMessage) {
+	if eventMessage.EntityID != "" {
 		// Также отправляем подписчикам конкретной сущности
-		specificSubscription := entity + ":" + entityID
+		specificSubscription := eventMessage.Entity + ":" + eventMessage.EntityID
 		h.BroadcastEventMessage(specificSubscription, eventMessage)
 	}
 
 	// Отправляем общим подписчикам типа сущности
-	h.BroadcastEventMessage(subscription, eventMessage)
+	h.BroadcastEventMessage(eventMessage.Entity, eventMessage)
 }
 
-// BroadcastEventMessage отправляет событие подписчикам
+// BroadcastEventMessage отправляет событие подписчикам subscription на этом экземпляре
 func (h *Hub) BroadcastEventMessage(subscription string, eventMessage EventMessage) {
 	data, err := json.Marshal(eventMessage)
 	if err != nil {
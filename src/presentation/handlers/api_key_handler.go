@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"tax-priority-api/src/application/apikeys/commands"
+	"tax-priority-api/src/application/apikeys/dtos"
+	"tax-priority-api/src/application/apikeys/handlers"
+	"tax-priority-api/src/application/apikeys/queries"
+	"tax-priority-api/src/presentation/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHTTPHandler HTTP обработчик управления API ключами
+type APIKeyHTTPHandler struct {
+	commandHandlers *handlers.APIKeyCommandHandlers
+	queryHandlers   *handlers.APIKeyQueryHandlers
+}
+
+// NewAPIKeyHTTPHandler создает новый HTTP обработчик API ключей
+func NewAPIKeyHTTPHandler(commandHandlers *handlers.APIKeyCommandHandlers, queryHandlers *handlers.APIKeyQueryHandlers) *APIKeyHTTPHandler {
+	return &APIKeyHTTPHandler{
+		commandHandlers: commandHandlers,
+		queryHandlers:   queryHandlers,
+	}
+}
+
+// CreateAPIKey выпускает API ключ
+// @Summary Выпустить API ключ
+// @Description Выпускает ключ для серверного клиента (импорт из CMS, SSR сайта). Клиент передает ключ в заголовке X-API-Key.
+// @Description Полный ключ возвращается только в ответе на этот запрос, в базе хранится его хеш.
+// @Description Допустимые scopes: api:read, api:write.
+// @Tags API Keys
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode
+// @Param key body models.CreateAPIKeyRequest true "Имя, scopes и срок действия ключа"
+// @Success 201 {object} models.CreateAPIKeyResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/admin/api-keys [post]
+func (h *APIKeyHTTPHandler) CreateAPIKey(c *gin.Context) {
+	var req models.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.commandHandlers.Create.HandleCreateAPIKey(c.Request.Context(), req.ToCreateAPIKeyCommand())
+	if err != nil {
+		c.JSON(commandErrorStatus(err), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// GetAPIKeys получает список API ключей
+// @Summary Получить список API ключей
+// @Description Возвращает ключи без секретов, новые первыми; по умолчанию без отозванных
+// @Tags API Keys
+// @Produce json
+// @Security OAuth2AccessCode
+// @Param _limit query int false "Лимит записей" default(50)
+// @Param _offset query int false "Смещение" default(0)
+// @Param includeRevoked query bool false "Включить отозванные ключи" default(false)
+// @Success 200 {object} models.PaginatedAPIKeyResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/admin/api-keys [get]
+func (h *APIKeyHTTPHandler) GetAPIKeys(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("_limit", "50"))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit parameter"})
+		return
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("_offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offset parameter"})
+		return
+	}
+
+	includeRevoked, err := strconv.ParseBool(c.DefaultQuery("includeRevoked", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid includeRevoked parameter, must be true or false"})
+		return
+	}
+
+	query := queries.GetAPIKeysQuery{
+		Limit:          limit,
+		Offset:         offset,
+		IncludeRevoked: includeRevoked,
+	}
+	result, err := h.queryHandlers.GetMany.HandleGetAPIKeys(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToPaginatedAPIKeyResponse(result.Paginated))
+}
+
+// RevokeAPIKey отзывает API ключ
+// @Summary Отозвать API ключ
+// @Description Ключ перестает приниматься сразу; запись остается в списке с includeRevoked=true
+// @Tags API Keys
+// @Produce json
+// @Security OAuth2AccessCode
+// @Param id path string true "ID ключа"
+// @Success 200 {object} models.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/admin/api-keys/{id} [delete]
+func (h *APIKeyHTTPHandler) RevokeAPIKey(c *gin.Context) {
+	cmd := commands.RevokeAPIKeyCommand{ID: c.Param("id")}
+	result, err := h.commandHandlers.Revoke.HandleRevokeAPIKey(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RegisterAPIKeyRoutes регистрирует маршруты управления API ключами
+func RegisterAPIKeyRoutes(r *gin.Engine, handler *APIKeyHTTPHandler) {
+	keys := r.Group("/api/admin/api-keys")
+	{
+		keys.POST("", handler.CreateAPIKey)
+		keys.GET("", handler.GetAPIKeys)
+		keys.DELETE("/:id", handler.RevokeAPIKey)
+	}
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"tax-priority-api/src/application/audit/dtos"
+	"tax-priority-api/src/application/audit/handlers"
+	"tax-priority-api/src/application/audit/queries"
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/presentation/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// auditCSVHeader колонки выгрузки журнала аудита
+var auditCSVHeader = []string{"id", "created_at", "actor", "action", "entity_type", "entity_id", "request_id", "client_ip", "before", "after"}
+
+// AuditHTTPHandler HTTP обработчик журнала аудита
+type AuditHTTPHandler struct {
+	queryHandlers *handlers.AuditQueryHandlers
+}
+
+// NewAuditHTTPHandler создает новый HTTP обработчик журнала аудита
+func NewAuditHTTPHandler(queryHandlers *handlers.AuditQueryHandlers) *AuditHTTPHandler {
+	return &AuditHTTPHandler{
+		queryHandlers: queryHandlers,
+	}
+}
+
+// GetAuditRecords получает записи журнала аудита
+// @Summary Получить журнал аудита
+// @Description Возвращает изменения FAQ и отзывов, новые первыми: автор, действие, снимки до и после, ID запроса и IP клиента.
+// @Description Период задается в формате RFC3339 и включает from, но не включает to.
+// @Tags Audit
+// @Produce json
+// @Security OAuth2AccessCode
+// @Param _limit query int false "Лимит записей" default(50)
+// @Param _offset query int false "Смещение" default(0)
+// @Param actor query string false "Автор изменения"
+// @Param action query string false "Действие" Enums(created, updated, activated, deactivated, deleted, restored, approved, review_started, rejected, archived, email_verified, file_uploaded, file_removed, trash_purged)
+// @Param entityType query string false "Тип сущности" Enums(faq, testimonial, feature)
+// @Param entityId query string false "ID сущности"
+// @Param requestId query string false "ID запроса (заголовок X-Request-ID)"
+// @Param from query string false "Начало периода, RFC3339" example(2023-12-01T00:00:00Z)
+// @Param to query string false "Конец периода, RFC3339" example(2024-01-01T00:00:00Z)
+// @Success 200 {object} models.PaginatedAuditRecordResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/audit [get]
+func (h *AuditHTTPHandler) GetAuditRecords(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("_limit", "50"))
+	if err != nil || limit < 0 || limit > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit parameter, must be between 0 and 100"})
+		return
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("_offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offset parameter"})
+		return
+	}
+
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	query := queries.GetAuditRecordsQuery{
+		AuditFilter: filter,
+		Limit:       limit,
+		Offset:      offset,
+	}
+	result, err := h.queryHandlers.GetMany.HandleGetAuditRecords(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToPaginatedAuditRecordResponse(result.Paginated))
+}
+
+// ExportAuditRecords выгружает журнал аудита в CSV
+// @Summary Выгрузить журнал аудита в CSV
+// @Description Выгружает все записи по фильтрам в хронологическом порядке. Снимки before и after передаются JSON строками.
+// @Description Фильтры те же, что у GET /api/audit.
+// @Tags Audit
+// @Produce text/csv
+// @Security OAuth2AccessCode
+// @Param actor query string false "Автор изменения"
+// @Param action query string false "Действие"
+// @Param entityType query string false "Тип сущности" Enums(faq, testimonial, feature)
+// @Param entityId query string false "ID сущности"
+// @Param requestId query string false "ID запроса (заголовок X-Request-ID)"
+// @Param from query string false "Начало периода, RFC3339"
+// @Param to query string false "Конец периода, RFC3339"
+// @Success 200 {file} file "CSV файл"
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/audit/export [get]
+func (h *AuditHTTPHandler) ExportAuditRecords(c *gin.Context) {
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	fileName := fmt.Sprintf("audit-%s.csv", time.Now().UTC().Format("20060102-150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	c.Status(http.StatusOK)
+
+	// Ответ уже начат, поэтому ошибка чтения журнала только обрывает выгрузку
+	writer := csv.NewWriter(c.Writer)
+	if err := writer.Write(auditCSVHeader); err != nil {
+		log.Printf("Failed to write audit export: %v", err)
+		return
+	}
+
+	query := queries.ExportAuditRecordsQuery{AuditFilter: filter}
+	err = h.queryHandlers.Export.HandleExportAuditRecords(c.Request.Context(), query, func(record *entities.AuditRecord) error {
+		return writer.Write(auditCSVRow(record))
+	})
+	writer.Flush()
+	if err == nil {
+		err = writer.Error()
+	}
+	if err != nil {
+		log.Printf("Failed to export audit records: %v", err)
+	}
+}
+
+// parseAuditFilter читает фильтры журнала аудита из query параметров
+func parseAuditFilter(c *gin.Context) (queries.AuditFilter, error) {
+	var query models.AuditFilterQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		return queries.AuditFilter{}, err
+	}
+	return query.ToAuditFilter()
+}
+
+// auditCSVRow преобразует запись журнала в строку CSV в порядке auditCSVHeader
+func auditCSVRow(record *entities.AuditRecord) []string {
+	return []string{
+		record.ID,
+		record.CreatedAt.UTC().Format(time.RFC3339Nano),
+		record.Actor,
+		string(record.Action),
+		string(record.EntityType),
+		record.EntityID,
+		record.RequestID,
+		record.ClientIP,
+		string(record.Before),
+		string(record.After),
+	}
+}
+
+// RegisterAuditRoutes регистрирует маршруты журнала аудита
+func RegisterAuditRoutes(r *gin.Engine, handler *AuditHTTPHandler) {
+	audit := r.Group("/api/audit")
+	{
+		audit.GET("", handler.GetAuditRecords)
+		audit.GET("/export", handler.ExportAuditRecords)
+	}
+}
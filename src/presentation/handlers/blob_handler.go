@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"tax-priority-api/src/application/storage"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BlobHTTPHandler отдает файлы по подписанным ссылкам хранилищ, которые не умеют отдавать их сами
+type BlobHTTPHandler struct {
+	blobStore storage.BlobStore
+}
+
+func NewBlobHTTPHandler(blobStore storage.BlobStore) *BlobHTTPHandler {
+	return &BlobHTTPHandler{blobStore: blobStore}
+}
+
+// GetSignedBlob отдает файл по подписанной ссылке
+// @Summary Скачать файл по подписанной ссылке
+// @Description Отдает файл локального хранилища по ссылке, выданной API. Ссылка действует ограниченное время,
+// @Description параметры ссылки защищены подписью. Поддерживает Range запросы.
+// @Tags files
+// @Produce application/octet-stream
+// @Param key path string true "Ключ файла"
+// @Param X-Expires query int true "Срок действия ссылки (unix time)"
+// @Param X-Signature query string true "Подпись ссылки"
+// @Success 200 {file} file
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /files/{key} [get]
+func (h *BlobHTTPHandler) GetSignedBlob(c *gin.Context) {
+	verifier, ok := h.blobStore.(storage.URLVerifier)
+	if !ok {
+		// Внешнее хранилище выдает ссылки на себя, приложение их не обслуживает
+		c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+		return
+	}
+
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	opts, err := verifier.VerifyURL(key, c.Request.URL.Query())
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	content, info, err := h.blobStore.Get(c.Request.Context(), key)
+	if errors.Is(err, storage.ErrBlobNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer content.Close()
+
+	contentType := opts.ContentType
+	if contentType == "" {
+		contentType = info.ContentType
+	}
+	serveBlob(c, content, key, contentType, opts.ContentDisposition, info.Size, info.ModTime)
+}
+
+// serveBlob отдает содержимое файла; при поддержке io.Seeker обрабатываются Range и условные запросы
+func serveBlob(c *gin.Context, content io.Reader, name, contentType, disposition string, size int64, modTime time.Time) {
+	if contentType != "" {
+		c.Header("Content-Type", contentType)
+	}
+	if disposition != "" {
+		c.Header("Content-Disposition", disposition)
+	}
+	c.Header("X-Content-Type-Options", "nosniff")
+
+	if seeker, ok := content.(io.ReadSeeker); ok {
+		http.ServeContent(c.Writer, c.Request, name, modTime, seeker)
+		return
+	}
+
+	if !modTime.IsZero() {
+		c.Header("Last-Modified", modTime.UTC().Format(http.TimeFormat))
+	}
+	if size >= 0 {
+		c.Header("Content-Length", strconv.FormatInt(size, 10))
+	}
+	c.Status(http.StatusOK)
+	if c.Request.Method != http.MethodHead {
+		_, _ = io.Copy(c.Writer, content)
+	}
+}
+
+// RegisterBlobRoutes регистрирует маршруты подписанных ссылок на файлы
+func RegisterBlobRoutes(router *gin.Engine, handler *BlobHTTPHandler) {
+	router.GET("/files/*key", handler.GetSignedBlob)
+}
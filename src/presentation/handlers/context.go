@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"tax-priority-api/src/presentation/middlewares"
+	"tax-priority-api/src/presentation/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// canReadUnpublished проверяет, может ли клиент видеть неактивные FAQ и преимущества и отзывы на модерации.
+// Остальным публичные маршруты отдают только опубликованные записи.
+func canReadUnpublished(c *gin.Context) bool {
+	return middlewares.Allowed(c, middlewares.ReaderPolicy)
+}
+
+// canAddTrustedTestimonials проверяет, может ли клиент добавлять отзывы без проверки на спам
+// и подтверждения email: такие права есть у модераторов и редакторов контента
+func canAddTrustedTestimonials(c *gin.Context) bool {
+	return middlewares.Allowed(c, middlewares.ModeratorPolicy) || middlewares.Allowed(c, middlewares.ContentEditorPolicy)
+}
+
+// listFilterFields выбирает белый список полей фильтрации по правам клиента
+func listFilterFields(c *gin.Context, public, privileged models.FilterFields) models.FilterFields {
+	if canReadUnpublished(c) {
+		return privileged
+	}
+	return public
+}
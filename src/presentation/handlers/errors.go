@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/infrastructure/persistence"
+)
+
+// repositoryErrorStatus возвращает HTTP статус для ошибки репозитория или fallback
+func repositoryErrorStatus(err error, fallback int) int {
+	var repoErr *persistence.RepositoryError
+	if !errors.As(err, &repoErr) {
+		return fallback
+	}
+
+	switch repoErr.Code {
+	case persistence.ErrCodeInvalidInput:
+		return http.StatusBadRequest
+	case persistence.ErrCodeNotFound:
+		return http.StatusNotFound
+	case persistence.ErrCodeAlreadyExists:
+		return http.StatusConflict
+	case persistence.ErrCodeConflict:
+		return http.StatusPreconditionFailed
+	}
+
+	return fallback
+}
+
+// commandErrorStatus возвращает HTTP статус для ошибки команды:
+// ошибки репозитория отображаются как в repositoryErrorStatus, остальные - ошибки валидации сущности
+func commandErrorStatus(err error) int {
+	if errors.Is(err, entities.ErrVersionConflict) {
+		return http.StatusPreconditionFailed
+	}
+
+	var repoErr *persistence.RepositoryError
+	if errors.As(err, &repoErr) {
+		return repositoryErrorStatus(err, http.StatusInternalServerError)
+	}
+	return http.StatusBadRequest
+}
+
+// versionErrorStatus возвращает 412 для конфликта версий, иначе fallback
+func versionErrorStatus(err error, fallback int) int {
+	if errors.Is(err, entities.ErrVersionConflict) {
+		return http.StatusPreconditionFailed
+	}
+	return fallback
+}
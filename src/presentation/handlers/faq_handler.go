@@ -1,39 +1,56 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	appCache "tax-priority-api/src/application/cache"
 	"tax-priority-api/src/application/faq/commands"
 	"tax-priority-api/src/application/faq/dtos"
 	"tax-priority-api/src/application/faq/handlers"
 	"tax-priority-api/src/application/faq/queries"
+	appModels "tax-priority-api/src/application/models"
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/presentation/middlewares"
 	"tax-priority-api/src/presentation/models"
 
 	"github.com/gin-gonic/gin"
 )
 
+var (
+	// faqPublicFilters поля FAQ, доступные для фильтрации всем
+	faqPublicFilters = models.NewFilterFields("question", "answer", "category", "priority", "createdAt", "updatedAt")
+	// faqFilters поля FAQ для клиентов с правами api:read
+	faqFilters = faqPublicFilters.With("isActive", "createdBy", "updatedBy")
+)
+
 // FAQHTTPHandler HTTP обработчик для FAQ
 type FAQHTTPHandler struct {
 	commandHandlers *handlers.FAQCommandHandlers
 	queryHandlers   *handlers.FAQQueryHandlers
+	conditionalGET  *middlewares.ConditionalGET
 }
 
 // NewFAQHTTPHandler создает новый HTTP обработчик FAQ
-func NewFAQHTTPHandler(commandHandlers *handlers.FAQCommandHandlers, queryHandlers *handlers.FAQQueryHandlers) *FAQHTTPHandler {
+func NewFAQHTTPHandler(commandHandlers *handlers.FAQCommandHandlers, queryHandlers *handlers.FAQQueryHandlers, conditionalGET *middlewares.ConditionalGET) *FAQHTTPHandler {
 	return &FAQHTTPHandler{
 		commandHandlers: commandHandlers,
 		queryHandlers:   queryHandlers,
+		conditionalGET:  conditionalGET,
 	}
 }
 
 // GetFAQ получает FAQ по ID
 // @Summary Получить FAQ по ID
-// @Description Возвращает FAQ по указанному ID
+// @Description Возвращает FAQ по указанному ID; неактивный FAQ доступен только с правами api:read
 // @Tags FAQ
 // @Produce json
 // @Param id path string true "ID FAQ"
 // @Success 200 {object} models.FAQResponse
+// @Header 200 {string} ETag "Версия FAQ для If-Match"
 // @Failure 404 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/faqs/{id} [get]
@@ -56,22 +73,44 @@ func (h *FAQHTTPHandler) GetFAQ(c *gin.Context) {
 		return
 	}
 
+	// Неактивный FAQ для анонимного клиента не существует
+	if !result.FAQ.IsActive && !canReadUnpublished(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "FAQ not found"})
+		return
+	}
+
+	setVersionETag(c, result.FAQ.Version)
 	c.JSON(http.StatusOK, dtos.ToFAQResponse(result.FAQ))
 }
 
 // GetFAQs получает список FAQ
 // @Summary Получить список FAQ
-// @Description Возвращает список FAQ с пагинацией и фильтрацией
+// @Description Возвращает список FAQ с пагинацией и фильтрацией. При передаче q выполняется полнотекстовый поиск,
+// @Description результаты упорядочены по релевантности и содержат rank и highlights.
+// @Description Дополнительные условия задаются как field[op]=value (AND) и _or[n][field][op]=value (OR между подгруппами n),
+// @Description операторы: eq, ne, gt, gte, lt, lte, in, nin, like, ilike, between, null; для in/nin/between значения через запятую.
+// @Description Пример: priority[gte]=50&createdAt[between]=2024-01-01,2024-12-31&_or[0][category][eq]=налоги&_or[1][question][ilike]=вычет
+// @Description Без прав api:read доступны поля question, answer, category, priority, createdAt, updatedAt; прочие поля - ошибка 400.
+// @Description Поддерживает условные запросы: при неизменных данных ответ 304 без тела.
 // @Tags FAQ
 // @Produce json
 // @Security OAuth2AccessCode
+// @Security ApiKeyAuth
+// @Param q query string false "Поисковый запрос (минимум 3 символа)"
+// @Param _cursor query string false "Курсор keyset-пагинации; пустое значение - первая страница; _sort только createdAt, updatedAt, priority, category или question. Ответ: items, nextCursor, prevCursor, hasNext, hasPrev, limit"
 // @Param _limit query int false "Лимит записей" default(10)
 // @Param _offset query int false "Смещение" default(0)
 // @Param _sort query string false "Поле сортировки" default(createdAt)
 // @Param _order query string false "Порядок сортировки" Enums(asc,desc) default(desc)
 // @Param category query string false "Фильтр по категории"
-// @Param isActive query bool false "Фильтр по активности" default(true)
+// @Param isActive query bool false "Фильтр по активности; без прав api:read всегда true" default(true)
+// @Param If-None-Match header string false "ETag из предыдущего ответа"
+// @Param If-Modified-Since header string false "Last-Modified из предыдущего ответа"
 // @Success 200 {object} models.PaginatedFAQResponse
+// @Header 200 {string} ETag "Версия списка"
+// @Header 200 {string} Last-Modified "Время последнего изменения FAQ"
+// @Header 200 {string} Cache-Control "public, max-age=HTTP_CACHE_FAQ_MAX_AGE для анонимных клиентов"
+// @Success 304 "Список не изменился"
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/faqs [get]
@@ -110,6 +149,46 @@ func (h *FAQHTTPHandler) GetFAQs(c *gin.Context) {
 		}
 		isActive = &isActiveVal
 	}
+	// Без прав на чтение неопубликованного фильтр всегда только по активным;
+	// условия where объединяются с ним через AND и не могут его обойти
+	if !canReadUnpublished(c) {
+		active := true
+		isActive = &active
+	}
+
+	where, err := models.ParseFilterQuery(c.Request.URL.Query(), listFilterFields(c, faqPublicFilters, faqFilters))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cursor, useCursor := c.GetQuery("_cursor")
+
+	if text := strings.TrimSpace(c.Query("q")); text != "" {
+		if useCursor {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Cursor pagination is not supported for search"})
+			return
+		}
+
+		if utf8.RuneCountInString(text) < 3 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Search query must be at least 3 characters"})
+			return
+		}
+
+		// При поиске сортировка по релевантности, _sort применяется только если передан явно
+		searchReq := models.SearchFAQsQuery{
+			Query:     text,
+			Category:  category,
+			Limit:     limit,
+			Offset:    offset,
+			SortBy:    c.Query("_sort"),
+			SortOrder: c.Query("_order"),
+			IsActive:  isActive,
+			Where:     where,
+		}
+		h.searchFAQs(c, searchReq)
+		return
+	}
 
 	req := models.GetFAQsQuery{
 		Limit:     limit,
@@ -118,12 +197,16 @@ func (h *FAQHTTPHandler) GetFAQs(c *gin.Context) {
 		SortOrder: sortOrder,
 		Category:  category,
 		IsActive:  isActive,
+		Where:     where,
+	}
+	if useCursor {
+		req.Cursor = &cursor
 	}
 
 	query := req.ToGetFAQsQuery()
 	result, err := h.queryHandlers.GetMany.HandleGetFAQs(c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -132,16 +215,45 @@ func (h *FAQHTTPHandler) GetFAQs(c *gin.Context) {
 		return
 	}
 
+	if result.CursorPaginated != nil {
+		c.JSON(http.StatusOK, dtos.ToCursorPaginatedFAQResponse(result.CursorPaginated))
+		return
+	}
+
 	c.JSON(http.StatusOK, dtos.ToPaginatedFAQResponse(result.Paginated))
 }
 
+// searchFAQs выполняет полнотекстовый поиск для GetFAQs
+func (h *FAQHTTPHandler) searchFAQs(c *gin.Context, req models.SearchFAQsQuery) {
+	query := req.ToSearchFAQsQuery()
+	result, err := h.queryHandlers.Search.HandleSearchFAQs(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	if !result.Success {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToPaginatedFAQSearchResponse(result.SearchResults))
+}
+
 // GetCategories получает список категорий FAQ
 // @Summary Получить список категорий FAQ
-// @Description Возвращает список уникальных категорий FAQ с опциональными счетчиками
+// @Description Возвращает список уникальных категорий FAQ с опциональными счетчиками.
+// @Description Поддерживает условные запросы: при неизменных данных ответ 304 без тела.
 // @Tags FAQ
 // @Produce json
 // @Param withCounts query bool false "Включить количество FAQ в каждой категории" default(false)
+// @Param If-None-Match header string false "ETag из предыдущего ответа"
+// @Param If-Modified-Since header string false "Last-Modified из предыдущего ответа"
 // @Success 200 {object} object{categories=[]string,categoryCounts=map[string]int64,success=bool,message=string,timestamp=string}
+// @Header 200 {string} ETag "Версия списка категорий"
+// @Header 200 {string} Last-Modified "Время последнего изменения FAQ"
+// @Header 200 {string} Cache-Control "public, max-age=HTTP_CACHE_FAQ_CATEGORIES_MAX_AGE для анонимных клиентов"
+// @Success 304 "Категории не изменились"
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/faqs/categories [get]
@@ -188,12 +300,20 @@ func (h *FAQHTTPHandler) GetCategories(c *gin.Context) {
 // @Tags FAQ
 // @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID FAQ"
+// @Param If-Match header string true "ETag из GET /api/faqs/{id}; * - без проверки версии"
 // @Param faq body models.UpdateFAQRequest true "Данные для обновления"
 // @Success 200 {object} models.CommandResult
+// @Header 200 {string} ETag "Новая версия FAQ"
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 404 {object} models.ErrorResponse
+// @Failure 412 {object} models.ErrorResponse "FAQ изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /api/faqs/{id} [put]
 func (h *FAQHTTPHandler) UpdateFAQ(c *gin.Context) {
 	id := c.Param("id")
@@ -202,6 +322,11 @@ func (h *FAQHTTPHandler) UpdateFAQ(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
 	var req models.UpdateFAQRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -209,9 +334,10 @@ func (h *FAQHTTPHandler) UpdateFAQ(c *gin.Context) {
 	}
 
 	cmd := req.ToUpdateFAQCommand(id)
+	cmd.ExpectedVersion = expectedVersion
 	result, err := h.commandHandlers.Update.HandleUpdateFAQ(c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(versionErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -220,18 +346,24 @@ func (h *FAQHTTPHandler) UpdateFAQ(c *gin.Context) {
 		return
 	}
 
+	setVersionETag(c, result.Version)
 	c.JSON(http.StatusOK, result)
 }
 
 // DeleteFAQ удаляет FAQ
 // @Summary Удалить FAQ
-// @Description Удаляет FAQ по ID
+// @Description Перемещает FAQ в корзину. Его можно восстановить через POST /api/faqs/{id}/restore,
+// @Description пока он не удален окончательно по истечении срока хранения (TRASH_RETENTION_DAYS).
 // @Tags FAQ
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID FAQ"
 // @Success 200 {object} models.CommandResult
 // @Failure 404 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /api/faqs/{id} [delete]
 func (h *FAQHTTPHandler) DeleteFAQ(c *gin.Context) {
 	id := c.Param("id")
@@ -255,19 +387,99 @@ func (h *FAQHTTPHandler) DeleteFAQ(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// GetFAQTrash получает список FAQ в корзине
+// @Summary Получить корзину FAQ
+// @Description Возвращает удаленные FAQ с временем удаления deletedAt, по умолчанию недавно удаленные первыми
+// @Tags FAQ
+// @Produce json
+// @Security OAuth2AccessCode[api:read]
+// @Security ApiKeyAuth
+// @Param _limit query int false "Лимит записей" default(10)
+// @Param _offset query int false "Смещение" default(0)
+// @Param _sort query string false "Поле сортировки" default(deletedAt)
+// @Param _order query string false "Порядок сортировки" Enums(asc,desc) default(desc)
+// @Success 200 {object} models.PaginatedFAQResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/faqs/trash [get]
+func (h *FAQHTTPHandler) GetFAQTrash(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("_limit", "10"))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit parameter"})
+		return
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("_offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offset parameter"})
+		return
+	}
+
+	query := queries.GetFAQTrashQuery{
+		Limit:     limit,
+		Offset:    offset,
+		SortBy:    c.DefaultQuery("_sort", "deletedAt"),
+		SortOrder: c.DefaultQuery("_order", "desc"),
+	}
+	result, err := h.queryHandlers.Trash.HandleGetFAQTrash(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToPaginatedFAQResponse(result.Paginated))
+}
+
+// RestoreFAQ восстанавливает FAQ из корзины
+// @Summary Восстановить FAQ из корзины
+// @Description Возвращает удаленный FAQ в общий список
+// @Tags FAQ
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID FAQ"
+// @Success 200 {object} models.CommandResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/faqs/{id}/restore [post]
+func (h *FAQHTTPHandler) RestoreFAQ(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	cmd := commands.RestoreFAQCommand{ID: id}
+	result, err := h.commandHandlers.Restore.HandleRestoreFAQ(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // GetFAQCount получает количество FAQ
 // @Summary Получить количество FAQ
 // @Description Возвращает общее количество FAQ
 // @Tags FAQ
 // @Produce json
 // @Param category query string false "Фильтр по категории"
-// @Param isActive query bool false "Фильтр по активности"
+// @Param isActive query bool false "Фильтр по активности; без прав api:read всегда true"
 // @Success 200 {object} models.CountResponse
 // @Failure 500 {object} models.ErrorResponse
 // @Router /api/faqs/count [get]
 func (h *FAQHTTPHandler) GetFAQCount(c *gin.Context) {
 	category := c.Query("category")
 	isActive, _ := strconv.ParseBool(c.Query("isActive"))
+	if !canReadUnpublished(c) {
+		isActive = true
+	}
 
 	req := models.GetFAQCountQuery{
 		Category: category,
@@ -291,7 +503,7 @@ func (h *FAQHTTPHandler) GetFAQCount(c *gin.Context) {
 
 // GetFAQsByIDs получает FAQ по списку ID
 // @Summary Получить FAQ по списку ID
-// @Description Возвращает FAQ по списку ID (batch запрос)
+// @Description Возвращает FAQ по списку ID (batch запрос); анонимным клиентам только активные
 // @Tags FAQ
 // @Accept json
 // @Produce json
@@ -319,19 +531,34 @@ func (h *FAQHTTPHandler) GetFAQsByIDs(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, dtos.ToFAQResponses(result.FAQs))
+	faqs := result.FAQs
+	if !canReadUnpublished(c) {
+		faqs = make([]*entities.FAQ, 0, len(result.FAQs))
+		for _, faq := range result.FAQs {
+			if faq.IsActive {
+				faqs = append(faqs, faq)
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, dtos.ToFAQResponses(faqs))
 }
 
 // BulkDeleteFAQs массовое удаление FAQ
 // @Summary Массовое удаление FAQ
-// @Description Удаляет несколько FAQ по списку ID
+// @Description Удаляет несколько FAQ по списку ID. С atomic=true удаляет все или ни одного
 // @Tags FAQ
 // @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param ids body models.BulkDeleteFAQRequest true "Список ID для удаления"
 // @Success 200 {object} models.BatchCommandResult
 // @Failure 400 {object} models.ErrorResponse
+// @Failure 409 {object} models.BatchCommandResult
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /api/faqs/bulk-delete [delete]
 func (h *FAQHTTPHandler) BulkDeleteFAQs(c *gin.Context) {
 	var req models.BulkDeleteFAQRequest
@@ -342,6 +569,10 @@ func (h *FAQHTTPHandler) BulkDeleteFAQs(c *gin.Context) {
 
 	cmd := req.ToBulkDeleteFAQCommand()
 	result, err := h.commandHandlers.BulkDelete.HandleBulkDeleteFAQ(c.Request.Context(), cmd)
+	if errors.Is(err, appModels.ErrBatchIncomplete) {
+		c.JSON(http.StatusConflict, result)
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -356,10 +587,14 @@ func (h *FAQHTTPHandler) BulkDeleteFAQs(c *gin.Context) {
 // @Tags FAQ
 // @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param faq body models.CreateFAQRequest true "Данные для создания FAQ"
 // @Success 201 {object} models.CommandResult
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /api/faqs [post]
 func (h *FAQHTTPHandler) CreateFAQ(c *gin.Context) {
 	var req models.CreateFAQRequest
@@ -388,11 +623,19 @@ func (h *FAQHTTPHandler) CreateFAQ(c *gin.Context) {
 // @Description Активирует FAQ по ID
 // @Tags FAQ
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID FAQ"
+// @Param If-Match header string true "ETag из GET /api/faqs/{id}; * - без проверки версии"
 // @Success 200 {object} models.CommandResult
+// @Header 200 {string} ETag "Новая версия FAQ"
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 404 {object} models.ErrorResponse
+// @Failure 412 {object} models.ErrorResponse "FAQ изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /api/faqs/{id}/activate [patch]
 func (h *FAQHTTPHandler) ActivateFAQ(c *gin.Context) {
 	id := c.Param("id")
@@ -401,10 +644,15 @@ func (h *FAQHTTPHandler) ActivateFAQ(c *gin.Context) {
 		return
 	}
 
-	cmd := commands.ActivateFAQCommand{ID: id}
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.ActivateFAQCommand{ID: id, ExpectedVersion: expectedVersion}
 	result, err := h.commandHandlers.Activate.HandleActivateFAQ(c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(versionErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -413,6 +661,7 @@ func (h *FAQHTTPHandler) ActivateFAQ(c *gin.Context) {
 		return
 	}
 
+	setVersionETag(c, result.Version)
 	c.JSON(http.StatusOK, result)
 }
 
@@ -421,11 +670,19 @@ func (h *FAQHTTPHandler) ActivateFAQ(c *gin.Context) {
 // @Description Деактивирует FAQ по ID
 // @Tags FAQ
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID FAQ"
+// @Param If-Match header string true "ETag из GET /api/faqs/{id}; * - без проверки версии"
 // @Success 200 {object} models.CommandResult
+// @Header 200 {string} ETag "Новая версия FAQ"
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 404 {object} models.ErrorResponse
+// @Failure 412 {object} models.ErrorResponse "FAQ изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /api/faqs/{id}/deactivate [patch]
 func (h *FAQHTTPHandler) DeactivateFAQ(c *gin.Context) {
 	id := c.Param("id")
@@ -434,10 +691,15 @@ func (h *FAQHTTPHandler) DeactivateFAQ(c *gin.Context) {
 		return
 	}
 
-	cmd := commands.DeactivateFAQCommand{ID: id}
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.DeactivateFAQCommand{ID: id, ExpectedVersion: expectedVersion}
 	result, err := h.commandHandlers.Deactivate.HandleDeactivateFAQ(c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(versionErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -446,6 +708,7 @@ func (h *FAQHTTPHandler) DeactivateFAQ(c *gin.Context) {
 		return
 	}
 
+	setVersionETag(c, result.Version)
 	c.JSON(http.StatusOK, result)
 }
 
@@ -455,12 +718,20 @@ func (h *FAQHTTPHandler) DeactivateFAQ(c *gin.Context) {
 // @Tags FAQ
 // @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID FAQ"
+// @Param If-Match header string true "ETag из GET /api/faqs/{id}; * - без проверки версии"
 // @Param priority body models.UpdateFAQPriorityRequest true "Новый приоритет"
 // @Success 200 {object} models.CommandResult
+// @Header 200 {string} ETag "Новая версия FAQ"
 // @Failure 400 {object} models.ErrorResponse
 // @Failure 404 {object} models.ErrorResponse
+// @Failure 412 {object} models.ErrorResponse "FAQ изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
 // @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /api/faqs/{id}/priority [patch]
 func (h *FAQHTTPHandler) UpdateFAQPriority(c *gin.Context) {
 	id := c.Param("id")
@@ -469,6 +740,11 @@ func (h *FAQHTTPHandler) UpdateFAQPriority(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
 	var req models.UpdateFAQPriorityRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -476,9 +752,186 @@ func (h *FAQHTTPHandler) UpdateFAQPriority(c *gin.Context) {
 	}
 
 	cmd := req.ToUpdateFAQPriorityCommand(id)
+	cmd.ExpectedVersion = expectedVersion
 	result, err := h.commandHandlers.UpdatePriority.HandleUpdateFAQPriority(c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(versionErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	if !result.Success {
+		c.JSON(http.StatusBadRequest, gin.H{"error": result.Error})
+		return
+	}
+
+	setVersionETag(c, result.Version)
+	c.JSON(http.StatusOK, result)
+}
+
+// GetFAQRevisions получает историю изменений FAQ
+// @Summary Получить историю изменений FAQ
+// @Description Возвращает ревизии FAQ от новых к старым
+// @Tags FAQ
+// @Produce json
+// @Security OAuth2AccessCode[api:read]
+// @Security ApiKeyAuth
+// @Param id path string true "ID FAQ"
+// @Param _limit query int false "Лимит записей" default(20)
+// @Param _offset query int false "Смещение" default(0)
+// @Success 200 {object} models.PaginatedFAQRevisionResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/faqs/{id}/revisions [get]
+func (h *FAQHTTPHandler) GetFAQRevisions(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("_limit", "20"))
+	if err != nil || limit < 1 || limit > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit parameter, must be between 1 and 100"})
+		return
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("_offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offset parameter"})
+		return
+	}
+
+	query := queries.GetFAQRevisionsQuery{FAQID: id, Limit: limit, Offset: offset}
+	result, err := h.queryHandlers.Revisions.HandleGetFAQRevisions(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result.Revisions)
+}
+
+// GetFAQRevision получает ревизию FAQ по номеру
+// @Summary Получить ревизию FAQ
+// @Description Возвращает состояние FAQ до и после указанной ревизии
+// @Tags FAQ
+// @Produce json
+// @Security OAuth2AccessCode[api:read]
+// @Security ApiKeyAuth
+// @Param id path string true "ID FAQ"
+// @Param revision path int true "Номер ревизии"
+// @Success 200 {object} models.FAQRevisionResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/faqs/{id}/revisions/{revision} [get]
+func (h *FAQHTTPHandler) GetFAQRevision(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	revision, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || revision < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision number"})
+		return
+	}
+
+	query := queries.GetFAQRevisionQuery{FAQID: id, Revision: revision}
+	result, err := h.queryHandlers.Revisions.HandleGetFAQRevision(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result.Revision)
+}
+
+// DiffFAQRevisions сравнивает две ревизии FAQ
+// @Summary Сравнить ревизии FAQ
+// @Description Возвращает поля, которые отличаются в состоянии FAQ после ревизий from и to
+// @Tags FAQ
+// @Produce json
+// @Security OAuth2AccessCode[api:read]
+// @Security ApiKeyAuth
+// @Param id path string true "ID FAQ"
+// @Param from query int true "Номер исходной ревизии"
+// @Param to query int true "Номер целевой ревизии"
+// @Success 200 {object} models.FAQRevisionDiffResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/faqs/{id}/revisions/diff [get]
+func (h *FAQHTTPHandler) DiffFAQRevisions(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	from, err := strconv.Atoi(c.Query("from"))
+	if err != nil || from < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from parameter"})
+		return
+	}
+
+	to, err := strconv.Atoi(c.Query("to"))
+	if err != nil || to < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to parameter"})
+		return
+	}
+
+	query := queries.DiffFAQRevisionsQuery{FAQID: id, From: from, To: to}
+	result, err := h.queryHandlers.Revisions.HandleDiffFAQRevisions(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result.Diff)
+}
+
+// RestoreFAQRevision восстанавливает FAQ из ревизии
+// @Summary Восстановить FAQ из ревизии
+// @Description Возвращает вопрос, ответ, категорию и приоритет FAQ к состоянию после указанной ревизии.
+// @Description Восстановление создает новую ревизию с action=restored.
+// @Tags FAQ
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID FAQ"
+// @Param revision path int true "Номер ревизии"
+// @Success 200 {object} models.CommandResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/faqs/{id}/revisions/{revision}/restore [post]
+func (h *FAQHTTPHandler) RestoreFAQRevision(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	revision, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || revision < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision number"})
+		return
+	}
+
+	cmd := commands.RestoreFAQRevisionCommand{ID: id, Revision: revision}
+	result, err := h.commandHandlers.RestoreRevision.HandleRestoreFAQRevision(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
 		return
 	}
 
@@ -494,10 +947,15 @@ func (h *FAQHTTPHandler) UpdateFAQPriority(c *gin.Context) {
 func RegisterFAQRoutes(r *gin.Engine, handler *FAQHTTPHandler) {
 	api := r.Group("/api")
 	faqs := api.Group("/faqs")
+
+	// Публичные списки поддерживают условные GET и кешируются браузерами и CDN
+	httpCache := handler.conditionalGET.Config
+	listCache := handler.conditionalGET.Handler(appCache.GenerationScopeFAQ, httpCache.FAQListMaxAge)
+	categoriesCache := handler.conditionalGET.Handler(appCache.GenerationScopeFAQ, httpCache.FAQCategoriesMaxAge)
 	{
 		// CRUD операции
 		faqs.GET("/:id", handler.GetFAQ)
-		faqs.GET("", handler.GetFAQs)
+		faqs.GET("", listCache, handler.GetFAQs)
 		faqs.POST("", handler.CreateFAQ)
 		faqs.PUT("/:id", handler.UpdateFAQ)
 		faqs.DELETE("/:id", handler.DeleteFAQ)
@@ -514,6 +972,16 @@ func RegisterFAQRoutes(r *gin.Engine, handler *FAQHTTPHandler) {
 		faqs.PATCH("/:id/deactivate", handler.DeactivateFAQ)
 		faqs.PATCH("/:id/priority", handler.UpdateFAQPriority)
 
-		faqs.GET("/categories", handler.GetCategories)
+		faqs.GET("/categories", categoriesCache, handler.GetCategories)
+
+		// Корзина
+		faqs.GET("/trash", handler.GetFAQTrash)
+		faqs.POST("/:id/restore", handler.RestoreFAQ)
+
+		// История изменений
+		faqs.GET("/:id/revisions", handler.GetFAQRevisions)
+		faqs.GET("/:id/revisions/diff", handler.DiffFAQRevisions)
+		faqs.GET("/:id/revisions/:revision", handler.GetFAQRevision)
+		faqs.POST("/:id/revisions/:revision/restore", handler.RestoreFAQRevision)
 	}
 }
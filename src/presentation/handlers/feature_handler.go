@@ -0,0 +1,384 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"tax-priority-api/src/application/features/commands"
+	"tax-priority-api/src/application/features/dtos"
+	"tax-priority-api/src/application/features/handlers"
+	"tax-priority-api/src/application/features/queries"
+	"tax-priority-api/src/presentation/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	// featurePublicFilters поля преимущества, доступные для фильтрации всем
+	featurePublicFilters = models.NewFilterFields("name", "description", "icon", "sortOrder", "createdAt", "updatedAt")
+	// featureFilters поля преимущества для клиентов с правами api:read
+	featureFilters = featurePublicFilters.With("isActive")
+)
+
+// FeatureHTTPHandler HTTP обработчик для Feature
+type FeatureHTTPHandler struct {
+	commandHandlers *handlers.FeatureCommandHandlers
+	queryHandlers   *handlers.FeatureQueryHandlers
+}
+
+// NewFeatureHTTPHandler создает новый HTTP обработчик Feature
+func NewFeatureHTTPHandler(commandHandlers *handlers.FeatureCommandHandlers, queryHandlers *handlers.FeatureQueryHandlers) *FeatureHTTPHandler {
+	return &FeatureHTTPHandler{
+		commandHandlers: commandHandlers,
+		queryHandlers:   queryHandlers,
+	}
+}
+
+// GetFeature получает Feature по ID
+// @Summary Получить Feature по ID
+// @Description Возвращает преимущество по указанному ID
+// @Tags Features
+// @Produce json
+// @Param id path string true "ID Feature"
+// @Success 200 {object} models.FeatureResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/features/{id} [get]
+func (h *FeatureHTTPHandler) GetFeature(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	query := queries.GetFeatureByIDQuery{ID: id}
+	result, err := h.queryHandlers.GetByID.HandleGetFeatureByID(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	if !result.Feature.IsActive && !canReadUnpublished(c) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Feature not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToFeatureResponse(result.Feature))
+}
+
+// GetFeatures получает список Feature
+// @Summary Получить список Feature
+// @Description Возвращает преимущества для блока «Почему выбирают нас», по умолчанию в порядке отображения (sortOrder).
+// @Description Дополнительные условия задаются как field[op]=value, см. GET /api/faqs.
+// @Description Без прав api:read доступны поля name, description, icon, sortOrder, createdAt, updatedAt; прочие поля - ошибка 400.
+// @Tags Features
+// @Produce json
+// @Param _limit query int false "Лимит записей" default(50)
+// @Param _offset query int false "Смещение" default(0)
+// @Param _sort query string false "Поле сортировки" default(sortOrder)
+// @Param _order query string false "Порядок сортировки" Enums(asc,desc) default(asc)
+// @Param isActive query bool false "Фильтр по активности; без прав api:read всегда true"
+// @Success 200 {object} models.PaginatedFeatureResponse
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Router /api/features [get]
+func (h *FeatureHTTPHandler) GetFeatures(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("_limit", "50"))
+	if err != nil || limit < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit parameter"})
+		return
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("_offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid offset parameter"})
+		return
+	}
+
+	var isActive *bool
+	if isActiveQuery := c.Query("isActive"); isActiveQuery != "" {
+		isActiveVal, err := strconv.ParseBool(isActiveQuery)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid isActive parameter, must be true or false"})
+			return
+		}
+		isActive = &isActiveVal
+	}
+	// Анонимным клиентам только активные преимущества
+	if !canReadUnpublished(c) {
+		active := true
+		isActive = &active
+	}
+
+	where, err := models.ParseFilterQuery(c.Request.URL.Query(), listFilterFields(c, featurePublicFilters, featureFilters))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := models.GetFeaturesQuery{
+		Limit:     limit,
+		Offset:    offset,
+		SortBy:    c.DefaultQuery("_sort", "sortOrder"),
+		SortOrder: c.DefaultQuery("_order", "asc"),
+		IsActive:  isActive,
+		Where:     where,
+	}
+
+	result, err := h.queryHandlers.GetMany.HandleGetFeatures(c.Request.Context(), req.ToGetFeaturesQuery())
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToPaginatedFeatureResponse(result.Paginated))
+}
+
+// CreateFeature создает новую Feature
+// @Summary Создать Feature
+// @Description Создает преимущество; новая Feature сразу активна
+// @Tags Features
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param feature body models.CreateFeatureRequest true "Данные для создания Feature"
+// @Success 201 {object} models.CommandResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/features [post]
+func (h *FeatureHTTPHandler) CreateFeature(c *gin.Context) {
+	var req models.CreateFeatureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.commandHandlers.Create.HandleCreateFeature(c.Request.Context(), req.ToCreateFeatureCommand())
+	if err != nil {
+		c.JSON(commandErrorStatus(err), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// UpdateFeature обновляет Feature
+// @Summary Обновить Feature
+// @Description Обновляет название, описание, иконку и позицию Feature
+// @Tags Features
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID Feature"
+// @Param feature body models.UpdateFeatureRequest true "Данные для обновления"
+// @Success 200 {object} models.CommandResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/features/{id} [put]
+func (h *FeatureHTTPHandler) UpdateFeature(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req models.UpdateFeatureRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.commandHandlers.Update.HandleUpdateFeature(c.Request.Context(), req.ToUpdateFeatureCommand(id))
+	if err != nil {
+		c.JSON(commandErrorStatus(err), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeleteFeature удаляет Feature
+// @Summary Удалить Feature
+// @Description Перемещает Feature в корзину. Ее можно восстановить через POST /api/features/{id}/restore,
+// @Description пока она не удалена окончательно по истечении срока хранения (TRASH_RETENTION_DAYS).
+// @Tags Features
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID Feature"
+// @Success 200 {object} models.CommandResult
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/features/{id} [delete]
+func (h *FeatureHTTPHandler) DeleteFeature(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	cmd := commands.DeleteFeatureCommand{ID: id}
+	result, err := h.commandHandlers.Delete.HandleDeleteFeature(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RestoreFeature восстанавливает Feature из корзины
+// @Summary Восстановить Feature из корзины
+// @Description Возвращает удаленную Feature в общий список
+// @Tags Features
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID Feature"
+// @Success 200 {object} models.CommandResult
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/features/{id}/restore [post]
+func (h *FeatureHTTPHandler) RestoreFeature(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	cmd := commands.RestoreFeatureCommand{ID: id}
+	result, err := h.commandHandlers.Restore.HandleRestoreFeature(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ActivateFeature активирует Feature
+// @Summary Активировать Feature
+// @Description Активирует Feature по ID, она начинает отображаться на сайте
+// @Tags Features
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID Feature"
+// @Success 200 {object} models.CommandResult
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/features/{id}/activate [patch]
+func (h *FeatureHTTPHandler) ActivateFeature(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	cmd := commands.ActivateFeatureCommand{ID: id}
+	result, err := h.commandHandlers.Activate.HandleActivateFeature(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// DeactivateFeature деактивирует Feature
+// @Summary Деактивировать Feature
+// @Description Деактивирует Feature по ID, она скрывается с сайта
+// @Tags Features
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID Feature"
+// @Success 200 {object} models.CommandResult
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/features/{id}/deactivate [patch]
+func (h *FeatureHTTPHandler) DeactivateFeature(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ID is required"})
+		return
+	}
+
+	cmd := commands.DeactivateFeatureCommand{ID: id}
+	result, err := h.commandHandlers.Deactivate.HandleDeactivateFeature(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReorderFeatures меняет порядок Feature
+// @Summary Изменить порядок Feature
+// @Description Перечисленные Feature получают позиции 0..N-1 в указанном порядке, остальные сохраняют взаимный порядок и идут следом
+// @Tags Features
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param order body models.ReorderFeaturesRequest true "ID Feature в желаемом порядке"
+// @Success 200 {object} models.CommandResult
+// @Failure 400 {object} models.ErrorResponse
+// @Failure 404 {object} models.ErrorResponse
+// @Failure 500 {object} models.ErrorResponse
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /api/features/order [put]
+func (h *FeatureHTTPHandler) ReorderFeatures(c *gin.Context) {
+	var req models.ReorderFeaturesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.commandHandlers.Reorder.HandleReorderFeatures(c.Request.Context(), req.ToReorderFeaturesCommand())
+	if err != nil {
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), gin.H{"error": result.Error})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RegisterFeatureRoutes регистрирует маршруты для Feature
+func RegisterFeatureRoutes(r *gin.Engine, handler *FeatureHTTPHandler) {
+	api := r.Group("/api")
+	features := api.Group("/features")
+	{
+		// CRUD операции
+		features.GET("/:id", handler.GetFeature)
+		features.GET("", handler.GetFeatures)
+		features.POST("", handler.CreateFeature)
+		features.PUT("/:id", handler.UpdateFeature)
+		features.DELETE("/:id", handler.DeleteFeature)
+
+		// Управление состоянием
+		features.PATCH("/:id/activate", handler.ActivateFeature)
+		features.PATCH("/:id/deactivate", handler.DeactivateFeature)
+		features.PUT("/order", handler.ReorderFeatures)
+
+		// Корзина
+		features.POST("/:id/restore", handler.RestoreFeature)
+	}
+}
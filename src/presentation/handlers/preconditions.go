@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// versionETag форматирует версию сущности как сильный ETag
+func versionETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// setVersionETag отдает версию сущности в заголовке ETag
+func setVersionETag(c *gin.Context, version int) {
+	c.Header("ETag", versionETag(version))
+}
+
+// ifMatchVersion читает ожидаемую версию из If-Match. Заголовок обязателен для PUT/PATCH:
+// без него клиент получает 428, с ETag не от этого API - 412, и метод возвращает false.
+// If-Match: * разрешает изменение любой версии, тогда возвращается nil.
+func ifMatchVersion(c *gin.Context) (*int, bool) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		c.AbortWithStatusJSON(http.StatusPreconditionRequired, gin.H{"error": "If-Match header with the ETag from GET is required"})
+		return nil, false
+	}
+	if header == "*" {
+		return nil, true
+	}
+
+	// Слабые ETag не подходят для If-Match: сравнение всегда строгое
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"error": "If-Match must contain a single strong ETag"})
+		return nil, false
+	}
+
+	version, err := strconv.Atoi(tag)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, gin.H{"error": "If-Match does not match any version"})
+		return nil, false
+	}
+
+	return &version, true
+}
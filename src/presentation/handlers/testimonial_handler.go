@@ -1,39 +1,61 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
+	appModels "tax-priority-api/src/application/models"
+	"tax-priority-api/src/application/storage"
 	"tax-priority-api/src/application/testimonial/dtos"
 	"tax-priority-api/src/application/testimonial/handlers"
+	"tax-priority-api/src/application/testimonial/queries"
+	"tax-priority-api/src/application/uploads"
+	"tax-priority-api/src/application/verification"
+	"tax-priority-api/src/domain/entities"
+	"tax-priority-api/src/presentation/models"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
+)
+
+var (
+	// testimonialPublicFilters поля отзыва, доступные для фильтрации всем; данные модерации и email автора исключены
+	testimonialPublicFilters = models.NewFilterFields("author", "company", "position", "rating", "approvedAt", "createdAt")
+	// testimonialFilters поля отзыва для клиентов с правами api:read
+	testimonialFilters = testimonialPublicFilters.With(
+		"status", "isApproved", "isActive", "statusChangedAt", "approvedBy", "reviewedBy", "rejectionReason",
+		"moderatorNotes", "spamScore", "authorEmail", "emailVerifiedAt", "createdBy", "updatedBy", "updatedAt",
+	)
 )
 
 type TestimonialHTTPHandler struct {
 	commandHandlers *handlers.TestimonialCommandHandlers
 	queryHandlers   *handlers.TestimonialQueryHandlers
+	uploadPolicy    *uploads.Policy
 }
 
 func NewTestimonialHTTPHandler(
 	commandHandlers *handlers.TestimonialCommandHandlers,
 	queryHandlers *handlers.TestimonialQueryHandlers,
+	uploadPolicy *uploads.Policy,
 ) *TestimonialHTTPHandler {
 	return &TestimonialHTTPHandler{
 		commandHandlers: commandHandlers,
 		queryHandlers:   queryHandlers,
+		uploadPolicy:    uploadPolicy,
 	}
 }
 
 // CreateTestimonial создает новый отзыв
 // @Summary Создать отзыв
-// @Description Создает новый отзыв с возможностью загрузки файла
+// @Description Создает новый отзыв с возможностью загрузки файла. Тип файла определяется по содержимому,
+// @Description допустимы PDF, JPEG, PNG и GIF размером до UPLOAD_MAX_FILE_SIZE_MB.
+// @Description Автору отзыва с сайта после сохранения отправляется письмо со ссылкой подтверждения email (GET /testimonials/verify);
+// @Description подозрительным на спам отзывам письмо отправляет модератор (POST /testimonials/{id}/verification).
+// @Description Без проверки на спам и подтверждения email отзывы добавляют только модераторы и редакторы контента
+// @Description (роли moderator, content-editor или scope api:write).
 // @Tags testimonials
 // @Accept multipart/form-data
 // @Produce json
@@ -44,11 +66,18 @@ func NewTestimonialHTTPHandler(
 // @Param company formData string false "Компания"
 // @Param position formData string false "Должность"
 // @Param file formData file false "Файл (PDF или изображение)"
+// @Param website formData string false "Скрытое поле-ловушка для ботов; форма на сайте оставляет его пустым"
 // @Success 201 {object} dtos.CommandResult
 // @Failure 400 {object} dtos.CommandResult
+// @Failure 413 {object} dtos.CommandResult
+// @Failure 415 {object} dtos.CommandResult
 // @Failure 500 {object} dtos.CommandResult
 // @Router /testimonials [post]
 func (h *TestimonialHTTPHandler) CreateTestimonial(c *gin.Context) {
+	if !h.parseUploadForm(c) {
+		return
+	}
+
 	var cmd dtos.CreateTestimonialCommand
 
 	// Получаем данные из формы
@@ -57,6 +86,9 @@ func (h *TestimonialHTTPHandler) CreateTestimonial(c *gin.Context) {
 	cmd.AuthorEmail = c.PostForm("authorEmail")
 	cmd.Company = c.PostForm("company")
 	cmd.Position = c.PostForm("position")
+	cmd.Honeypot = c.PostForm("website")
+	cmd.ClientIP = c.ClientIP()
+	cmd.Trusted = canAddTrustedTestimonials(c)
 
 	// Парсим рейтинг
 	ratingStr := c.PostForm("rating")
@@ -73,97 +105,310 @@ func (h *TestimonialHTTPHandler) CreateTestimonial(c *gin.Context) {
 		cmd.Rating = rating
 	}
 
-	// Создаем отзыв
+	// Файл необязателен; сохраняется командой вместе с отзывом
+	file, fileHeader, err := c.Request.FormFile("file")
+	switch {
+	case err == nil:
+		defer file.Close()
+		cmd.Attachment = &dtos.FileUpload{FileName: fileHeader.Filename, Content: file}
+	case !errors.Is(err, http.ErrMissingFile):
+		c.JSON(attachmentErrorStatus(err), dtos.CommandResult{
+			Success:   false,
+			Error:     fmt.Sprintf("Invalid file upload: %v", err),
+			Timestamp: time.Now(),
+		})
+		return
+	}
+
 	result, err := h.commandHandlers.CreateTestimonial(c.Request.Context(), cmd)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, result)
+		c.JSON(attachmentErrorStatus(err), result)
+		return
+	}
+
+	// Отправитель с сайта не узнает, что отзыв сочли спамом
+	if testimonial, ok := result.Data.(*entities.Testimonial); ok && !canReadUnpublished(c) {
+		hideModerationNotes(testimonial)
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// VerifyTestimonialEmail подтверждает email автора отзыва
+// @Summary Подтвердить email автора
+// @Description Подтверждает email автора по ссылке из письма, отправленного при создании отзыва.
+// @Description Только отзыв с подтвержденным email можно одобрить. Повторный переход по ссылке не ошибка.
+// @Tags testimonials
+// @Produce json
+// @Param token query string true "Токен из письма"
+// @Success 200 {object} dtos.CommandResult
+// @Failure 400 {object} dtos.CommandResult "Токен поврежден или выдан для другого email"
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 410 {object} dtos.CommandResult "Срок действия ссылки истек"
+// @Failure 500 {object} dtos.CommandResult
+// @Router /testimonials/verify [get]
+func (h *TestimonialHTTPHandler) VerifyTestimonialEmail(c *gin.Context) {
+	cmd := dtos.VerifyTestimonialEmailCommand{Token: c.Query("token")}
+	if cmd.Token == "" {
+		c.JSON(http.StatusBadRequest, dtos.CommandResult{
+			Success:   false,
+			Error:     "token is required",
+			Timestamp: time.Now(),
+		})
+		return
+	}
+
+	result, err := h.commandHandlers.VerifyTestimonialEmail(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(verificationErrorStatus(err), result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ResendTestimonialVerification повторно отправляет письмо подтверждения email автора
+// @Summary Повторить письмо подтверждения email
+// @Description Ставит в очередь письмо со ссылкой подтверждения на текущий email автора: если ссылка истекла,
+// @Description письмо не дошло или отзыв был подозрительным на спам и письмо при отправке не отправлялось.
+// @Description Письмо отправляется после ответа, ошибки SMTP повторяются.
+// @Tags testimonials
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Success 202 {object} dtos.CommandResult
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 409 {object} dtos.CommandResult "Email автора уже подтвержден"
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/verification [post]
+func (h *TestimonialHTTPHandler) ResendTestimonialVerification(c *gin.Context) {
+	cmd := dtos.ResendTestimonialVerificationCommand{ID: c.Param("id")}
+	result, err := h.commandHandlers.ResendTestimonialVerification(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(verificationErrorStatus(err), result)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, result)
+}
+
+// verificationErrorStatus возвращает HTTP статус для ошибок подтверждения email
+func verificationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, verification.ErrInvalidToken):
+		return http.StatusBadRequest
+	case errors.Is(err, verification.ErrTokenExpired):
+		return http.StatusGone
+	case errors.Is(err, entities.ErrEmailAlreadyVerified):
+		return http.StatusConflict
+	case strings.Contains(err.Error(), "not found"):
+		return http.StatusNotFound
+	}
+	return repositoryErrorStatus(err, http.StatusInternalServerError)
+}
+
+// GetTestimonialFile отдает файл отзыва
+// @Summary Скачать файл отзыва
+// @Description Отдает прикрепленный к отзыву файл с исходным именем; поддерживает Range запросы.
+// @Description Оригиналы JPEG и PNG хранятся без EXIF, XMP и IPTC; у JPEG сохраняется только тег ориентации.
+// @Description Для изображений параметр variant возвращает уменьшенную копию: thumb (128px) или preview (512px).
+// @Tags testimonials
+// @Produce application/octet-stream
+// @Param id path string true "ID отзыва"
+// @Param variant query string false "Уменьшенная копия изображения" Enums(thumb, preview)
+// @Success 200 {file} file
+// @Failure 400 {object} dtos.CommandResult
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 500 {object} dtos.CommandResult
+// @Router /testimonials/{id}/file [get]
+func (h *TestimonialHTTPHandler) GetTestimonialFile(c *gin.Context) {
+	query := dtos.GetTestimonialFileQuery{
+		ID:            c.Param("id"),
+		Variant:       c.Query("variant"),
+		PublishedOnly: !canReadUnpublished(c),
+	}
+	file, err := h.queryHandlers.GetTestimonialFile(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), dtos.CommandResult{
+			Success:   false,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		})
+		return
+	}
+	defer file.Content.Close()
+
+	serveBlob(c, file.Content, file.FileName, file.FileType, uploads.ContentDisposition(file.FileType, file.FileName), file.FileSize, file.UpdatedAt)
+}
+
+// GetTestimonialFileURL выдает временную ссылку на файл отзыва
+// @Summary Получить ссылку на файл отзыва
+// @Description Возвращает подписанную ссылку, по которой файл можно скачать без авторизации в течение 15 минут.
+// @Description Для S3 хранилища ссылка ведет напрямую в бакет.
+// @Tags testimonials
+// @Produce json
+// @Param id path string true "ID отзыва"
+// @Param variant query string false "Уменьшенная копия изображения" Enums(thumb, preview)
+// @Success 200 {object} dtos.QueryResult
+// @Failure 400 {object} dtos.QueryResult
+// @Failure 404 {object} dtos.QueryResult
+// @Failure 500 {object} dtos.QueryResult
+// @Router /testimonials/{id}/file/url [get]
+func (h *TestimonialHTTPHandler) GetTestimonialFileURL(c *gin.Context) {
+	query := dtos.GetTestimonialFileURLQuery{
+		ID:            c.Param("id"),
+		Variant:       c.Query("variant"),
+		PublishedOnly: !canReadUnpublished(c),
+	}
+	result, err := h.queryHandlers.GetTestimonialFileURL(c.Request.Context(), query)
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// UploadTestimonialFile загружает файл отзыва
+// @Summary Загрузить файл отзыва
+// @Description Прикрепляет файл к отзыву, ранее прикрепленный файл удаляется. Тип файла определяется по содержимому,
+// @Description допустимы PDF, JPEG, PNG и GIF размером до UPLOAD_MAX_FILE_SIZE_MB.
+// @Tags testimonials
+// @Accept multipart/form-data
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
+// @Param file formData file true "Файл (PDF или изображение)"
+// @Success 200 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
+// @Failure 400 {object} dtos.CommandResult
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 413 {object} dtos.CommandResult
+// @Failure 415 {object} dtos.CommandResult
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/file [put]
+func (h *TestimonialHTTPHandler) UploadTestimonialFile(c *gin.Context) {
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	if !h.parseUploadForm(c) {
 		return
 	}
 
-	// Обрабатываем загрузку файла, если он есть
 	file, fileHeader, err := c.Request.FormFile("file")
-	if err == nil && file != nil {
-		defer file.Close()
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), dtos.CommandResult{
+			Success:   false,
+			Error:     fmt.Sprintf("Invalid file upload: %v", err),
+			Timestamp: time.Now(),
+		})
+		return
+	}
+	defer file.Close()
 
-		// Проверяем тип файла
-		allowedTypes := map[string]bool{
-			"application/pdf": true,
-			"image/jpeg":      true,
-			"image/png":       true,
-			"image/gif":       true,
-		}
+	cmd := dtos.UploadTestimonialFileCommand{
+		ID:              c.Param("id"),
+		File:            dtos.FileUpload{FileName: fileHeader.Filename, Content: file},
+		ExpectedVersion: expectedVersion,
+	}
+	result, err := h.commandHandlers.UploadTestimonialFile(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), result)
+		return
+	}
 
-		contentType := fileHeader.Header.Get("Content-Type")
-		if !allowedTypes[contentType] {
-			c.JSON(http.StatusBadRequest, dtos.CommandResult{
-				Success:   false,
-				Error:     "Unsupported file type. Only PDF and images are allowed",
-				Timestamp: time.Now(),
-			})
-			return
-		}
+	setTestimonialETag(c, result.Data)
+	c.JSON(http.StatusOK, result)
+}
 
-		// Создаем папку для файлов, если её нет
-		uploadDir := "uploads/testimonials"
-		if err := os.MkdirAll(uploadDir, 0755); err != nil {
-			c.JSON(http.StatusInternalServerError, dtos.CommandResult{
-				Success:   false,
-				Error:     "Failed to create upload directory",
-				Timestamp: time.Now(),
-			})
-			return
-		}
+// DeleteTestimonialFile удаляет файл отзыва
+// @Summary Удалить файл отзыва
+// @Description Открепляет файл от отзыва и удаляет его из хранилища
+// @Tags testimonials
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Success 200 {object} dtos.CommandResult
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/file [delete]
+func (h *TestimonialHTTPHandler) DeleteTestimonialFile(c *gin.Context) {
+	cmd := dtos.RemoveTestimonialFileCommand{ID: c.Param("id")}
+	result, err := h.commandHandlers.RemoveTestimonialFile(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), result)
+		return
+	}
 
-		// Генерируем уникальное имя файла
-		ext := filepath.Ext(fileHeader.Filename)
-		fileName := uuid.New().String() + ext
-		filePath := filepath.Join(uploadDir, fileName)
+	c.JSON(http.StatusOK, result)
+}
 
-		// Сохраняем файл
-		dst, err := os.Create(filePath)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, dtos.CommandResult{
-				Success:   false,
-				Error:     "Failed to save file",
-				Timestamp: time.Now(),
-			})
-			return
-		}
-		defer dst.Close()
+// parseUploadForm разбирает форму с ограничением размера тела, чтобы multipart не буферизовал файлы сверх лимита.
+// При ошибке отвечает клиенту и возвращает false.
+func (h *TestimonialHTTPHandler) parseUploadForm(c *gin.Context) bool {
+	// Запас на остальные поля формы и заголовки частей
+	const formOverhead = 1 << 20
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.uploadPolicy.MaxSize+formOverhead)
 
-		if _, err := io.Copy(dst, file); err != nil {
-			c.JSON(http.StatusInternalServerError, dtos.CommandResult{
-				Success:   false,
-				Error:     "Failed to save file",
-				Timestamp: time.Now(),
-			})
-			return
-		}
+	err := c.Request.ParseMultipartForm(32 << 20)
+	if err == nil || errors.Is(err, http.ErrNotMultipart) {
+		return true
+	}
 
-		// Обновляем отзыв с информацией о файле
-		if testimonial, ok := result.Data.(*dtos.CommandResult); ok {
-			if testimonialData, ok := testimonial.Data.(map[string]interface{}); ok {
-				if id, ok := testimonialData["id"].(string); ok {
-					fileCmd := dtos.UploadTestimonialFileCommand{
-						ID:       id,
-						FilePath: filePath,
-						FileName: fileHeader.Filename,
-						FileType: contentType,
-						FileSize: fileHeader.Size,
-					}
-					// Здесь можно добавить обработку команды загрузки файла
-					_ = fileCmd
-				}
-			}
-		}
+	c.JSON(attachmentErrorStatus(err), dtos.CommandResult{
+		Success:   false,
+		Error:     fmt.Sprintf("Invalid form: %v", err),
+		Timestamp: time.Now(),
+	})
+	return false
+}
+
+// attachmentErrorStatus возвращает HTTP статус для ошибок загрузки файлов и репозитория
+func attachmentErrorStatus(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, uploads.ErrFileTooLarge), errors.As(err, &maxBytesErr):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, uploads.ErrUnsupportedType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, uploads.ErrEmptyFile), errors.Is(err, uploads.ErrInvalidImage), errors.Is(err, uploads.ErrUnknownVariant):
+		return http.StatusBadRequest
+	case errors.Is(err, uploads.ErrFileNotFound), errors.Is(err, uploads.ErrVariantNotFound), errors.Is(err, storage.ErrBlobNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, entities.ErrVersionConflict):
+		return http.StatusPreconditionFailed
 	}
+	return repositoryErrorStatus(err, http.StatusInternalServerError)
+}
 
-	c.JSON(http.StatusCreated, result)
+// setTestimonialETag отдает версию отзыва из результата команды в заголовке ETag
+func setTestimonialETag(c *gin.Context, data interface{}) {
+	if testimonial, ok := data.(*entities.Testimonial); ok {
+		setVersionETag(c, testimonial.Version)
+	}
 }
 
 // GetTestimonials получает список отзывов
 // @Summary Получить список отзывов
-// @Description Получает список отзывов с пагинацией и фильтрацией
+// @Description Получает список отзывов с пагинацией и фильтрацией.
+// @Description Дополнительные условия задаются как field[op]=value (AND) и _or[n][field][op]=value (OR между подгруппами n),
+// @Description операторы: eq, ne, gt, gte, lt, lte, in, nin, like, ilike, between, null; для in/nin/between значения через запятую.
+// @Description Пример: rating[gte]=4&createdAt[between]=2024-01-01,2024-12-31&_or[0][company][ilike]=банк&_or[1][isApproved][eq]=true
+// @Description Без прав api:read доступны поля author, company, position, rating, approvedAt, createdAt; прочие поля - ошибка 400.
 // @Tags testimonials
 // @Accept json
 // @Produce json
@@ -171,8 +416,11 @@ func (h *TestimonialHTTPHandler) CreateTestimonial(c *gin.Context) {
 // @Param offset query int false "Смещение" default(0)
 // @Param sortBy query string false "Поле для сортировки" default("createdAt")
 // @Param sortOrder query string false "Порядок сортировки" Enums(asc, desc) default("desc")
-// @Param approved query bool false "Фильтр по статусу одобрения"
+// @Param approved query bool false "Фильтр по статусу одобрения; без прав api:read возвращаются только одобренные"
+// @Param status query string false "Фильтр по статусу модерации; без прав api:read игнорируется" Enums(submitted, in_review, approved, rejected, archived, suspected_spam)
 // @Param rating query int false "Фильтр по рейтингу"
+// @Param author query string false "Поиск по автору (подстрока, без учета регистра)"
+// @Param _cursor query string false "Курсор keyset-пагинации; пустое значение - первая страница, результат в поле cursorPaginated; sortBy только createdAt, updatedAt, rating или author"
 // @Success 200 {object} dtos.QueryResult
 // @Failure 400 {object} dtos.QueryResult
 // @Failure 500 {object} dtos.QueryResult
@@ -196,12 +444,17 @@ func (h *TestimonialHTTPHandler) GetTestimonials(c *gin.Context) {
 	query.SortBy = c.Query("sortBy")
 	query.SortOrder = c.Query("sortOrder")
 
+	// Наличие _cursor (даже пустого) включает keyset-пагинацию, offset игнорируется
+	if cursor, ok := c.GetQuery("_cursor"); ok {
+		query.Cursor = &cursor
+	}
+
 	// Парсим фильтры
 	filters := make(map[string]interface{})
 
 	if approvedStr := c.Query("approved"); approvedStr != "" {
 		if approved, err := strconv.ParseBool(approvedStr); err == nil {
-			filters["is_approved"] = approved
+			filters["isApproved"] = approved
 		}
 	}
 
@@ -211,29 +464,61 @@ func (h *TestimonialHTTPHandler) GetTestimonials(c *gin.Context) {
 		}
 	}
 
-	if author := c.Query("author"); author != "" {
-		filters["author ILIKE"] = fmt.Sprintf("%%%s%%", author)
+	if status := entities.TestimonialStatus(c.Query("status")); status.IsValid() {
+		filters["status"] = string(status)
+	}
+
+	// Анонимным клиентам только одобренные и активные отзывы, фильтры approved и status игнорируются
+	privileged := canReadUnpublished(c)
+	if !privileged {
+		delete(filters, "status")
+		filters["isApproved"] = true
+		filters["isActive"] = true
 	}
 
 	query.Filters = filters
 
+	where, err := models.ParseFilterQuery(c.Request.URL.Query(), listFilterFields(c, testimonialPublicFilters, testimonialFilters))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dtos.QueryResult{Success: false, Error: err.Error(), Timestamp: time.Now()})
+		return
+	}
+
+	if author := c.Query("author"); author != "" {
+		if where == nil {
+			where = appModels.NewFilterGroup(appModels.FilterAnd)
+		}
+		where.Add("author", appModels.FilterILike, author)
+	}
+	query.Where = where
+
 	result, err := h.queryHandlers.GetTestimonials(c.Request.Context(), query)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, result)
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), result)
 		return
 	}
 
+	if !privileged {
+		if result.Paginated != nil {
+			hideModerationNotes(result.Paginated.Items...)
+		}
+		if result.CursorPaginated != nil {
+			hideModerationNotes(result.CursorPaginated.Items...)
+		}
+	}
+
 	c.JSON(http.StatusOK, result)
 }
 
 // GetTestimonialByID получает отзыв по ID
 // @Summary Получить отзыв по ID
-// @Description Получает отзыв по указанному ID
+// @Description Получает отзыв по указанному ID; отзыв на модерации доступен только с правами api:read
 // @Tags testimonials
 // @Accept json
 // @Produce json
 // @Param id path string true "ID отзыва"
 // @Success 200 {object} dtos.QueryResult
+// @Header 200 {string} ETag "Версия отзыва для If-Match"
 // @Failure 404 {object} dtos.QueryResult
 // @Failure 500 {object} dtos.QueryResult
 // @Router /testimonials/{id} [get]
@@ -247,24 +532,64 @@ func (h *TestimonialHTTPHandler) GetTestimonialByID(c *gin.Context) {
 		return
 	}
 
+	// Отзыв на модерации для анонимного клиента не существует
+	if !canReadUnpublished(c) {
+		if !result.Data.IsPublished() {
+			c.JSON(http.StatusNotFound, dtos.QueryResult{
+				Success:   false,
+				Error:     "testimonial not found",
+				Timestamp: time.Now(),
+			})
+			return
+		}
+		hideModerationNotes(result.Data)
+	}
+
+	setVersionETag(c, result.Data.Version)
 	c.JSON(http.StatusOK, result)
 }
 
+// hideModerationNotes убирает из отзывов служебные поля модерации перед ответом анонимному клиенту;
+// подозрительный на спам отзыв выглядит как обычный отправленный
+func hideModerationNotes(testimonials ...*entities.Testimonial) {
+	for _, testimonial := range testimonials {
+		testimonial.ModeratorNotes = ""
+		testimonial.ReviewedBy = ""
+		testimonial.SpamScore = 0
+		testimonial.SpamSignals = nil
+		if testimonial.CurrentStatus() == entities.TestimonialStatusSuspectedSpam {
+			testimonial.Status = entities.TestimonialStatusSubmitted
+		}
+	}
+}
+
 // UpdateTestimonial обновляет отзыв
 // @Summary Обновить отзыв
 // @Description Обновляет существующий отзыв
 // @Tags testimonials
 // @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID отзыва"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
 // @Param testimonial body dtos.UpdateTestimonialCommand true "Данные для обновления"
 // @Success 200 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
 // @Failure 400 {object} dtos.CommandResult
 // @Failure 404 {object} dtos.CommandResult
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
 // @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /testimonials/{id} [put]
 func (h *TestimonialHTTPHandler) UpdateTestimonial(c *gin.Context) {
 	id := c.Param("id")
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
 
 	var cmd dtos.UpdateTestimonialCommand
 	if err := c.ShouldBindJSON(&cmd); err != nil {
@@ -277,36 +602,140 @@ func (h *TestimonialHTTPHandler) UpdateTestimonial(c *gin.Context) {
 	}
 
 	cmd.ID = id
+	cmd.ExpectedVersion = expectedVersion
 	result, err := h.commandHandlers.UpdateTestimonial(c.Request.Context(), cmd)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			c.JSON(http.StatusNotFound, result)
 		} else {
-			c.JSON(http.StatusInternalServerError, result)
+			c.JSON(versionErrorStatus(err, http.StatusInternalServerError), result)
 		}
 		return
 	}
 
+	setTestimonialETag(c, result.Data)
 	c.JSON(http.StatusOK, result)
 }
 
 // ApproveTestimonial одобряет отзыв
 // @Summary Одобрить отзыв
-// @Description Одобряет отзыв для публикации
+// @Description Одобряет отзыв в статусе in_review с подтвержденным email автора. Одобривший модератор (approvedBy)
+// @Description берется из токена, тело запроса не нужно.
 // @Tags testimonials
-// @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID отзыва"
-// @Param approveData body dtos.ApproveTestimonialCommand true "Данные для одобрения"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
 // @Success 200 {object} dtos.CommandResult
-// @Failure 400 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
 // @Failure 404 {object} dtos.CommandResult
+// @Failure 409 {object} dtos.CommandResult "Отзыв не на проверке или email автора не подтвержден"
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
 // @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /testimonials/{id}/approve [patch]
 func (h *TestimonialHTTPHandler) ApproveTestimonial(c *gin.Context) {
-	id := c.Param("id")
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
 
-	var cmd dtos.ApproveTestimonialCommand
+	cmd := dtos.ApproveTestimonialCommand{ID: c.Param("id"), ExpectedVersion: expectedVersion}
+	result, err := h.commandHandlers.ApproveTestimonial(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(moderationErrorStatus(err), result)
+		return
+	}
+
+	setTestimonialETag(c, result.Data)
+	c.JSON(http.StatusOK, result)
+}
+
+// StartTestimonialReview берет отзыв на проверку
+// @Summary Взять отзыв на проверку
+// @Description Переводит отзыв в статус in_review из submitted или suspected_spam, а также возвращает на повторную проверку
+// @Description одобренный или отклоненный отзыв (одобрение и причина отклонения снимаются). Тело запроса необязательно.
+// @Tags testimonials
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
+// @Param review body dtos.StartTestimonialReviewCommand false "Заметки модератора"
+// @Success 200 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
+// @Failure 400 {object} dtos.CommandResult
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 409 {object} dtos.CommandResult "Переход из текущего статуса не разрешен"
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/review [patch]
+func (h *TestimonialHTTPHandler) StartTestimonialReview(c *gin.Context) {
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	var cmd dtos.StartTestimonialReviewCommand
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&cmd); err != nil {
+			c.JSON(http.StatusBadRequest, dtos.CommandResult{
+				Success:   false,
+				Error:     err.Error(),
+				Timestamp: time.Now(),
+			})
+			return
+		}
+	}
+
+	cmd.ID = c.Param("id")
+	cmd.ExpectedVersion = expectedVersion
+	result, err := h.commandHandlers.StartTestimonialReview(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(moderationErrorStatus(err), result)
+		return
+	}
+
+	setTestimonialETag(c, result.Data)
+	c.JSON(http.StatusOK, result)
+}
+
+// RejectTestimonial отклоняет отзыв
+// @Summary Отклонить отзыв
+// @Description Отклоняет отзыв в статусе in_review или suspected_spam с указанием причины. Для причины other нужны заметки модератора (notes).
+// @Tags testimonials
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
+// @Param rejection body dtos.RejectTestimonialCommand true "Причина отклонения и заметки модератора"
+// @Success 200 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
+// @Failure 400 {object} dtos.CommandResult "Неизвестная причина отклонения"
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 409 {object} dtos.CommandResult "Отзыв не на проверке или email автора не подтвержден"
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/reject [patch]
+func (h *TestimonialHTTPHandler) RejectTestimonial(c *gin.Context) {
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	var cmd dtos.RejectTestimonialCommand
 	if err := c.ShouldBindJSON(&cmd); err != nil {
 		c.JSON(http.StatusBadRequest, dtos.CommandResult{
 			Success:   false,
@@ -316,30 +745,350 @@ func (h *TestimonialHTTPHandler) ApproveTestimonial(c *gin.Context) {
 		return
 	}
 
-	cmd.ID = id
-	result, err := h.commandHandlers.ApproveTestimonial(c.Request.Context(), cmd)
+	cmd.ID = c.Param("id")
+	cmd.ExpectedVersion = expectedVersion
+	result, err := h.commandHandlers.RejectTestimonial(c.Request.Context(), cmd)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			c.JSON(http.StatusNotFound, result)
-		} else {
-			c.JSON(http.StatusInternalServerError, result)
+		c.JSON(moderationErrorStatus(err), result)
+		return
+	}
+
+	setTestimonialETag(c, result.Data)
+	c.JSON(http.StatusOK, result)
+}
+
+// ArchiveTestimonial переносит отзыв в архив
+// @Summary Перенести отзыв в архив
+// @Description Переносит одобренный или отклоненный отзыв в архив; архивный отзыв не публикуется и не меняет статус
+// @Tags testimonials
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
+// @Success 200 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 409 {object} dtos.CommandResult "Переход из текущего статуса не разрешен"
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/archive [patch]
+func (h *TestimonialHTTPHandler) ArchiveTestimonial(c *gin.Context) {
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	cmd := dtos.ArchiveTestimonialCommand{ID: c.Param("id"), ExpectedVersion: expectedVersion}
+	result, err := h.commandHandlers.ArchiveTestimonial(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(moderationErrorStatus(err), result)
+		return
+	}
+
+	setTestimonialETag(c, result.Data)
+	c.JSON(http.StatusOK, result)
+}
+
+// ActivateTestimonial активировает отзыв
+// @Summary Активировать отзыв
+// @Description Активирует отзыв: одобренный отзыв снова показывается на сайте
+// @Tags testimonials
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
+// @Success 200 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/activate [patch]
+func (h *TestimonialHTTPHandler) ActivateTestimonial(c *gin.Context) {
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	cmd := dtos.ActivateTestimonialCommand{ID: c.Param("id"), ExpectedVersion: expectedVersion}
+	result, err := h.commandHandlers.ActivateTestimonial(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(moderationErrorStatus(err), result)
+		return
+	}
+
+	setTestimonialETag(c, result.Data)
+	c.JSON(http.StatusOK, result)
+}
+
+// DeactivateTestimonial деактивировает отзыв
+// @Summary Деактивировать отзыв
+// @Description Скрывает отзыв с сайта без смены статуса модерации
+// @Tags testimonials
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param id path string true "ID отзыва"
+// @Param If-Match header string true "ETag из GET /testimonials/{id}; * - без проверки версии"
+// @Success 200 {object} dtos.CommandResult
+// @Header 200 {string} ETag "Новая версия отзыва"
+// @Failure 404 {object} dtos.CommandResult
+// @Failure 412 {object} dtos.CommandResult "Отзыв изменен после чтения"
+// @Failure 428 {object} models.ErrorResponse "Нет If-Match"
+// @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/{id}/deactivate [patch]
+func (h *TestimonialHTTPHandler) DeactivateTestimonial(c *gin.Context) {
+	expectedVersion, ok := ifMatchVersion(c)
+	if !ok {
+		return
+	}
+
+	cmd := dtos.DeactivateTestimonialCommand{ID: c.Param("id"), ExpectedVersion: expectedVersion}
+	result, err := h.commandHandlers.DeactivateTestimonial(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(moderationErrorStatus(err), result)
+		return
+	}
+
+	setTestimonialETag(c, result.Data)
+	c.JSON(http.StatusOK, result)
+}
+
+// BulkApproveTestimonials массовое одобрение отзывов
+// @Summary Массовое одобрение отзывов
+// @Description Одобряет отзывы в статусе in_review с подтвержденным email автора; остальные отзывы получают ошибку в results.
+// @Description Не больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).
+// @Tags testimonials
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param ids body dtos.BulkApproveTestimonialsCommand true "Список ID"
+// @Success 200 {object} dtos.BatchCommandResult
+// @Failure 400 {object} dtos.BatchCommandResult "Пустой пакет или больше 100 ID"
+// @Failure 409 {object} dtos.BatchCommandResult "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом"
+// @Failure 500 {object} dtos.BatchCommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/bulk/approve [patch]
+func (h *TestimonialHTTPHandler) BulkApproveTestimonials(c *gin.Context) {
+	var cmd dtos.BulkApproveTestimonialsCommand
+	if !bindBatch(c, &cmd) {
+		return
+	}
+
+	result, err := h.commandHandlers.BulkApproveTestimonials(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(batchErrorStatus(err), result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// BulkActivateTestimonials массовая активация отзывов
+// @Summary Массовая активация отзывов
+// @Description Активирует отзывы: одобренные снова показываются на сайте.
+// @Description Не больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).
+// @Tags testimonials
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param ids body dtos.BulkActivateTestimonialsCommand true "Список ID"
+// @Success 200 {object} dtos.BatchCommandResult
+// @Failure 400 {object} dtos.BatchCommandResult "Пустой пакет или больше 100 ID"
+// @Failure 409 {object} dtos.BatchCommandResult "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом"
+// @Failure 500 {object} dtos.BatchCommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/bulk/activate [patch]
+func (h *TestimonialHTTPHandler) BulkActivateTestimonials(c *gin.Context) {
+	var cmd dtos.BulkActivateTestimonialsCommand
+	if !bindBatch(c, &cmd) {
+		return
+	}
+
+	result, err := h.commandHandlers.BulkActivateTestimonials(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(batchErrorStatus(err), result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// BulkDeactivateTestimonials массовая деактивация отзывов
+// @Summary Массовая деактивация отзывов
+// @Description Скрывает отзывы с сайта без смены статуса модерации.
+// @Description Не больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).
+// @Tags testimonials
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param ids body dtos.BulkDeactivateTestimonialsCommand true "Список ID"
+// @Success 200 {object} dtos.BatchCommandResult
+// @Failure 400 {object} dtos.BatchCommandResult "Пустой пакет или больше 100 ID"
+// @Failure 409 {object} dtos.BatchCommandResult "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом"
+// @Failure 500 {object} dtos.BatchCommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/bulk/deactivate [patch]
+func (h *TestimonialHTTPHandler) BulkDeactivateTestimonials(c *gin.Context) {
+	var cmd dtos.BulkDeactivateTestimonialsCommand
+	if !bindBatch(c, &cmd) {
+		return
+	}
+
+	result, err := h.commandHandlers.BulkDeactivateTestimonials(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(batchErrorStatus(err), result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// BulkDeleteTestimonials массовое удаление отзывов
+// @Summary Массовое удаление отзывов
+// @Description Переносит отзывы в корзину; файлы удаляются при очистке корзины.
+// @Description Не больше 100 ID за запрос; результат содержит исход по каждому ID. С atomic=true изменяются все отзывы или ни одного (409).
+// @Tags testimonials
+// @Accept json
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param ids body dtos.BulkDeleteTestimonialsCommand true "Список ID"
+// @Success 200 {object} dtos.BatchCommandResult
+// @Failure 400 {object} dtos.BatchCommandResult "Пустой пакет или больше 100 ID"
+// @Failure 409 {object} dtos.BatchCommandResult "atomic=true и часть отзывов не изменена или отзыв изменен параллельным запросом"
+// @Failure 500 {object} dtos.BatchCommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/bulk/delete [delete]
+func (h *TestimonialHTTPHandler) BulkDeleteTestimonials(c *gin.Context) {
+	var cmd dtos.BulkDeleteTestimonialsCommand
+	if !bindBatch(c, &cmd) {
+		return
+	}
+
+	result, err := h.commandHandlers.BulkDeleteTestimonials(c.Request.Context(), cmd)
+	if err != nil {
+		c.JSON(batchErrorStatus(err), result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// bindBatch разбирает тело пакетной команды; при ошибке отвечает 400 и возвращает false
+func bindBatch(c *gin.Context, cmd interface{}) bool {
+	if err := c.ShouldBindJSON(cmd); err != nil {
+		c.JSON(http.StatusBadRequest, dtos.BatchCommandResult{
+			Errors:    []string{err.Error()},
+			Timestamp: time.Now(),
+		})
+		return false
+	}
+	return true
+}
+
+// batchErrorStatus возвращает HTTP статус для ошибки пакетной команды
+func batchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, appModels.ErrBatchEmpty), errors.Is(err, appModels.ErrBatchTooLarge):
+		return http.StatusBadRequest
+	case errors.Is(err, appModels.ErrBatchIncomplete), errors.Is(err, entities.ErrVersionConflict):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// GetModerationQueue получает очередь модерации
+// @Summary Очередь модерации отзывов
+// @Description Возвращает отзывы в статусах submitted и in_review: дольше всех ожидающие решения идут первыми
+// @Tags testimonials
+// @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
+// @Param limit query int false "Лимит записей" default(20)
+// @Param offset query int false "Смещение" default(0)
+// @Param status query string false "Только отзывы в этом статусе; suspected_spam - очередь подозрительных на спам" Enums(submitted, in_review, suspected_spam)
+// @Success 200 {object} dtos.QueryResult
+// @Failure 400 {object} dtos.QueryResult
+// @Failure 500 {object} dtos.QueryResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
+// @Router /testimonials/moderation-queue [get]
+func (h *TestimonialHTTPHandler) GetModerationQueue(c *gin.Context) {
+	var query dtos.GetModerationQueueQuery
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil {
+			query.Limit = limit
+		}
+	}
+
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if offset, err := strconv.Atoi(offsetStr); err == nil {
+			query.Offset = offset
 		}
+	}
+
+	query.Status = entities.TestimonialStatus(c.Query("status"))
+
+	result, err := h.queryHandlers.GetModerationQueue(c.Request.Context(), query)
+	if err != nil {
+		if errors.Is(err, queries.ErrNotInModerationQueue) {
+			c.JSON(http.StatusBadRequest, result)
+			return
+		}
+		c.JSON(repositoryErrorStatus(err, http.StatusInternalServerError), result)
 		return
 	}
 
 	c.JSON(http.StatusOK, result)
 }
 
+// moderationErrorStatus возвращает HTTP статус для ошибок команд модерации
+func moderationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, entities.ErrInvalidStatusTransition), errors.Is(err, entities.ErrEmailNotVerified):
+		return http.StatusConflict
+	case errors.Is(err, entities.ErrInvalidRejectionReason):
+		return http.StatusBadRequest
+	case errors.Is(err, entities.ErrVersionConflict):
+		return http.StatusPreconditionFailed
+	case strings.Contains(err.Error(), "not found"):
+		return http.StatusNotFound
+	}
+	return repositoryErrorStatus(err, http.StatusInternalServerError)
+}
+
 // DeleteTestimonial удаляет отзыв
 // @Summary Удалить отзыв
 // @Description Удаляет отзыв по ID
 // @Tags testimonials
 // @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param id path string true "ID отзыва"
 // @Success 200 {object} dtos.CommandResult
 // @Failure 404 {object} dtos.CommandResult
 // @Failure 500 {object} dtos.CommandResult
+// @Failure 401 {object} models.ErrorResponse
+// @Failure 403 {object} models.ErrorResponse
 // @Router /testimonials/{id} [delete]
 func (h *TestimonialHTTPHandler) DeleteTestimonial(c *gin.Context) {
 	id := c.Param("id")
@@ -363,13 +1112,33 @@ func RegisterTestimonialRoutes(router *gin.Engine, handler *TestimonialHTTPHandl
 	{
 		// Публичные маршруты
 		testimonialGroup.POST("", handler.CreateTestimonial)
+		testimonialGroup.GET("/verify", handler.VerifyTestimonialEmail)
 
 		// Маршруты для управления отзывами
 		testimonialGroup.GET("", handler.GetTestimonials)
+		testimonialGroup.GET("/moderation-queue", handler.GetModerationQueue)
 		testimonialGroup.GET("/:id", handler.GetTestimonialByID)
 		testimonialGroup.PUT("/:id", handler.UpdateTestimonial)
 		testimonialGroup.DELETE("/:id", handler.DeleteTestimonial)
+		testimonialGroup.PATCH("/:id/review", handler.StartTestimonialReview)
 		testimonialGroup.PATCH("/:id/approve", handler.ApproveTestimonial)
+		testimonialGroup.PATCH("/:id/reject", handler.RejectTestimonial)
+		testimonialGroup.PATCH("/:id/archive", handler.ArchiveTestimonial)
+		testimonialGroup.PATCH("/:id/activate", handler.ActivateTestimonial)
+		testimonialGroup.PATCH("/:id/deactivate", handler.DeactivateTestimonial)
+		testimonialGroup.POST("/:id/verification", handler.ResendTestimonialVerification)
+
+		// Пакетные команды, не больше appModels.MaxBatchSize ID
+		testimonialGroup.PATCH("/bulk/approve", handler.BulkApproveTestimonials)
+		testimonialGroup.PATCH("/bulk/activate", handler.BulkActivateTestimonials)
+		testimonialGroup.PATCH("/bulk/deactivate", handler.BulkDeactivateTestimonials)
+		testimonialGroup.DELETE("/bulk/delete", handler.BulkDeleteTestimonials)
+
+		// Файл отзыва
+		testimonialGroup.GET("/:id/file", handler.GetTestimonialFile)
+		testimonialGroup.GET("/:id/file/url", handler.GetTestimonialFileURL)
+		testimonialGroup.PUT("/:id/file", handler.UploadTestimonialFile)
+		testimonialGroup.DELETE("/:id/file", handler.DeleteTestimonialFile)
 
 	}
 }
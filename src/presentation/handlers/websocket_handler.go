@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"log"
 	"net/http"
 	"time"
 
@@ -45,6 +46,8 @@ func (h *WebSocketHandler) HandleWebSocket(c *gin.Context) {
 // @Description Возвращает статистику WebSocket подключений и подписок
 // @Tags WebSocket
 // @Produce json
+// @Security OAuth2AccessCode[api:read]
+// @Security ApiKeyAuth
 // @Success 200 {object} map[string]interface{}
 // @Router /ws/stats [get]
 func (h *WebSocketHandler) GetWebSocketStats(c *gin.Context) {
@@ -57,18 +60,25 @@ func (h *WebSocketHandler) GetWebSocketStats(c *gin.Context) {
 // @Description Отправляет тестовое уведомление всем подключенным клиентам
 // @Tags WebSocket
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Success 200 {object} gin.H
 // @Router /ws/test [post]
 func (h *WebSocketHandler) SendTestNotification(c *gin.Context) {
-	h.notificationService.NotifySystemEvent(c.Request.Context(), "test", map[string]interface{}{
+	err := h.notificationService.NotifySystemEvent(c.Request.Context(), "test", map[string]interface{}{
 		"message":   "This is a test notification",
 		"timestamp": time.Now(),
 		"from":      "API",
 	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
 
+	// Уведомление доставляется через outbox, клиенты получают его в течение OUTBOX_POLL_INTERVAL
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
-		"message": "Test notification sent",
+		"message": "Test notification queued",
 	})
 }
 
@@ -78,6 +88,8 @@ func (h *WebSocketHandler) SendTestNotification(c *gin.Context) {
 // @Tags WebSocket
 // @Accept json
 // @Produce json
+// @Security OAuth2AccessCode[api:write]
+// @Security ApiKeyAuth
 // @Param message body BroadcastMessageRequest true "Сообщение для отправки"
 // @Success 200 {object} gin.H
 // @Router /ws/broadcast [post]
@@ -95,7 +107,10 @@ func (h *WebSocketHandler) BroadcastMessage(c *gin.Context) {
 		Timestamp: time.Now(),
 	}
 
-	h.hub.BroadcastToAll(message)
+	if err := h.hub.BroadcastToAll(message); err != nil {
+		// Клиенты этого экземпляра сообщение уже получили
+		log.Printf("Failed to broadcast message to other nodes: %v", err)
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
@@ -109,16 +124,20 @@ func (h *WebSocketHandler) BroadcastMessage(c *gin.Context) {
 // @Description Возвращает подробную информацию о WebSocket подключениях
 // @Tags WebSocket
 // @Produce json
+// @Security OAuth2AccessCode[api:read]
+// @Security ApiKeyAuth
 // @Success 200 {object} ConnectionInfoResponse
 // @Router /ws/info [get]
 func (h *WebSocketHandler) GetConnectionInfo(c *gin.Context) {
 	stats := h.notificationService.GetStats()
 
 	response := ConnectionInfoResponse{
-		Enabled:        stats["enabled"].(bool),
-		TotalClients:   stats["connections"].(int),
-		FAQSubscribers: stats["faq_subscribers"].(int),
-		ServerTime:     time.Now(),
+		Enabled:                stats["enabled"].(bool),
+		TotalClients:           stats["connections"].(int),
+		FAQSubscribers:         stats["faq_subscribers"].(int),
+		FeatureSubscribers:     stats["feature_subscribers"].(int),
+		TestimonialSubscribers: stats["testimonial_subscribers"].(int),
+		ServerTime:             time.Now(),
 		AvailableEvents: []string{
 			"faq.created",
 			"faq.updated",
@@ -129,11 +148,27 @@ func (h *WebSocketHandler) GetConnectionInfo(c *gin.Context) {
 			"faq.category_changed",
 			"faq.batch_created",
 			"faq.batch_deleted",
+			"feature.created",
+			"feature.updated",
+			"feature.deleted",
+			"feature.activated",
+			"feature.deactivated",
+			"feature.reordered",
+			"testimonial.submitted",
+			"testimonial.in_review",
+			"testimonial.approved",
+			"testimonial.rejected",
+			"testimonial.archived",
+			"testimonial.suspected_spam",
 		},
 		SubscriptionTypes: []string{
-			"faq",    // Все FAQ события
-			"faq:ID", // События конкретного FAQ
-			"system", // Системные события
+			"faq",            // Все FAQ события
+			"faq:ID",         // События конкретного FAQ
+			"feature",        // Все события Feature
+			"feature:ID",     // События конкретной Feature
+			"testimonial",    // Переходы отзывов между статусами модерации
+			"testimonial:ID", // События конкретного отзыва
+			"system",         // Системные события
 		},
 	}
 
@@ -148,12 +183,14 @@ type BroadcastMessageRequest struct {
 
 // ConnectionInfoResponse ответ с информацией о подключениях
 type ConnectionInfoResponse struct {
-	Enabled           bool      `json:"enabled"`
-	TotalClients      int       `json:"totalClients"`
-	FAQSubscribers    int       `json:"faqSubscribers"`
-	ServerTime        time.Time `json:"serverTime"`
-	AvailableEvents   []string  `json:"availableEvents"`
-	SubscriptionTypes []string  `json:"subscriptionTypes"`
+	Enabled                bool      `json:"enabled"`
+	TotalClients           int       `json:"totalClients"`
+	FAQSubscribers         int       `json:"faqSubscribers"`
+	FeatureSubscribers     int       `json:"featureSubscribers"`
+	TestimonialSubscribers int       `json:"testimonialSubscribers"`
+	ServerTime             time.Time `json:"serverTime"`
+	AvailableEvents        []string  `json:"availableEvents"`
+	SubscriptionTypes      []string  `json:"subscriptionTypes"`
 }
 
 // GetHub возвращает WebSocket хаб
@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"tax-priority-api/src/application/apikeys"
+	"tax-priority-api/src/domain/entities"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHeader заголовок, в котором серверные клиенты передают API ключ
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuthenticator проверяет API ключ и возвращает его запись
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, key string) (*entities.APIKey, error)
+}
+
+// APIKeyMiddleware аутентифицирует запросы с заголовком X-API-Key; подключается перед AuthMiddleware.
+// Ключ получает свои scopes без ролей, поэтому маршруты, доступные только по роли, ему закрыты.
+// Запросы без заголовка передаются дальше без изменений.
+func APIKeyMiddleware(authenticator APIKeyAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(APIKeyHeader)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		if c.GetHeader("Authorization") != "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Use either Authorization or " + APIKeyHeader + " header"})
+			return
+		}
+
+		apiKey, err := authenticator.Authenticate(c.Request.Context(), key)
+		if errors.Is(err, apikeys.ErrInvalidAPIKey) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid API key"})
+			return
+		}
+		if err != nil {
+			log.Printf("API key authentication failed: %v", err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to verify API key"})
+			return
+		}
+
+		setAuthClaims(c, &AuthClaims{
+			Subject:  "apikey:" + apiKey.ID,
+			Username: "apikey:" + apiKey.Name,
+			Scopes:   apiKey.Scopes,
+		})
+
+		c.Next()
+	}
+}
@@ -2,59 +2,30 @@ package middlewares
 
 import (
 	"context"
-	"crypto/rsa"
-	"fmt"
+	"errors"
 	"net/http"
 	"strings"
-	"time"
+
+	"tax-priority-api/src/infrastructure/auth"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
-	"github.com/lestrrat-go/jwx/v3/jwk"
-)
-
-const (
-	keycloakIssuer = "http://localhost:8080/realms/master"                               // Ваш issuer
-	jwksURL        = "http://localhost:8080/realms/master/protocol/openid-connect/certs" // JWKS endpoint
-	audience       = "golang-api"                                                        // Audience из Keycloak
 )
 
-var keySet jwk.Set
-
-func FetchJWKS() error {
-	ctx := context.Background()
-	set, err := jwk.Fetch(ctx, jwksURL)
-	if err != nil {
-		return fmt.Errorf("failed to fetch JWKS: %w", err)
-	}
-	keySet = set
-	return nil
+// TokenVerifier проверяет access token и возвращает его claims
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (jwt.MapClaims, error)
 }
 
-func StartJWKSRefresh() {
-	if err := FetchJWKS(); err != nil {
-		fmt.Printf("Initial JWKS fetch failed: %v\n", err)
-	}
-
-	go func() {
-		for range time.Tick(1 * time.Hour) {
-			if err := FetchJWKS(); err != nil {
-				fmt.Printf("JWKS refresh failed: %v\n", err)
-			}
-		}
-	}()
-}
-
-func AuthMiddleware() gin.HandlerFunc {
+// AuthMiddleware проверяет Bearer токен, если он передан, и сохраняет в контексте его claims.
+// Запросы без токена проходят анонимно: доступ к маршрутам определяют политики (см. Authorize).
+// Неверный токен отклоняется и на публичных маршрутах, чтобы клиент узнал об ошибке.
+// Роли клиента читаются из resource_access.<clientID>.
+func AuthMiddleware(verifier TokenVerifier, clientID string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if c.Request.URL.Path == "/" || strings.HasPrefix(c.Request.URL.Path, "/swagger") {
-			c.Next()
-			return
-		}
-
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header missing"})
+			c.Next()
 			return
 		}
 
@@ -64,78 +35,42 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-
-			kid, ok := token.Header["kid"].(string)
-			if !ok {
-				return nil, fmt.Errorf("kid not found")
-			}
-
-			key, found := keySet.LookupKeyID(kid)
-			if !found {
-				return nil, fmt.Errorf("key not found")
-			}
-
-			var rawKey rsa.PublicKey
-			if err := jwk.Export(key, &rawKey); err != nil {
-				return nil, fmt.Errorf("failed to export public key: %w", err)
-			}
-			return &rawKey, nil
-		})
-
-		if err != nil || !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			return
-		}
-
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid claims"})
-			return
-		}
-
-		iss, ok := claims["iss"].(string)
-		if !ok || iss != keycloakIssuer {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid issuer"})
+		tokenClaims, err := verifier.Verify(c.Request.Context(), tokenStr)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": tokenErrorMessage(err)})
 			return
 		}
+		setAuthClaims(c, newAuthClaims(tokenClaims, clientID))
 
-		aud, ok := claims["aud"].([]interface{})
-		if !ok {
-			audStr, ok := claims["aud"].(string)
-			if ok {
-				aud = []interface{}{audStr}
-			}
-		}
-		audienceFound := false
-		for _, a := range aud {
-			if a == audience {
-				audienceFound = true
-				break
-			}
-		}
-		if !audienceFound {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid audience"})
-			return
-		}
-
-		if scopes, ok := claims["scope"].(string); ok {
-			if !strings.Contains(scopes, "api:read") {
-				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient scopes"})
-				return
-			}
-		} else {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "No scopes in token"})
-			return
-		}
-
-		if username, ok := claims["preferred_username"].(string); ok {
-			c.Set("user", username)
-		}
+		c.Next()
+	}
+}
 
+// NoAuthMiddleware заменяет AuthMiddleware при AUTH_ENABLED=false: каждый запрос получает
+// все scopes и роли API, поэтому политики пропускают его, а обработчики отдают и неопубликованные данные
+func NoAuthMiddleware() gin.HandlerFunc {
+	claims := &AuthClaims{
+		Subject:  "local",
+		Username: "local",
+		Scopes:   []string{ScopeRead, ScopeWrite},
+		Roles:    []string{RoleContentEditor, RoleModerator},
+	}
+	return func(c *gin.Context) {
+		setAuthClaims(c, claims)
 		c.Next()
 	}
 }
+
+// tokenErrorMessage сообщение клиенту о причине отклонения токена без подробностей проверки подписи
+func tokenErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return "token expired"
+	case errors.Is(err, jwt.ErrTokenInvalidAudience):
+		return "invalid audience"
+	case errors.Is(err, auth.ErrUnknownIssuer):
+		return "invalid issuer"
+	default:
+		return "invalid token"
+	}
+}
@@ -0,0 +1,93 @@
+package middlewares
+
+import (
+	"slices"
+	"strings"
+
+	"tax-priority-api/src/application/identity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// authClaimsKey ключ контекста gin, под которым AuthMiddleware сохраняет claims токена
+const authClaimsKey = "authClaims"
+
+// AuthClaims данные проверенного токена, нужные для авторизации
+type AuthClaims struct {
+	Subject  string
+	Username string
+	Scopes   []string
+	// Roles роли realm и роли клиента API из resource_access
+	Roles []string
+}
+
+// newAuthClaims извлекает scopes и роли Keycloak: realm_access.roles и resource_access.<clientID>.roles
+func newAuthClaims(claims jwt.MapClaims, clientID string) *AuthClaims {
+	result := &AuthClaims{}
+	result.Subject, _ = claims["sub"].(string)
+	result.Username, _ = claims["preferred_username"].(string)
+
+	if scope, ok := claims["scope"].(string); ok {
+		result.Scopes = strings.Fields(scope)
+	}
+
+	if realmAccess, ok := claims["realm_access"].(map[string]interface{}); ok {
+		result.Roles = append(result.Roles, stringList(realmAccess["roles"])...)
+	}
+	if resourceAccess, ok := claims["resource_access"].(map[string]interface{}); ok {
+		if client, ok := resourceAccess[clientID].(map[string]interface{}); ok {
+			result.Roles = append(result.Roles, stringList(client["roles"])...)
+		}
+	}
+
+	return result
+}
+
+// HasScope проверяет наличие scope в токене
+func (a *AuthClaims) HasScope(scope string) bool {
+	return slices.Contains(a.Scopes, scope)
+}
+
+// HasRole проверяет наличие роли realm или клиента
+func (a *AuthClaims) HasRole(role string) bool {
+	return slices.Contains(a.Roles, role)
+}
+
+// setAuthClaims сохраняет claims аутентифицированного запроса в контексте gin для политик
+// и клиента запроса в context.Context для команд, которые заполняют поля автора
+func setAuthClaims(c *gin.Context, claims *AuthClaims) {
+	c.Set(authClaimsKey, claims)
+
+	principal := &identity.Principal{
+		Subject:  claims.Subject,
+		Username: claims.Username,
+		Roles:    claims.Roles,
+	}
+	c.Request = c.Request.WithContext(identity.WithPrincipal(c.Request.Context(), principal))
+}
+
+// ClaimsFromContext возвращает claims аутентифицированного запроса или nil для анонимного
+func ClaimsFromContext(c *gin.Context) *AuthClaims {
+	value, ok := c.Get(authClaimsKey)
+	if !ok {
+		return nil
+	}
+	claims, _ := value.(*AuthClaims)
+	return claims
+}
+
+func stringList(value interface{}) []string {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
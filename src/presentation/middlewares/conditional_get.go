@@ -0,0 +1,119 @@
+package middlewares
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	appCache "tax-priority-api/src/application/cache"
+	"tax-priority-api/src/infrastructure/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HTTPCacheConfig время, на которое браузеры и CDN могут кешировать публичные ответы без перепроверки
+type HTTPCacheConfig struct {
+	FAQListMaxAge       time.Duration
+	FAQCategoriesMaxAge time.Duration
+}
+
+func NewHTTPCacheConfig() *HTTPCacheConfig {
+	return &HTTPCacheConfig{
+		FAQListMaxAge:       config.GetEnvDuration("HTTP_CACHE_FAQ_MAX_AGE", time.Minute),
+		FAQCategoriesMaxAge: config.GetEnvDuration("HTTP_CACHE_FAQ_CATEGORIES_MAX_AGE", 5*time.Minute),
+	}
+}
+
+// ConditionalGET поддерживает условные GET для списков: ETag и Last-Modified строятся по поколению
+// области кеша, поэтому ответ 304 отдается без запросов к базе
+type ConditionalGET struct {
+	generations appCache.GenerationStore
+	Config      *HTTPCacheConfig
+}
+
+func NewConditionalGET(generations appCache.GenerationStore, config *HTTPCacheConfig) *ConditionalGET {
+	return &ConditionalGET{generations: generations, Config: config}
+}
+
+// Handler выставляет ETag, Last-Modified и Cache-Control ответа и отвечает 304 на If-None-Match
+// или If-Modified-Since, если данные области scope не менялись. Анонимным клиентам разрешено
+// кешировать ответ на maxAge, клиентам с правами api:read - только с перепроверкой.
+func (m *ConditionalGET) Handler(scope string, maxAge time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		generation, err := m.generations.Current(c.Request.Context(), scope)
+		if err != nil {
+			// Без поколения ответ нельзя проверить, но его можно отдать
+			c.Header("Cache-Control", "no-cache")
+			c.Next()
+			return
+		}
+
+		// Права клиента меняют содержимое списка, поэтому входят в ETag
+		privileged := Allowed(c, ReaderPolicy)
+		etag := generationETag(scope, generation, c.Request, privileged)
+		lastModified := generation.ModifiedAt.UTC().Truncate(time.Second)
+
+		c.Header("ETag", etag)
+		c.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+		c.Header("Vary", "Authorization, "+APIKeyHeader)
+		if privileged {
+			c.Header("Cache-Control", "private, no-cache")
+		} else {
+			c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+		}
+
+		if notModified(c.Request, etag, lastModified) {
+			c.AbortWithStatus(http.StatusNotModified)
+			return
+		}
+
+		c.Writer = &validatedWriter{ResponseWriter: c.Writer}
+		c.Next()
+	}
+}
+
+// generationETag слабый ETag ответа: одно поколение и один запрос дают одинаковый по смыслу список
+func generationETag(scope string, generation appCache.Generation, r *http.Request, privileged bool) string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%d\n%s\n%s\n%t", scope, generation.Value, r.URL.Path, r.URL.Query().Encode(), privileged)
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// notModified проверяет условия запроса; If-Modified-Since учитывается только без If-None-Match
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+			// If-None-Match сравнивает ETag без учета признака слабого W/
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince := r.Header.Get("If-Modified-Since"); ifModifiedSince != "" {
+		since, err := http.ParseTime(ifModifiedSince)
+		return err == nil && !lastModified.After(since)
+	}
+
+	return false
+}
+
+// validatedWriter убирает валидаторы из ответов кроме 200: ETag описывает только успешный список
+type validatedWriter struct {
+	gin.ResponseWriter
+}
+
+func (w *validatedWriter) WriteHeader(code int) {
+	if code != http.StatusOK {
+		header := w.Header()
+		header.Del("ETag")
+		header.Del("Last-Modified")
+		header.Set("Cache-Control", "no-store")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
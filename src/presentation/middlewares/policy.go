@@ -0,0 +1,167 @@
+package middlewares
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Scopes OAuth2 и роли Keycloak, которые используют политики API
+const (
+	ScopeRead         = "api:read"
+	ScopeWrite        = "api:write"
+	RoleContentEditor = "content-editor"
+	RoleModerator     = "moderator"
+	RoleAdmin         = "admin"
+)
+
+// Policy правило доступа к маршруту.
+// Запрос разрешен, если политика публичная, либо токен содержит все Scopes, либо одну из Roles.
+// Политика без Scopes и Roles пропускает любой аутентифицированный запрос.
+type Policy struct {
+	Public bool
+	Scopes []string
+	Roles  []string
+}
+
+var (
+	// PublicPolicy доступ без аутентификации
+	PublicPolicy = Policy{Public: true}
+	// ReaderPolicy чтение неопубликованных данных: неактивных FAQ, отзывов на модерации, корзины и истории
+	ReaderPolicy = Policy{Scopes: []string{ScopeRead}, Roles: []string{RoleContentEditor, RoleModerator}}
+	// ContentEditorPolicy изменение FAQ и преимуществ
+	ContentEditorPolicy = Policy{Scopes: []string{ScopeWrite}, Roles: []string{RoleContentEditor}}
+	// ModeratorPolicy модерация отзывов
+	ModeratorPolicy = Policy{Scopes: []string{ScopeWrite}, Roles: []string{RoleModerator}}
+	// AdminPolicy управление доступом к API; только по роли, чтобы API ключ не мог выпускать ключи
+	AdminPolicy = Policy{Roles: []string{RoleAdmin}}
+)
+
+// Allows проверяет, разрешает ли политика запрос с claims; nil - анонимный запрос
+func (p Policy) Allows(claims *AuthClaims) bool {
+	if p.Public {
+		return true
+	}
+	if claims == nil {
+		return false
+	}
+	if len(p.Scopes) == 0 && len(p.Roles) == 0 {
+		return true
+	}
+
+	if len(p.Scopes) > 0 {
+		hasScopes := true
+		for _, scope := range p.Scopes {
+			if !claims.HasScope(scope) {
+				hasScopes = false
+				break
+			}
+		}
+		if hasScopes {
+			return true
+		}
+	}
+
+	for _, role := range p.Roles {
+		if claims.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allowed проверяет политику для текущего запроса; используется обработчиками,
+// которые отдают анонимным клиентам только опубликованные данные
+func Allowed(c *gin.Context, policy Policy) bool {
+	return policy.Allows(ClaimsFromContext(c))
+}
+
+// Authorize отклоняет запрос, не удовлетворяющий политике:
+// 401 для анонимного запроса, 403 при недостаточных правах
+func Authorize(policy Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims := ClaimsFromContext(c)
+		if policy.Allows(claims) {
+			c.Next()
+			return
+		}
+
+		if claims == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Insufficient scopes or roles"})
+	}
+}
+
+// RequireScopes пропускает запросы с токеном, содержащим все scopes
+func RequireScopes(scopes ...string) gin.HandlerFunc {
+	return Authorize(Policy{Scopes: scopes})
+}
+
+// RequireRoles пропускает запросы с токеном, содержащим одну из ролей
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	return Authorize(Policy{Roles: roles})
+}
+
+// RoutePolicy политика для маршрута gin: метод и шаблон пути, например GET /api/faqs/:id
+type RoutePolicy struct {
+	Method string
+	Path   string
+	Policy Policy
+}
+
+// PolicyTable таблица политик маршрутов; для маршрутов вне таблицы действует fallback
+type PolicyTable struct {
+	policies map[string]Policy
+	fallback Policy
+}
+
+// NewPolicyTable создает таблицу политик
+func NewPolicyTable(fallback Policy, routes ...RoutePolicy) *PolicyTable {
+	table := &PolicyTable{
+		policies: make(map[string]Policy, len(routes)),
+		fallback: fallback,
+	}
+	for _, route := range routes {
+		table.policies[route.Method+" "+route.Path] = route.Policy
+	}
+	return table
+}
+
+// Lookup возвращает политику маршрута и признак того, что она задана в таблице
+func (t *PolicyTable) Lookup(method, path string) (Policy, bool) {
+	policy, ok := t.policies[method+" "+path]
+	if !ok {
+		return t.fallback, false
+	}
+	return policy, true
+}
+
+// Middleware применяет политику найденного маршрута. Подключается через router.Use:
+// gin выбирает маршрут до запуска цепочки, поэтому шаблон пути доступен через c.FullPath().
+func (t *PolicyTable) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Несуществующий маршрут: gin ответит 404
+		if c.FullPath() == "" {
+			c.Next()
+			return
+		}
+
+		policy, _ := t.Lookup(c.Request.Method, c.FullPath())
+		Authorize(policy)(c)
+	}
+}
+
+// Missing возвращает зарегистрированные маршруты, для которых в таблице нет политики
+func (t *PolicyTable) Missing(routes gin.RoutesInfo) []string {
+	var missing []string
+	for _, route := range routes {
+		if _, ok := t.Lookup(route.Method, route.Path); !ok {
+			missing = append(missing, route.Method+" "+route.Path)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
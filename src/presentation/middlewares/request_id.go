@@ -0,0 +1,33 @@
+package middlewares
+
+import (
+	"regexp"
+
+	"tax-priority-api/src/application/identity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader заголовок с идентификатором запроса; сервер возвращает его в ответе
+const RequestIDHeader = "X-Request-ID"
+
+// validRequestID ограничивает идентификатор клиента, чтобы он безопасно попадал в журнал аудита и логи
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestInfoMiddleware сохраняет в контексте запроса его идентификатор и IP клиента.
+// Идентификатор берется из X-Request-ID (например, от балансировщика) или генерируется.
+func RequestInfoMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.New().String()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		info := identity.RequestInfo{ID: requestID, ClientIP: c.ClientIP()}
+		c.Request = c.Request.WithContext(identity.WithRequestInfo(c.Request.Context(), info))
+
+		c.Next()
+	}
+}
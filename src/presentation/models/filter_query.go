@@ -0,0 +1,148 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	appModels "tax-priority-api/src/application/models"
+)
+
+const (
+	// maxFilterConditions ограничение на количество условий в одном запросе
+	maxFilterConditions = 20
+	// maxFilterOrGroups ограничение на количество OR-подгрупп
+	maxFilterOrGroups = 10
+)
+
+var (
+	filterParamPattern   = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*)\[([a-z]+)\]$`)
+	filterOrParamPattern = regexp.MustCompile(`^_or\[(\d+)\]\[([A-Za-z][A-Za-z0-9_]*)\]\[([a-z]+)\]$`)
+)
+
+// FilterFields белый список полей, по которым клиент может фильтровать список
+type FilterFields map[string]struct{}
+
+// NewFilterFields создает белый список из имен полей в camelCase
+func NewFilterFields(fields ...string) FilterFields {
+	return FilterFields{}.With(fields...)
+}
+
+// With возвращает копию списка с добавленными полями
+func (f FilterFields) With(fields ...string) FilterFields {
+	result := make(FilterFields, len(f)+len(fields))
+	for field := range f {
+		result[field] = struct{}{}
+	}
+	for _, field := range fields {
+		result[field] = struct{}{}
+	}
+	return result
+}
+
+// Allows проверяет, что по полю разрешено фильтровать
+func (f FilterFields) Allows(field string) bool {
+	_, ok := f[field]
+	return ok
+}
+
+// ParseFilterQuery разбирает условия фильтрации из query string.
+//
+// Синтаксис:
+//
+//	field[op]=value            условие; все такие условия объединяются через AND
+//	_or[n][field][op]=value    условие n-й подгруппы; условия внутри подгруппы объединяются через AND,
+//	                           подгруппы между собой - через OR
+//
+// Операторы: eq, ne, gt, gte, lt, lte, in, nin, like, ilike, between, null.
+// Для in, nin и between значения перечисляются через запятую, для null передается true или false.
+// Параметры без квадратных скобок не обрабатываются. Поле вне allowed - ошибка: служебные колонки
+// не должны становиться условием запроса. Возвращает nil, если условий нет.
+func ParseFilterQuery(values url.Values, allowed FilterFields) (*appModels.FilterGroup, error) {
+	root := appModels.NewFilterGroup(appModels.FilterAnd)
+	orGroups := make(map[int]*appModels.FilterGroup)
+	count := 0
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var (
+			group     *appModels.FilterGroup
+			field, op string
+		)
+
+		if match := filterParamPattern.FindStringSubmatch(key); match != nil {
+			group, field, op = root, match[1], match[2]
+		} else if match := filterOrParamPattern.FindStringSubmatch(key); match != nil {
+			index, err := strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter group in %q", key)
+			}
+			if _, exists := orGroups[index]; !exists {
+				if len(orGroups) >= maxFilterOrGroups {
+					return nil, fmt.Errorf("too many filter groups, maximum is %d", maxFilterOrGroups)
+				}
+				orGroups[index] = appModels.NewFilterGroup(appModels.FilterAnd)
+			}
+			group, field, op = orGroups[index], match[2], match[3]
+		} else {
+			continue
+		}
+
+		if !allowed.Allows(field) {
+			return nil, fmt.Errorf("filtering by %q is not allowed", field)
+		}
+
+		operator := appModels.FilterOperator(op)
+		if !operator.IsValid() {
+			return nil, fmt.Errorf("unsupported filter operator %q in %q", op, key)
+		}
+
+		for _, raw := range values[key] {
+			count++
+			if count > maxFilterConditions {
+				return nil, fmt.Errorf("too many filter conditions, maximum is %d", maxFilterConditions)
+			}
+			group.Add(field, operator, filterValue(operator, raw))
+		}
+	}
+
+	if len(orGroups) > 0 {
+		indexes := make([]int, 0, len(orGroups))
+		for index := range orGroups {
+			indexes = append(indexes, index)
+		}
+		sort.Ints(indexes)
+
+		or := appModels.NewFilterGroup(appModels.FilterOr)
+		for _, index := range indexes {
+			or.AddGroup(orGroups[index])
+		}
+		root.AddGroup(or)
+	}
+
+	if root.IsEmpty() {
+		return nil, nil
+	}
+
+	return root, nil
+}
+
+func filterValue(operator appModels.FilterOperator, raw string) any {
+	switch operator {
+	case appModels.FilterIn, appModels.FilterNotIn, appModels.FilterBetween:
+		parts := strings.Split(raw, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		return parts
+	}
+	return raw
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestParseFilterQueryRejectsFieldsOutsideAllowlist(t *testing.T) {
+	allowed := NewFilterFields("author", "rating")
+
+	tests := []struct {
+		name  string
+		query string
+		ok    bool
+	}{
+		{name: "allowed field", query: "rating[gte]=4", ok: true},
+		{name: "allowed field in or group", query: "_or[0][author][ilike]=Иван", ok: true},
+		{name: "hidden field", query: "moderatorNotes[ilike]=a%25", ok: false},
+		{name: "hidden field in or group", query: "_or[0][rating][eq]=5&_or[1][spamScore][gt]=0", ok: false},
+		{name: "column name instead of field", query: "moderator_notes[eq]=x", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = ParseFilterQuery(values, allowed)
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && err == nil {
+				t.Fatal("expected error for field outside allowlist")
+			}
+		})
+	}
+}
+
+func TestFilterFieldsWithDoesNotModifyBase(t *testing.T) {
+	public := NewFilterFields("author")
+	privileged := public.With("moderatorNotes")
+
+	if public.Allows("moderatorNotes") {
+		t.Fatal("With must not add fields to the base list")
+	}
+	if !privileged.Allows("author") || !privileged.Allows("moderatorNotes") {
+		t.Fatal("extended list must contain base and added fields")
+	}
+}
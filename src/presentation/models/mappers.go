@@ -3,6 +3,8 @@ package models
 import (
 	"tax-priority-api/src/application/faq/commands"
 	"tax-priority-api/src/application/faq/queries"
+	featureCommands "tax-priority-api/src/application/features/commands"
+	featureQueries "tax-priority-api/src/application/features/queries"
 )
 
 // ToUpdateFAQCommand преобразует HTTP-модель в команду обновления FAQ
@@ -27,7 +29,8 @@ func (r *UpdateFAQPriorityRequest) ToUpdateFAQPriorityCommand(id string) command
 // ToBulkDeleteFAQCommand преобразует HTTP-модель в команду массового удаления FAQ
 func (r *BulkDeleteFAQRequest) ToBulkDeleteFAQCommand() commands.BulkDeleteFAQCommand {
 	return commands.BulkDeleteFAQCommand{
-		IDs: r.IDs,
+		IDs:    r.IDs,
+		Atomic: r.Atomic,
 	}
 }
 
@@ -57,6 +60,31 @@ func (r *GetFAQsQuery) ToGetFAQsQuery() queries.GetFAQsQuery {
 		SortBy:    r.SortBy,
 		SortOrder: r.SortOrder,
 		Filters:   filters,
+		Where:     r.Where,
+		Cursor:    r.Cursor,
+	}
+}
+
+// ToSearchFAQsQuery преобразует HTTP-модель в запрос полнотекстового поиска FAQ
+func (r *SearchFAQsQuery) ToSearchFAQsQuery() queries.SearchFAQsQuery {
+	filters := make(map[string]interface{})
+
+	if r.Category != "" {
+		filters["category"] = r.Category
+	}
+
+	if r.IsActive != nil {
+		filters["isActive"] = r.IsActive
+	}
+
+	return queries.SearchFAQsQuery{
+		Query:     r.Query,
+		Limit:     r.Limit,
+		Offset:    r.Offset,
+		SortBy:    r.SortBy,
+		SortOrder: r.SortOrder,
+		Filters:   filters,
+		Where:     r.Where,
 	}
 }
 
@@ -83,3 +111,39 @@ func (r *GetFAQCountQuery) ToGetFAQCountQuery() queries.GetFAQCountQuery {
 		Filters: filters,
 	}
 }
+
+// ToUpdateFeatureCommand преобразует HTTP-модель в команду обновления Feature
+func (r *UpdateFeatureRequest) ToUpdateFeatureCommand(id string) featureCommands.UpdateFeatureCommand {
+	return featureCommands.UpdateFeatureCommand{
+		ID:          id,
+		Name:        r.Name,
+		Description: r.Description,
+		Icon:        r.Icon,
+		SortOrder:   r.SortOrder,
+	}
+}
+
+// ToReorderFeaturesCommand преобразует HTTP-модель в команду изменения порядка Feature
+func (r *ReorderFeaturesRequest) ToReorderFeaturesCommand() featureCommands.ReorderFeaturesCommand {
+	return featureCommands.ReorderFeaturesCommand{
+		IDs: r.IDs,
+	}
+}
+
+// ToGetFeaturesQuery преобразует HTTP-модель в запрос получения списка Feature
+func (r *GetFeaturesQuery) ToGetFeaturesQuery() featureQueries.GetFeaturesQuery {
+	filters := make(map[string]interface{})
+
+	if r.IsActive != nil {
+		filters["isActive"] = r.IsActive
+	}
+
+	return featureQueries.GetFeaturesQuery{
+		Limit:     r.Limit,
+		Offset:    r.Offset,
+		SortBy:    r.SortBy,
+		SortOrder: r.SortOrder,
+		Filters:   filters,
+		Where:     r.Where,
+	}
+}
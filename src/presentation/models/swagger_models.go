@@ -1,7 +1,12 @@
 package models
 
 import (
+	"fmt"
+	apiKeyCommands "tax-priority-api/src/application/apikeys/commands"
+	auditQueries "tax-priority-api/src/application/audit/queries"
 	"tax-priority-api/src/application/faq/commands"
+	featureCommands "tax-priority-api/src/application/features/commands"
+	appModels "tax-priority-api/src/application/models"
 	"time"
 )
 
@@ -40,6 +45,8 @@ type UpdateFAQPriorityRequest struct {
 // BulkDeleteFAQRequest модель для массового удаления FAQ
 type BulkDeleteFAQRequest struct {
 	IDs []string `json:"ids" validate:"required,min=1" example:"[\"uuid1\", \"uuid2\"]"`
+	// Atomic - все или ничего: если часть FAQ не найдена, ни один не удаляется (409)
+	Atomic bool `json:"atomic" example:"false"`
 }
 
 // GetFAQsByIDsRequest модель для получения FAQ по списку ID
@@ -55,8 +62,17 @@ type FAQResponse struct {
 	Category  string    `json:"category" example:"налоги"`
 	IsActive  bool      `json:"isActive" example:"true"`
 	Priority  int       `json:"priority" example:"50"`
+	CreatedBy string    `json:"createdBy,omitempty" example:"editor"`
+	UpdatedBy string    `json:"updatedBy,omitempty" example:"editor"`
+	Version   int       `json:"version" example:"3"`
 	CreatedAt time.Time `json:"createdAt" example:"2023-12-01T10:00:00Z"`
 	UpdatedAt time.Time `json:"updatedAt" example:"2023-12-01T10:00:00Z"`
+	// DeletedAt время перемещения в корзину, только для /api/faqs/trash
+	DeletedAt *time.Time `json:"deletedAt,omitempty" example:"2023-12-05T10:00:00Z"`
+	// Rank релевантность, только для поиска по q
+	Rank *float64 `json:"rank,omitempty" example:"0.42"`
+	// Highlights фрагменты с подсветкой совпадений (<mark>), только для поиска по q
+	Highlights map[string]string `json:"highlights,omitempty"`
 }
 
 // PaginatedFAQResponse модель пагинированного ответа FAQ
@@ -84,6 +100,7 @@ type CommandResult struct {
 	Error     string    `json:"error,omitempty" example:"Validation failed"`
 	CreatedAt time.Time `json:"createdAt,omitempty" example:"2023-12-01T10:00:00Z"`
 	UpdatedAt time.Time `json:"updatedAt,omitempty" example:"2023-12-01T10:00:00Z"`
+	Version   int       `json:"version,omitempty" example:"2"`
 }
 
 // BatchCommandResult модель результата выполнения batch команды
@@ -94,6 +111,230 @@ type BatchCommandResult struct {
 	Errors       []string        `json:"errors,omitempty" example:"[\"Validation failed for item 1\"]"`
 }
 
+// FAQSnapshot модель состояния FAQ в ревизии
+type FAQSnapshot struct {
+	Question string `json:"question" example:"Как подать налоговую декларацию?"`
+	Answer   string `json:"answer" example:"Для подачи налоговой декларации необходимо..."`
+	Category string `json:"category" example:"налоги"`
+	Priority int    `json:"priority" example:"50"`
+	IsActive bool   `json:"isActive" example:"true"`
+}
+
+// FAQRevisionResponse модель ревизии FAQ
+type FAQRevisionResponse struct {
+	ID           string       `json:"id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	FAQID        string       `json:"faqId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Revision     int          `json:"revision" example:"3"`
+	Action       string       `json:"action" example:"updated" enums:"created,updated,activated,deactivated,deleted,restored"`
+	Before       *FAQSnapshot `json:"before,omitempty"`
+	After        *FAQSnapshot `json:"after,omitempty"`
+	ChangedBy    string       `json:"changedBy,omitempty" example:"editor"`
+	ChangedAt    time.Time    `json:"changedAt" example:"2023-12-01T10:00:00Z"`
+	RestoredFrom *int         `json:"restoredFrom,omitempty" example:"1"`
+}
+
+// PaginatedFAQRevisionResponse модель пагинированного списка ревизий FAQ
+type PaginatedFAQRevisionResponse struct {
+	Items      []FAQRevisionResponse `json:"items"`
+	Total      int64                 `json:"total" example:"5"`
+	Offset     int                   `json:"offset" example:"0"`
+	Limit      int                   `json:"limit" example:"20"`
+	HasNext    bool                  `json:"hasNext" example:"false"`
+	HasPrev    bool                  `json:"hasPrev" example:"false"`
+	TotalPages int                   `json:"totalPages" example:"1"`
+}
+
+// FAQFieldChange модель изменения поля FAQ
+type FAQFieldChange struct {
+	Field string `json:"field" example:"answer"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// FAQRevisionDiffResponse модель различий между двумя ревизиями FAQ
+type FAQRevisionDiffResponse struct {
+	FAQID   string           `json:"faqId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	From    int              `json:"from" example:"1"`
+	To      int              `json:"to" example:"3"`
+	Changes []FAQFieldChange `json:"changes"`
+}
+
+// CreateFeatureRequest модель для создания Feature
+type CreateFeatureRequest struct {
+	Name        string `json:"name" validate:"required,min=3,max=200" example:"Опыт более 10 лет"`
+	Description string `json:"description" validate:"max=1000" example:"Сопровождаем бизнес и частных клиентов с 2012 года"`
+	Icon        string `json:"icon" validate:"max=100" example:"award"`
+	SortOrder   int    `json:"sortOrder" validate:"min=0" example:"0"`
+}
+
+// ToCreateFeatureCommand преобразует запрос создания Feature в команду
+func (r *CreateFeatureRequest) ToCreateFeatureCommand() featureCommands.CreateFeatureCommand {
+	return featureCommands.CreateFeatureCommand{
+		Name:        r.Name,
+		Description: r.Description,
+		Icon:        r.Icon,
+		SortOrder:   r.SortOrder,
+	}
+}
+
+// UpdateFeatureRequest модель для обновления Feature
+type UpdateFeatureRequest struct {
+	Name        string `json:"name" validate:"required,min=3,max=200" example:"Опыт более 10 лет"`
+	Description string `json:"description" validate:"max=1000" example:"Сопровождаем бизнес и частных клиентов с 2012 года"`
+	Icon        string `json:"icon" validate:"max=100" example:"award"`
+	SortOrder   int    `json:"sortOrder" validate:"min=0" example:"0"`
+}
+
+// ReorderFeaturesRequest модель для изменения порядка Feature
+type ReorderFeaturesRequest struct {
+	// IDs Feature в желаемом порядке; не перечисленные Feature идут следом
+	IDs []string `json:"ids" validate:"required,min=1" example:"[\"uuid1\", \"uuid2\"]"`
+}
+
+// FeatureResponse модель ответа Feature
+type FeatureResponse struct {
+	ID          string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name        string    `json:"name" example:"Опыт более 10 лет"`
+	Description string    `json:"description" example:"Сопровождаем бизнес и частных клиентов с 2012 года"`
+	Icon        string    `json:"icon" example:"award"`
+	SortOrder   int       `json:"sortOrder" example:"0"`
+	IsActive    bool      `json:"isActive" example:"true"`
+	CreatedAt   time.Time `json:"createdAt" example:"2023-12-01T10:00:00Z"`
+	UpdatedAt   time.Time `json:"updatedAt" example:"2023-12-01T10:00:00Z"`
+}
+
+// PaginatedFeatureResponse модель пагинированного ответа Feature
+type PaginatedFeatureResponse struct {
+	Items      []FeatureResponse `json:"items"`
+	Total      int64             `json:"total" example:"6"`
+	Offset     int               `json:"offset" example:"0"`
+	Limit      int               `json:"limit" example:"50"`
+	HasNext    bool              `json:"hasNext" example:"false"`
+	HasPrev    bool              `json:"hasPrev" example:"false"`
+	TotalPages int               `json:"totalPages" example:"1"`
+}
+
+// CreateAPIKeyRequest модель для выпуска API ключа
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100" example:"cms-import"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1" example:"api:read,api:write"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" example:"2026-01-01T00:00:00Z"`
+}
+
+// ToCreateAPIKeyCommand преобразует запрос выпуска ключа в команду
+func (r *CreateAPIKeyRequest) ToCreateAPIKeyCommand() apiKeyCommands.CreateAPIKeyCommand {
+	return apiKeyCommands.CreateAPIKeyCommand{
+		Name:      r.Name,
+		Scopes:    r.Scopes,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
+
+// CreateAPIKeyResult модель результата выпуска API ключа
+type CreateAPIKeyResult struct {
+	ID      string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Success bool   `json:"success" example:"true"`
+	Message string `json:"message,omitempty" example:"API key created successfully, store it now: it cannot be shown again"`
+	// Key полный ключ, показывается только в этом ответе
+	Key       string    `json:"key" example:"tp_3f9a1c2b7d4e_J8kq...Xw"`
+	Prefix    string    `json:"prefix" example:"3f9a1c2b7d4e"`
+	CreatedAt time.Time `json:"createdAt" example:"2023-12-01T10:00:00Z"`
+}
+
+// APIKeyResponse модель API ключа без секрета
+type APIKeyResponse struct {
+	ID         string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name       string     `json:"name" example:"cms-import"`
+	Prefix     string     `json:"prefix" example:"3f9a1c2b7d4e"`
+	Scopes     []string   `json:"scopes" example:"api:read,api:write"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty" example:"2026-01-01T00:00:00Z"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" example:"2023-12-02T08:30:00Z"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	CreatedBy  string     `json:"createdBy,omitempty" example:"admin"`
+	CreatedAt  time.Time  `json:"createdAt" example:"2023-12-01T10:00:00Z"`
+	UpdatedAt  time.Time  `json:"updatedAt" example:"2023-12-01T10:00:00Z"`
+}
+
+// PaginatedAPIKeyResponse модель пагинированного списка API ключей
+type PaginatedAPIKeyResponse struct {
+	Items      []APIKeyResponse `json:"items"`
+	Total      int64            `json:"total" example:"2"`
+	Offset     int              `json:"offset" example:"0"`
+	Limit      int              `json:"limit" example:"50"`
+	HasNext    bool             `json:"hasNext" example:"false"`
+	HasPrev    bool             `json:"hasPrev" example:"false"`
+	TotalPages int              `json:"totalPages" example:"1"`
+}
+
+// AuditRecordResponse модель записи журнала аудита
+type AuditRecordResponse struct {
+	ID         string                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Actor      string                 `json:"actor,omitempty" example:"admin"`
+	Action     string                 `json:"action" example:"updated"`
+	EntityType string                 `json:"entityType" example:"faq"`
+	EntityID   string                 `json:"entityId,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Before     map[string]interface{} `json:"before,omitempty"`
+	After      map[string]interface{} `json:"after,omitempty"`
+	RequestID  string                 `json:"requestId,omitempty" example:"5f1c2d3e-4b5a-6978-8a9b-0c1d2e3f4a5b"`
+	ClientIP   string                 `json:"clientIp,omitempty" example:"203.0.113.10"`
+	CreatedAt  time.Time              `json:"createdAt" example:"2023-12-01T10:00:00Z"`
+}
+
+// AuditFilterQuery фильтры журнала аудита из query string; период задается в формате RFC3339
+type AuditFilterQuery struct {
+	Actor      string `form:"actor" example:"admin"`
+	Action     string `form:"action" example:"updated"`
+	EntityType string `form:"entityType" example:"faq"`
+	EntityID   string `form:"entityId" example:"550e8400-e29b-41d4-a716-446655440001"`
+	RequestID  string `form:"requestId" example:"5f1c2d3e-4b5a-6978-8a9b-0c1d2e3f4a5b"`
+	From       string `form:"from" example:"2023-12-01T00:00:00Z"`
+	To         string `form:"to" example:"2024-01-01T00:00:00Z"`
+}
+
+// ToAuditFilter преобразует параметры запроса в фильтр журнала аудита
+func (q *AuditFilterQuery) ToAuditFilter() (auditQueries.AuditFilter, error) {
+	filter := auditQueries.AuditFilter{
+		Actor:      q.Actor,
+		Action:     q.Action,
+		EntityType: q.EntityType,
+		EntityID:   q.EntityID,
+		RequestID:  q.RequestID,
+	}
+
+	var err error
+	if filter.From, err = parseRFC3339("from", q.From); err != nil {
+		return filter, err
+	}
+	if filter.To, err = parseRFC3339("to", q.To); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+// parseRFC3339 разбирает необязательный параметр времени; пустое значение дает nil
+func parseRFC3339(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s parameter, expected RFC3339 time", name)
+	}
+	return &t, nil
+}
+
+// PaginatedAuditRecordResponse модель пагинированного журнала аудита
+type PaginatedAuditRecordResponse struct {
+	Items      []AuditRecordResponse `json:"items"`
+	Total      int64                 `json:"total" example:"120"`
+	Offset     int                   `json:"offset" example:"0"`
+	Limit      int                   `json:"limit" example:"50"`
+	HasNext    bool                  `json:"hasNext" example:"true"`
+	HasPrev    bool                  `json:"hasPrev" example:"false"`
+	TotalPages int                   `json:"totalPages" example:"3"`
+}
+
 // ErrorResponse модель ошибки
 type ErrorResponse struct {
 	Error string `json:"error" example:"Validation failed"`
@@ -112,13 +353,15 @@ type CountResponse struct {
 
 // SearchFAQsQuery модель для поиска FAQ
 type SearchFAQsQuery struct {
-	Query      string `form:"q" binding:"required,min=3" example:"налоги"`
-	Category   string `form:"category" example:"налоги"`
-	Limit      int    `form:"_limit" example:"10"`
-	Offset     int    `form:"_offset" example:"0"`
-	SortBy     string `form:"_sort" example:"priority"`
-	SortOrder  string `form:"_order" example:"desc"`
-	ActiveOnly bool   `form:"activeOnly" example:"true"`
+	Query     string `form:"q" binding:"required,min=3" example:"налоги"`
+	Category  string `form:"category" example:"налоги"`
+	Limit     int    `form:"_limit" example:"10"`
+	Offset    int    `form:"_offset" example:"0"`
+	SortBy    string `form:"_sort" example:"priority"`
+	SortOrder string `form:"_order" example:"desc"`
+	IsActive  *bool  `form:"isActive" example:"true"`
+	// Where условия вида field[op]=value, см. ParseFilterQuery
+	Where *appModels.FilterGroup `form:"-" swaggerignore:"true"`
 }
 
 // GetFAQsQuery модель для получения списка FAQ
@@ -129,6 +372,10 @@ type GetFAQsQuery struct {
 	SortOrder string `form:"_order" example:"desc"`
 	Category  string `form:"category" example:"налоги"`
 	IsActive  *bool  `form:"isActive" example:"true"`
+	// Where условия вида field[op]=value, см. ParseFilterQuery
+	Where *appModels.FilterGroup `form:"-" swaggerignore:"true"`
+	// Cursor курсор keyset-пагинации, nil - обычная пагинация по смещению
+	Cursor *string `form:"_cursor"`
 }
 
 // GetFAQsByCategoryQuery модель для получения FAQ по категории
@@ -150,3 +397,14 @@ type GetFAQCountQuery struct {
 	Category string `form:"category" example:"налоги"`
 	IsActive bool   `form:"isActive" example:"true"`
 }
+
+// GetFeaturesQuery модель для получения списка Feature
+type GetFeaturesQuery struct {
+	Limit     int    `form:"_limit" example:"50"`
+	Offset    int    `form:"_offset" example:"0"`
+	SortBy    string `form:"_sort" example:"sortOrder"`
+	SortOrder string `form:"_order" example:"asc"`
+	IsActive  *bool  `form:"isActive" example:"true"`
+	// Where условия вида field[op]=value, см. ParseFilterQuery
+	Where *appModels.FilterGroup `form:"-" swaggerignore:"true"`
+}
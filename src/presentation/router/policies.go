@@ -0,0 +1,105 @@
+package router
+
+import (
+	"net/http"
+
+	"tax-priority-api/src/presentation/middlewares"
+)
+
+// Сокращения для таблицы политик
+var (
+	public    = middlewares.PublicPolicy
+	reader    = middlewares.ReaderPolicy
+	editor    = middlewares.ContentEditorPolicy
+	moderator = middlewares.ModeratorPolicy
+	admin     = middlewares.AdminPolicy
+)
+
+// routePolicies политики доступа ко всем маршрутам API.
+// Публичные списки и карточки отдают анонимным клиентам только опубликованные записи,
+// неопубликованные видны с политикой reader (проверяется в обработчиках).
+// Маршруты вне таблицы требуют политики reader, при запуске о них пишется предупреждение.
+var routePolicies = middlewares.NewPolicyTable(
+	reader,
+
+	// Служебные маршруты
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/health", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/swagger", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/swagger/*any", Policy: public},
+
+	// Файлы по подписанным ссылкам: доступ проверяется подписью
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/files/*key", Policy: public},
+
+	// WebSocket уведомления
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/ws", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/ws/test-page", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/ws/stats", Policy: reader},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/ws/info", Policy: reader},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/ws/test", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/ws/broadcast", Policy: editor},
+
+	// FAQ
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs/:id", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs/count", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs/categories", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/api/faqs/batch", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/api/faqs", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPut, Path: "/api/faqs/:id", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodDelete, Path: "/api/faqs/:id", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodDelete, Path: "/api/faqs/bulk-delete", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/api/faqs/:id/activate", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/api/faqs/:id/deactivate", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/api/faqs/:id/priority", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs/trash", Policy: reader},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/api/faqs/:id/restore", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs/:id/revisions", Policy: reader},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs/:id/revisions/diff", Policy: reader},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/faqs/:id/revisions/:revision", Policy: reader},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/api/faqs/:id/revisions/:revision/restore", Policy: editor},
+
+	// Преимущества
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/features", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/features/:id", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/api/features", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPut, Path: "/api/features/:id", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodDelete, Path: "/api/features/:id", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/api/features/:id/activate", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/api/features/:id/deactivate", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPut, Path: "/api/features/order", Policy: editor},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/api/features/:id/restore", Policy: editor},
+
+	// Отзывы: отправка отзыва и просмотр одобренных доступны посетителям сайта
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/testimonials", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/testimonials/verify", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/testimonials", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/testimonials/:id", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/testimonials/:id/file", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/testimonials/:id/file/url", Policy: public},
+	middlewares.RoutePolicy{Method: http.MethodPut, Path: "/testimonials/:id", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodDelete, Path: "/testimonials/:id", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/testimonials/moderation-queue", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/:id/review", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/:id/approve", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/:id/reject", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/:id/archive", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/:id/activate", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/:id/deactivate", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/testimonials/:id/verification", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/bulk/approve", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/bulk/activate", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPatch, Path: "/testimonials/bulk/deactivate", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodDelete, Path: "/testimonials/bulk/delete", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodPut, Path: "/testimonials/:id/file", Policy: moderator},
+	middlewares.RoutePolicy{Method: http.MethodDelete, Path: "/testimonials/:id/file", Policy: moderator},
+
+	// API ключи серверных клиентов
+	middlewares.RoutePolicy{Method: http.MethodPost, Path: "/api/admin/api-keys", Policy: admin},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/admin/api-keys", Policy: admin},
+	middlewares.RoutePolicy{Method: http.MethodDelete, Path: "/api/admin/api-keys/:id", Policy: admin},
+
+	// Журнал аудита
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/audit", Policy: admin},
+	middlewares.RoutePolicy{Method: http.MethodGet, Path: "/api/audit/export", Policy: admin},
+)
@@ -6,8 +6,9 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"tax-priority-api/src/infrastructure/auth"
+	"tax-priority-api/src/infrastructure/config"
 	"tax-priority-api/src/infrastructure/persistence"
-	"tax-priority-api/src/infrastructure/persistence/models"
 	"tax-priority-api/src/presentation/handlers"
 	"tax-priority-api/src/presentation/middlewares"
 	"tax-priority-api/src/wire"
@@ -21,6 +22,14 @@ import (
 func SetupRouter() *gin.Engine {
 	router := gin.Default()
 
+	// IP клиента берется из X-Forwarded-For только от доверенных прокси: иначе ограничение отправок
+	// по IP обходится подделкой заголовка. Без TRUSTED_PROXIES остается поведение gin по умолчанию.
+	if proxies := config.GetEnvList("TRUSTED_PROXIES", nil); proxies != nil {
+		if err := router.SetTrustedProxies(proxies); err != nil {
+			log.Fatal("Invalid TRUSTED_PROXIES: ", err)
+		}
+	}
+
 	// Настройка CORS
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
@@ -30,8 +39,8 @@ func SetupRouter() *gin.Engine {
 		AllowCredentials: true,
 	}))
 
-	middlewares.StartJWKSRefresh()
-	//router.Use(middlewares.AuthMiddleware())
+	// Идентификатор запроса и IP клиента для журнала аудита
+	router.Use(middlewares.RequestInfoMiddleware())
 
 	// Подключение к базе данных
 	db, err := persistence.Connect(persistence.NewDatabaseConfig())
@@ -39,25 +48,61 @@ func SetupRouter() *gin.Engine {
 		log.Fatal("Failed to connect to database:", err)
 	}
 
-	// Миграции
-	if err := db.AutoMigrate(&models.FAQModel{}, &models.TestimonialModel{}); err != nil {
-		log.Fatal("Failed to migrate database:", err)
+	// Миграции: при DB_AUTO_MIGRATE=false схема только проверяется, применять ее нужно командой migrate up
+	if config.GetEnvBool("DB_AUTO_MIGRATE", true) {
+		err = persistence.Migrate(context.Background(), db)
+	} else {
+		err = persistence.CheckSchemaVersion(context.Background(), db)
+	}
+	if err != nil {
+		log.Fatal("Failed to prepare database schema: ", err)
 	}
 
+	// Аутентификация и политики доступа; подключаются до регистрации маршрутов.
+	// Серверные клиенты передают API ключ, пользователи - токен Keycloak.
+	// AUTH_ENABLED=false отключает проверку, только для локальной разработки.
+	if config.GetEnvBool("AUTH_ENABLED", true) {
+		oidcConfig := auth.NewOIDCConfig()
+		verifier := auth.NewOIDCVerifier(oidcConfig)
+		verifier.Start(context.Background())
+		router.Use(
+			middlewares.APIKeyMiddleware(wire.InitializeAPIKeyAuthenticator(db)),
+			middlewares.AuthMiddleware(verifier, oidcConfig.ClientID),
+		)
+	} else {
+		log.Println("WARNING: AUTH_ENABLED=false, API is available without authentication")
+		router.Use(middlewares.NoAuthMiddleware())
+	}
+	router.Use(routePolicies.Middleware())
+
 	// Инициализация фабрики обработчиков
 	handlerFactory := wire.InitializeHandlerFactory(db)
 
 	// Создание обработчиков через фабрику
 	wsHandler := handlerFactory.CreateWebSocketHandler()
 	faqHandler := handlerFactory.CreateFAQHandler()
+	featureHandler := handlerFactory.CreateFeatureHandler()
 	testimonialHandler := handlerFactory.CreateTestimonialHandler()
+	blobHandler := handlerFactory.CreateBlobHandler()
+	apiKeyHandler := handlerFactory.CreateAPIKeyHandler()
+	auditHandler := handlerFactory.CreateAuditHandler()
 
 	// Запуск WebSocket хаба в горутине
 	go wsHandler.GetHub().Run(context.Background())
 
+	// Доставка событий из outbox клиентам WebSocket
+	go wire.InitializeOutboxRelay(db, wsHandler.GetHub()).Run(context.Background())
+
+	// Очистка корзины от записей старше TRASH_RETENTION_DAYS
+	go wire.InitializeTrashPurgeJob(db).Run(context.Background())
+
 	// Регистрация маршрутов
 	handlers.RegisterFAQRoutes(router, faqHandler)
+	handlers.RegisterFeatureRoutes(router, featureHandler)
 	handlers.RegisterTestimonialRoutes(router, testimonialHandler)
+	handlers.RegisterBlobRoutes(router, blobHandler)
+	handlers.RegisterAPIKeyRoutes(router, apiKeyHandler)
+	handlers.RegisterAuditRoutes(router, auditHandler)
 	RegisterWebSocketRoutes(router, wsHandler)
 
 	// Health check
@@ -110,6 +155,10 @@ func SetupRouter() *gin.Engine {
 	})
 	router.GET("/swagger/*any", ginSwagger.CustomWrapHandler(swaggerConfig, swaggerFiles.Handler))
 
+	for _, route := range routePolicies.Missing(router.Routes()) {
+		log.Printf("WARNING: no access policy for route %s, reader policy is applied", route)
+	}
+
 	router.Use(gin.Recovery())
 	router.Use(gin.Logger())
 
@@ -0,0 +1,29 @@
+package wire
+
+import (
+	"gorm.io/gorm"
+
+	appRepos "tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	infraPersistence "tax-priority-api/src/infrastructure/persistence"
+	infraModels "tax-priority-api/src/infrastructure/persistence/models"
+	infraRepos "tax-priority-api/src/infrastructure/persistence/repositories"
+)
+
+// CreateAPIKeyRepository создает репозиторий API ключей. Ключи не кешируются:
+// отзыв ключа должен действовать сразу на всех экземплярах API.
+func CreateAPIKeyRepository(db *gorm.DB, cursors *infraPersistence.CursorCodec) appRepos.APIKeyRepository {
+	domainToModel := func(entity *entities.APIKey) *infraModels.APIKeyModel {
+		return infraModels.NewAPIKeyModelFromEntity(entity)
+	}
+	modelToDomain := func(model *infraModels.APIKeyModel) *entities.APIKey {
+		return model.ToEntity()
+	}
+	genericRepo := infraRepos.NewGenericRepository(
+		db,
+		cursors,
+		domainToModel,
+		modelToDomain,
+	)
+	return infraRepos.NewAPIKeyRepository(db, genericRepo)
+}
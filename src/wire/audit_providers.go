@@ -0,0 +1,29 @@
+package wire
+
+import (
+	"gorm.io/gorm"
+
+	appRepos "tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	infraPersistence "tax-priority-api/src/infrastructure/persistence"
+	infraModels "tax-priority-api/src/infrastructure/persistence/models"
+	infraRepos "tax-priority-api/src/infrastructure/persistence/repositories"
+)
+
+// CreateAuditRepository создает репозиторий журнала аудита. Журнал не кешируется:
+// записи читаются редко, а выгрузка должна видеть все записи.
+func CreateAuditRepository(db *gorm.DB, cursors *infraPersistence.CursorCodec) appRepos.AuditRepository {
+	domainToModel := func(entity *entities.AuditRecord) *infraModels.AuditRecordModel {
+		return infraModels.NewAuditRecordModelFromEntity(entity)
+	}
+	modelToDomain := func(model *infraModels.AuditRecordModel) *entities.AuditRecord {
+		return model.ToEntity()
+	}
+	genericRepo := infraRepos.NewGenericRepository(
+		db,
+		cursors,
+		domainToModel,
+		modelToDomain,
+	)
+	return infraRepos.NewAuditRepository(genericRepo)
+}
@@ -7,13 +7,14 @@ import (
 	appRepos "tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
 	infraCache "tax-priority-api/src/infrastructure/cache"
+	infraPersistence "tax-priority-api/src/infrastructure/persistence"
 	infraModels "tax-priority-api/src/infrastructure/persistence/models"
 	infraRepos "tax-priority-api/src/infrastructure/persistence/repositories"
 )
 
 // CreateFAQGenericRepository создает GenericRepository для FAQ
 // Эта функция изолирована от Wire чтобы избежать проблем с AST
-func CreateFAQGenericRepository(db *gorm.DB) appRepos.GenericRepository[*entities.FAQ, string] {
+func CreateFAQGenericRepository(db *gorm.DB, cursors *infraPersistence.CursorCodec) appRepos.GenericRepository[*entities.FAQ, string] {
 	domainToModel := func(entity *entities.FAQ) *infraModels.FAQModel {
 		return infraModels.NewFAQModelFromEntity(entity)
 	}
@@ -22,6 +23,7 @@ func CreateFAQGenericRepository(db *gorm.DB) appRepos.GenericRepository[*entitie
 	}
 	return infraRepos.NewGenericRepository(
 		db,
+		cursors,
 		domainToModel,
 		modelToDomain,
 	)
@@ -46,16 +48,39 @@ func CreateFAQInvalidationConfig() *appCache.InvalidationConfig {
 }
 
 // CreateFAQCacheManager создает менеджер кеша для FAQ
+// Инвалидация увеличивает поколение FAQ, по которому строятся ETag публичных списков
 func CreateFAQCacheManager(
 	cache appCache.Cache,
 	keyGen appCache.KeyGenerator[*entities.FAQ, string],
 	cacheConfig *appCache.CacheConfig,
 	invalidationConfig *appCache.InvalidationConfig,
+	generations appCache.GenerationStore,
 ) infraCache.CacheManager[*entities.FAQ, string] {
-	return infraCache.NewCacheManager(cache, keyGen, cacheConfig, invalidationConfig)
+	return infraCache.NewGenerationCacheManager(
+		infraCache.NewCacheManager(cache, keyGen, cacheConfig, invalidationConfig),
+		generations,
+		appCache.GenerationScopeFAQ,
+	)
 }
 
 // CreateFAQRepository создает FAQ репозиторий
-func CreateFAQRepository(genericRepo appRepos.GenericRepository[*entities.FAQ, string]) appRepos.FAQRepository {
-	return infraRepos.NewFAQRepository(genericRepo)
+func CreateFAQRepository(db *gorm.DB, genericRepo appRepos.GenericRepository[*entities.FAQ, string]) appRepos.FAQRepository {
+	return infraRepos.NewFAQRepository(db, genericRepo)
+}
+
+// CreateFAQRevisionRepository создает репозиторий истории изменений FAQ
+func CreateFAQRevisionRepository(db *gorm.DB, cursors *infraPersistence.CursorCodec) appRepos.FAQRevisionRepository {
+	domainToModel := func(entity *entities.FAQRevision) *infraModels.FAQRevisionModel {
+		return infraModels.NewFAQRevisionModelFromEntity(entity)
+	}
+	modelToDomain := func(model *infraModels.FAQRevisionModel) *entities.FAQRevision {
+		return model.ToEntity()
+	}
+	genericRepo := infraRepos.NewGenericRepository(
+		db,
+		cursors,
+		domainToModel,
+		modelToDomain,
+	)
+	return infraRepos.NewFAQRevisionRepository(db, genericRepo)
 }
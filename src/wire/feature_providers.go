@@ -0,0 +1,62 @@
+package wire
+
+import (
+	"gorm.io/gorm"
+
+	appCache "tax-priority-api/src/application/cache"
+	appRepos "tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/domain/entities"
+	infraCache "tax-priority-api/src/infrastructure/cache"
+	infraPersistence "tax-priority-api/src/infrastructure/persistence"
+	infraModels "tax-priority-api/src/infrastructure/persistence/models"
+	infraRepos "tax-priority-api/src/infrastructure/persistence/repositories"
+)
+
+// CreateFeatureGenericRepository создает GenericRepository для Feature
+func CreateFeatureGenericRepository(db *gorm.DB, cursors *infraPersistence.CursorCodec) appRepos.GenericRepository[*entities.Feature, string] {
+	domainToModel := func(entity *entities.Feature) *infraModels.FeatureModel {
+		return infraModels.NewFeatureModelFromEntity(entity)
+	}
+	modelToDomain := func(model *infraModels.FeatureModel) *entities.Feature {
+		return model.ToEntity()
+	}
+	return infraRepos.NewGenericRepository(
+		db,
+		cursors,
+		domainToModel,
+		modelToDomain,
+	)
+}
+
+// CreateFeatureKeyGenerator создает генератор ключей для Feature
+func CreateFeatureKeyGenerator() appCache.KeyGenerator[*entities.Feature, string] {
+	return appCache.NewKeyGenerator(
+		"feature",
+		func(feature *entities.Feature) string { return feature.GetID() },
+		func(id string) string { return id },
+	)
+}
+
+// CreateFeatureInvalidationConfig создает конфигурацию инвалидации для Feature
+func CreateFeatureInvalidationConfig() *appCache.InvalidationConfig {
+	return &appCache.InvalidationConfig{
+		Mode:              appCache.InvalidationModeSelective,
+		BatchSize:         100,
+		InvalidateRelated: true,
+	}
+}
+
+// CreateFeatureCacheManager создает менеджер кеша для Feature
+func CreateFeatureCacheManager(
+	cache appCache.Cache,
+	keyGen appCache.KeyGenerator[*entities.Feature, string],
+	cacheConfig *appCache.CacheConfig,
+	invalidationConfig *appCache.InvalidationConfig,
+) infraCache.CacheManager[*entities.Feature, string] {
+	return infraCache.NewCacheManager(cache, keyGen, cacheConfig, invalidationConfig)
+}
+
+// CreateFeatureRepository создает Feature репозиторий
+func CreateFeatureRepository(db *gorm.DB, genericRepo appRepos.GenericRepository[*entities.Feature, string]) appRepos.FeatureRepository {
+	return infraRepos.NewFeatureRepository(db, genericRepo)
+}
@@ -0,0 +1,61 @@
+package wire
+
+import (
+	"log"
+
+	appMail "tax-priority-api/src/application/mail"
+	appNotifications "tax-priority-api/src/application/notifications"
+	appVerification "tax-priority-api/src/application/verification"
+	"tax-priority-api/src/infrastructure/config"
+	infraMail "tax-priority-api/src/infrastructure/mail"
+)
+
+// CreateMailer создает отправителя писем, выбранного переменной MAILER (log, memory или smtp)
+func CreateMailer() appMail.Mailer {
+	switch driver := config.GetEnv("MAILER", "log"); driver {
+	case "log":
+		return infraMail.NewLogMailer()
+	case "memory":
+		return infraMail.NewMemoryMailer()
+	case "smtp":
+		mailer, err := infraMail.NewSMTPMailer(infraMail.NewSMTPConfig())
+		if err != nil {
+			log.Fatalf("Failed to configure SMTP mailer: %v", err)
+		}
+		return mailer
+	default:
+		log.Fatalf("Unknown MAILER %q, expected log, memory or smtp", driver)
+		return nil
+	}
+}
+
+// CreateMailTemplates загружает встроенные шаблоны писем
+func CreateMailTemplates() *appMail.Templates {
+	templates, err := appMail.NewTemplates()
+	if err != nil {
+		log.Fatalf("Failed to load mail templates: %v", err)
+	}
+	return templates
+}
+
+// CreateEmailVerificationSender создает отправителя писем подтверждения email автора отзыва
+// со ссылкой на EMAIL_VERIFICATION_URL
+func CreateEmailVerificationSender(tokens appVerification.Tokens, mailer appMail.Mailer, templates *appMail.Templates) *appVerification.Sender {
+	return appVerification.NewSender(
+		tokens,
+		mailer,
+		templates,
+		config.GetEnv("EMAIL_VERIFICATION_URL", "http://localhost:8080/testimonials/verify"),
+	)
+}
+
+// CreateTestimonialMailer создает письма о модерации отзывов: модераторам MODERATOR_EMAILS
+// о новых отзывах и авторам о решении
+func CreateTestimonialMailer(mailer appMail.Mailer, templates *appMail.Templates) *appNotifications.TestimonialMailer {
+	return appNotifications.NewTestimonialMailer(
+		mailer,
+		templates,
+		config.GetEnvList("MODERATOR_EMAILS", nil),
+		config.GetEnv("MODERATION_QUEUE_URL", ""),
+	)
+}
@@ -0,0 +1,31 @@
+package wire
+
+import (
+	"gorm.io/gorm"
+
+	infraAuth "tax-priority-api/src/infrastructure/auth"
+	infraEvents "tax-priority-api/src/infrastructure/events"
+	infraPersistence "tax-priority-api/src/infrastructure/persistence"
+	infraRepos "tax-priority-api/src/infrastructure/persistence/repositories"
+	infraWebSocket "tax-priority-api/src/infrastructure/websocket"
+)
+
+// InitializeOutboxRelay создает relay событий outbox. Хаб передается явно: события должны
+// попадать в хаб, к которому подключены клиенты /ws, а не в хаб отдельного инжектора.
+// Кроме хаба события модерации отзывов и письма подтверждения email получает почта.
+func InitializeOutboxRelay(db *gorm.DB, hub *infraWebSocket.Hub) *infraEvents.OutboxRelay {
+	mailer, templates := CreateMailer(), CreateMailTemplates()
+	sinks := []infraEvents.OutboxSink{
+		infraEvents.NewHubSink(hub),
+		infraEvents.NewEmailSink(
+			CreateTestimonialGenericRepository(db, infraPersistence.NewCursorCodecFromEnv()),
+			CreateTestimonialMailer(mailer, templates),
+			CreateEmailVerificationSender(infraAuth.NewEmailTokenSignerFromEnv(), mailer, templates),
+		),
+	}
+	return infraEvents.NewOutboxRelay(
+		infraRepos.NewOutboxRepository(db),
+		sinks,
+		infraEvents.NewOutboxRelayConfig(),
+	)
+}
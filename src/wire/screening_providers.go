@@ -0,0 +1,41 @@
+package wire
+
+import (
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	appScreening "tax-priority-api/src/application/screening"
+	infraCache "tax-priority-api/src/infrastructure/cache"
+	"tax-priority-api/src/infrastructure/config"
+)
+
+// CreateSpamScreeningPipeline создает конвейер проверки публичных отзывов на спам.
+// Отправка с оценкой не ниже SPAM_SCORE_THRESHOLD попадает в очередь suspected_spam.
+func CreateSpamScreeningPipeline(client *redis.Client) *appScreening.Pipeline {
+	counter := infraCache.NewRedisWindowCounter(client)
+
+	return appScreening.NewPipeline(
+		config.GetEnvInt("SPAM_SCORE_THRESHOLD", 50),
+		appScreening.NewHoneypotCheck(100),
+		appScreening.NewBlocklistCheck(config.GetEnvList("SPAM_BLOCKLIST", nil), config.GetEnvInt("SPAM_BLOCKLIST_SCORE", 40)),
+		appScreening.NewLinkCheck(config.GetEnvInt("SPAM_MAX_LINKS", 1), config.GetEnvInt("SPAM_LINK_SCORE", 20)),
+		appScreening.NewDuplicateCheck(
+			counter,
+			config.GetEnvDuration("SPAM_DUPLICATE_WINDOW", 24*time.Hour),
+			config.GetEnvInt("SPAM_DUPLICATE_SCORE", 50),
+		),
+		appScreening.NewEmailThrottleCheck(
+			counter,
+			config.GetEnvInt("SPAM_EMAIL_LIMIT", 3),
+			config.GetEnvDuration("SPAM_EMAIL_WINDOW", 24*time.Hour),
+			config.GetEnvInt("SPAM_THROTTLE_SCORE", 30),
+		),
+		appScreening.NewIPThrottleCheck(
+			counter,
+			config.GetEnvInt("SPAM_IP_LIMIT", 5),
+			config.GetEnvDuration("SPAM_IP_WINDOW", time.Hour),
+			config.GetEnvInt("SPAM_THROTTLE_SCORE", 30),
+		),
+	)
+}
@@ -0,0 +1,46 @@
+package wire
+
+import (
+	"log"
+
+	appStorage "tax-priority-api/src/application/storage"
+	appUploads "tax-priority-api/src/application/uploads"
+	"tax-priority-api/src/infrastructure/config"
+	infraStorage "tax-priority-api/src/infrastructure/storage"
+)
+
+// CreateBlobStore создает хранилище файлов, выбранное переменной BLOB_STORE (local или s3)
+func CreateBlobStore() appStorage.BlobStore {
+	switch driver := config.GetEnv("BLOB_STORE", "local"); driver {
+	case "local":
+		return infraStorage.NewLocalBlobStore(
+			config.GetEnv("UPLOADS_DIR", "uploads"),
+			config.GetEnv("BLOB_PUBLIC_URL", "/files"),
+			config.SecretOrRandom("BLOB_URL_SECRET"),
+		)
+	case "s3":
+		endpoint := config.GetEnv("S3_ENDPOINT", "")
+		store, err := infraStorage.NewS3BlobStore(infraStorage.S3Config{
+			Endpoint:        endpoint,
+			Region:          config.GetEnv("S3_REGION", "us-east-1"),
+			Bucket:          config.GetEnv("S3_BUCKET", ""),
+			AccessKeyID:     config.GetEnv("S3_ACCESS_KEY_ID", ""),
+			SecretAccessKey: config.GetEnv("S3_SECRET_ACCESS_KEY", ""),
+			// Совместимые хранилища со своим адресом обычно не поддерживают бакеты в имени хоста
+			UsePathStyle: config.GetEnvBool("S3_USE_PATH_STYLE", endpoint != ""),
+		})
+		if err != nil {
+			log.Fatalf("Failed to configure S3 blob store: %v", err)
+		}
+		return store
+	default:
+		log.Fatalf("Unknown BLOB_STORE %q, expected local or s3", driver)
+		return nil
+	}
+}
+
+// CreateAttachmentPolicy создает политику вложений с лимитом UPLOAD_MAX_FILE_SIZE_MB
+func CreateAttachmentPolicy() *appUploads.Policy {
+	maxSizeMB := config.GetEnvInt("UPLOAD_MAX_FILE_SIZE_MB", 10)
+	return appUploads.NewAttachmentPolicy(int64(maxSizeMB) << 20)
+}
@@ -7,11 +7,12 @@ import (
 	appRepos "tax-priority-api/src/application/repositories"
 	"tax-priority-api/src/domain/entities"
 	infraCache "tax-priority-api/src/infrastructure/cache"
+	infraPersistence "tax-priority-api/src/infrastructure/persistence"
 	infraModels "tax-priority-api/src/infrastructure/persistence/models"
 	infraRepos "tax-priority-api/src/infrastructure/persistence/repositories"
 )
 
-func CreateTestimonialGenericRepository(db *gorm.DB) appRepos.GenericRepository[*entities.Testimonial, string] {
+func CreateTestimonialGenericRepository(db *gorm.DB, cursors *infraPersistence.CursorCodec) appRepos.GenericRepository[*entities.Testimonial, string] {
 	domainToModel := func(entity *entities.Testimonial) *infraModels.TestimonialModel {
 		return infraModels.NewTestimonialModelFromEntity(entity)
 	}
@@ -20,6 +21,7 @@ func CreateTestimonialGenericRepository(db *gorm.DB) appRepos.GenericRepository[
 	}
 	return infraRepos.NewGenericRepository(
 		db,
+		cursors,
 		domainToModel,
 		modelToDomain,
 	)
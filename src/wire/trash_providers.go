@@ -0,0 +1,18 @@
+package wire
+
+import (
+	"gorm.io/gorm"
+
+	"tax-priority-api/src/infrastructure/jobs"
+)
+
+// InitializeTrashPurgeJob создает задачу очистки корзины FAQ, преимуществ и отзывов.
+// Репозитории кешированные, чтобы удаление сбрасывало кеш списков корзины;
+// для отзывов вместе с записями удаляются их файлы.
+func InitializeTrashPurgeJob(db *gorm.DB) *jobs.TrashPurgeJob {
+	return jobs.NewTrashPurgeJobFromEnv(map[string]jobs.Purger{
+		"faqs":         InitializeCachedFAQRepository(db),
+		"features":     InitializeCachedFeatureRepository(db),
+		"testimonials": InitializeTestimonialTrashPurger(db),
+	})
+}
@@ -10,16 +10,26 @@ import (
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
+	appAPIKeyHandlers "tax-priority-api/src/application/apikeys/handlers"
+	appAPIKeyQueries "tax-priority-api/src/application/apikeys/queries"
+	appAudit "tax-priority-api/src/application/audit"
+	appAuditHandlers "tax-priority-api/src/application/audit/handlers"
 	appCache "tax-priority-api/src/application/cache"
 	appEvents "tax-priority-api/src/application/events"
 	appFaqHandlers "tax-priority-api/src/application/faq/handlers"
+	appFeatureHandlers "tax-priority-api/src/application/features/handlers"
+	appRepos "tax-priority-api/src/application/repositories"
+	appStorage "tax-priority-api/src/application/storage"
+	appTestimonialCommands "tax-priority-api/src/application/testimonial/commands"
 	appTestimonialHandlers "tax-priority-api/src/application/testimonial/handlers"
+	infraAuth "tax-priority-api/src/infrastructure/auth"
 	infraCache "tax-priority-api/src/infrastructure/cache"
 	infraEvents "tax-priority-api/src/infrastructure/events"
 	infraPersistence "tax-priority-api/src/infrastructure/persistence"
 	infraRepos "tax-priority-api/src/infrastructure/persistence/repositories"
 	infraWebSocket "tax-priority-api/src/infrastructure/websocket"
 	httpHandlers "tax-priority-api/src/presentation/handlers"
+	httpMiddlewares "tax-priority-api/src/presentation/middlewares"
 )
 
 // DependencyContainer содержит все основные зависимости
@@ -29,6 +39,7 @@ type DependencyContainer struct {
 	Hub                 *infraWebSocket.Hub
 	NotificationService appEvents.NotificationService
 	Cache               appCache.Cache
+	BlobStore           appStorage.BlobStore
 }
 
 // NewDependencyContainer создает контейнер зависимостей
@@ -38,6 +49,7 @@ func NewDependencyContainer(
 	hub *infraWebSocket.Hub,
 	notificationService appEvents.NotificationService,
 	cache appCache.Cache,
+	blobStore appStorage.BlobStore,
 ) *DependencyContainer {
 	return &DependencyContainer{
 		DB:                  db,
@@ -45,6 +57,7 @@ func NewDependencyContainer(
 		Hub:                 hub,
 		NotificationService: notificationService,
 		Cache:               cache,
+		BlobStore:           blobStore,
 	}
 }
 
@@ -59,27 +72,46 @@ func CreateRedisClient(config *infraPersistence.RedisConfig) *redis.Client {
 
 // BaseProviderSet базовый набор провайдеров для всех модулей
 var BaseProviderSet = wire.NewSet(
-	// WebSocket
-	infraWebSocket.NewHub,
-
 	// Redis
 	infraPersistence.NewRedisConfig,
 	CreateRedisClient,
 
+	// WebSocket: WS_HUB_MODE=cluster пересылает события через Redis Pub/Sub
+	infraWebSocket.NewClusterConfig,
+	infraWebSocket.NewHubFromConfig,
+
+	// Pagination cursors
+	infraPersistence.NewCursorCodecFromEnv,
+
+	// Transactions
+	infraPersistence.NewTransactor,
+
 	// Cache
 	appCache.NewCacheConfig,
 	infraCache.NewRedisCache,
+	infraCache.NewRedisGenerationStore,
 
 	// Events
+	infraRepos.NewOutboxRepository,
 	infraEvents.NewNotificationService,
 
+	// File storage
+	CreateBlobStore,
+
 	// Container
 	NewDependencyContainer,
 )
 
+// AuditLogProviderSet запись журнала аудита из команд
+var AuditLogProviderSet = wire.NewSet(
+	CreateAuditRepository,
+	appAudit.NewRecorder,
+)
+
 // FAQProviderSet набор провайдеров для FAQ
 var FAQProviderSet = wire.NewSet(
 	BaseProviderSet,
+	AuditLogProviderSet,
 
 	// Cache components for FAQ
 	CreateFAQKeyGenerator,
@@ -90,18 +122,22 @@ var FAQProviderSet = wire.NewSet(
 	CreateFAQGenericRepository,
 	CreateFAQRepository,
 	infraRepos.NewCachedFAQRepository,
+	CreateFAQRevisionRepository,
 
 	// Application handlers aggregators
 	appFaqHandlers.NewFAQCommandHandlers,
 	appFaqHandlers.NewFAQQueryHandlers,
 
 	// HTTP handler
+	httpMiddlewares.NewHTTPCacheConfig,
+	httpMiddlewares.NewConditionalGET,
 	httpHandlers.NewFAQHTTPHandler,
 )
 
 // TestimonialProviderSet набор провайдеров для Testimonials
 var TestimonialProviderSet = wire.NewSet(
 	BaseProviderSet,
+	AuditLogProviderSet,
 
 	// Cache components for Testimonial
 	CreateTestimonialKeyGenerator,
@@ -112,14 +148,77 @@ var TestimonialProviderSet = wire.NewSet(
 	CreateTestimonialGenericRepository,
 	infraRepos.NewCachedTestimonialRepository,
 
+	// File uploads
+	CreateAttachmentPolicy,
+
+	// Spam screening
+	CreateSpamScreeningPipeline,
+
+	// Проверка токенов подтверждения email автора; письма отправляет relay outbox
+	infraAuth.NewEmailTokenSignerFromEnv,
+
 	// Application handlers
 	appTestimonialHandlers.NewTestimonialCommandHandlers,
 	appTestimonialHandlers.NewTestimonialQueryHandlers,
+	appTestimonialCommands.NewTestimonialTrashPurger,
 
 	// HTTP handler
 	httpHandlers.NewTestimonialHTTPHandler,
 )
 
+// FeatureProviderSet набор провайдеров для Feature
+var FeatureProviderSet = wire.NewSet(
+	BaseProviderSet,
+	AuditLogProviderSet,
+
+	// Cache components for Feature
+	CreateFeatureKeyGenerator,
+	CreateFeatureInvalidationConfig,
+	CreateFeatureCacheManager,
+
+	// Repository
+	CreateFeatureGenericRepository,
+	CreateFeatureRepository,
+	infraRepos.NewCachedFeatureRepository,
+
+	// Application handlers aggregators
+	appFeatureHandlers.NewFeatureCommandHandlers,
+	appFeatureHandlers.NewFeatureQueryHandlers,
+
+	// HTTP handler
+	httpHandlers.NewFeatureHTTPHandler,
+)
+
+// APIKeyProviderSet набор провайдеров для API ключей
+var APIKeyProviderSet = wire.NewSet(
+	BaseProviderSet,
+
+	// Repository
+	CreateAPIKeyRepository,
+
+	// Application handlers
+	appAPIKeyHandlers.NewAPIKeyCommandHandlers,
+	appAPIKeyHandlers.NewAPIKeyQueryHandlers,
+	appAPIKeyQueries.NewAuthenticateAPIKeyQueryHandler,
+
+	// HTTP handler
+	httpHandlers.NewAPIKeyHTTPHandler,
+)
+
+// AuditProviderSet набор провайдеров для просмотра журнала аудита
+var AuditProviderSet = wire.NewSet(
+	BaseProviderSet,
+
+	// Repository
+	CreateAuditRepository,
+
+	// Application handlers
+	appAuditHandlers.NewAuditQueryHandlers,
+
+	// HTTP handler
+	httpHandlers.NewAuditHTTPHandler,
+)
+
 // InitializeFAQHTTPHandler инициализирует HTTP обработчик FAQ
 func InitializeFAQHTTPHandler(db *gorm.DB) *httpHandlers.FAQHTTPHandler {
 	wire.Build(FAQProviderSet)
@@ -132,6 +231,48 @@ func InitializeTestimonialHandler(db *gorm.DB) *httpHandlers.TestimonialHTTPHand
 	return &httpHandlers.TestimonialHTTPHandler{}
 }
 
+// InitializeFeatureHTTPHandler инициализирует HTTP обработчик Feature
+func InitializeFeatureHTTPHandler(db *gorm.DB) *httpHandlers.FeatureHTTPHandler {
+	wire.Build(FeatureProviderSet)
+	return &httpHandlers.FeatureHTTPHandler{}
+}
+
+// InitializeAPIKeyHTTPHandler инициализирует HTTP обработчик API ключей
+func InitializeAPIKeyHTTPHandler(db *gorm.DB) *httpHandlers.APIKeyHTTPHandler {
+	wire.Build(APIKeyProviderSet)
+	return &httpHandlers.APIKeyHTTPHandler{}
+}
+
+// InitializeAuditHTTPHandler инициализирует HTTP обработчик журнала аудита
+func InitializeAuditHTTPHandler(db *gorm.DB) *httpHandlers.AuditHTTPHandler {
+	wire.Build(AuditProviderSet)
+	return &httpHandlers.AuditHTTPHandler{}
+}
+
+// InitializeAPIKeyAuthenticator инициализирует проверку API ключей для middleware
+func InitializeAPIKeyAuthenticator(db *gorm.DB) *appAPIKeyQueries.AuthenticateAPIKeyQueryHandler {
+	wire.Build(APIKeyProviderSet)
+	return nil
+}
+
+// InitializeCachedFAQRepository инициализирует кешированный репозиторий FAQ
+func InitializeCachedFAQRepository(db *gorm.DB) appRepos.CachedFAQRepository {
+	wire.Build(FAQProviderSet)
+	return nil
+}
+
+// InitializeTestimonialTrashPurger инициализирует очистку корзины отзывов вместе с их файлами
+func InitializeTestimonialTrashPurger(db *gorm.DB) *appTestimonialCommands.TestimonialTrashPurger {
+	wire.Build(TestimonialProviderSet)
+	return nil
+}
+
+// InitializeCachedFeatureRepository инициализирует кешированный репозиторий Feature
+func InitializeCachedFeatureRepository(db *gorm.DB) appRepos.CachedFeatureRepository {
+	wire.Build(FeatureProviderSet)
+	return nil
+}
+
 // HandlerFactory фабрика для создания обработчиков
 type HandlerFactory struct {
 	container *DependencyContainer
@@ -149,6 +290,11 @@ func (f *HandlerFactory) CreateWebSocketHandler() *httpHandlers.WebSocketHandler
 	return httpHandlers.NewWebSocketHandler(f.container.Hub, f.container.NotificationService)
 }
 
+// CreateBlobHandler создает обработчик подписанных ссылок на файлы
+func (f *HandlerFactory) CreateBlobHandler() *httpHandlers.BlobHTTPHandler {
+	return httpHandlers.NewBlobHTTPHandler(f.container.BlobStore)
+}
+
 // CreateFAQHandler создает FAQ обработчик
 func (f *HandlerFactory) CreateFAQHandler() *httpHandlers.FAQHTTPHandler {
 	return InitializeFAQHTTPHandler(f.container.DB)
@@ -159,6 +305,21 @@ func (f *HandlerFactory) CreateTestimonialHandler() *httpHandlers.TestimonialHTT
 	return InitializeTestimonialHandler(f.container.DB)
 }
 
+// CreateFeatureHandler создает Feature обработчик
+func (f *HandlerFactory) CreateFeatureHandler() *httpHandlers.FeatureHTTPHandler {
+	return InitializeFeatureHTTPHandler(f.container.DB)
+}
+
+// CreateAPIKeyHandler создает обработчик управления API ключами
+func (f *HandlerFactory) CreateAPIKeyHandler() *httpHandlers.APIKeyHTTPHandler {
+	return InitializeAPIKeyHTTPHandler(f.container.DB)
+}
+
+// CreateAuditHandler создает обработчик журнала аудита
+func (f *HandlerFactory) CreateAuditHandler() *httpHandlers.AuditHTTPHandler {
+	return InitializeAuditHTTPHandler(f.container.DB)
+}
+
 // InitializeHandlerFactory инициализирует фабрику обработчиков
 func InitializeHandlerFactory(db *gorm.DB) *HandlerFactory {
 	wire.Build(BaseProviderSet, NewHandlerFactory)
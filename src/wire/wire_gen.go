@@ -11,43 +11,64 @@ import (
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 	"log"
+	handlers5 "tax-priority-api/src/application/apikeys/handlers"
+	"tax-priority-api/src/application/apikeys/queries"
+	"tax-priority-api/src/application/audit"
+	handlers6 "tax-priority-api/src/application/audit/handlers"
 	"tax-priority-api/src/application/cache"
 	events2 "tax-priority-api/src/application/events"
 	handlers2 "tax-priority-api/src/application/faq/handlers"
+	handlers4 "tax-priority-api/src/application/features/handlers"
+	repositories2 "tax-priority-api/src/application/repositories"
+	"tax-priority-api/src/application/storage"
+	"tax-priority-api/src/application/testimonial/commands"
 	handlers3 "tax-priority-api/src/application/testimonial/handlers"
+	"tax-priority-api/src/infrastructure/auth"
 	cache2 "tax-priority-api/src/infrastructure/cache"
 	"tax-priority-api/src/infrastructure/events"
 	"tax-priority-api/src/infrastructure/persistence"
 	"tax-priority-api/src/infrastructure/persistence/repositories"
 	"tax-priority-api/src/infrastructure/websocket"
 	"tax-priority-api/src/presentation/handlers"
+	"tax-priority-api/src/presentation/middlewares"
 )
 
 // Injectors from wire.go:
 
 // InitializeFAQHTTPHandler инициализирует HTTP обработчик FAQ
 func InitializeFAQHTTPHandler(db *gorm.DB) *handlers.FAQHTTPHandler {
-	genericRepository := CreateFAQGenericRepository(db)
-	faqRepository := CreateFAQRepository(genericRepository)
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	genericRepository := CreateFAQGenericRepository(db, cursorCodec)
+	faqRepository := CreateFAQRepository(db, genericRepository)
 	redisConfig := persistence.NewRedisConfig()
 	client := CreateRedisClient(redisConfig)
 	cacheConfig := cache.NewCacheConfig()
 	cacheCache := cache2.NewRedisCache(client, cacheConfig)
 	keyGenerator := CreateFAQKeyGenerator()
 	invalidationConfig := CreateFAQInvalidationConfig()
-	cacheManager := CreateFAQCacheManager(cacheCache, keyGenerator, cacheConfig, invalidationConfig)
+	generationStore := cache2.NewRedisGenerationStore(client)
+	cacheManager := CreateFAQCacheManager(cacheCache, keyGenerator, cacheConfig, invalidationConfig, generationStore)
 	cachedFAQRepository := repositories.NewCachedFAQRepository(genericRepository, faqRepository, cacheManager, keyGenerator, cacheConfig)
-	hub := websocket.NewHub()
-	notificationService := events.NewNotificationService(hub)
-	faqCommandHandlers := handlers2.NewFAQCommandHandlers(cachedFAQRepository, notificationService)
-	faqQueryHandlers := handlers2.NewFAQQueryHandlers(cachedFAQRepository)
-	faqhttpHandler := handlers.NewFAQHTTPHandler(faqCommandHandlers, faqQueryHandlers)
+	faqRevisionRepository := CreateFAQRevisionRepository(db, cursorCodec)
+	transactor := persistence.NewTransactor(db)
+	auditRepository := CreateAuditRepository(db, cursorCodec)
+	recorder := audit.NewRecorder(auditRepository)
+	clusterConfig := websocket.NewClusterConfig()
+	hub := websocket.NewHubFromConfig(clusterConfig, client)
+	outboxRepository := repositories.NewOutboxRepository(db)
+	notificationService := events.NewNotificationService(hub, outboxRepository)
+	faqCommandHandlers := handlers2.NewFAQCommandHandlers(cachedFAQRepository, faqRevisionRepository, transactor, recorder, notificationService)
+	faqQueryHandlers := handlers2.NewFAQQueryHandlers(cachedFAQRepository, faqRevisionRepository)
+	httpCacheConfig := middlewares.NewHTTPCacheConfig()
+	conditionalGET := middlewares.NewConditionalGET(generationStore, httpCacheConfig)
+	faqhttpHandler := handlers.NewFAQHTTPHandler(faqCommandHandlers, faqQueryHandlers, conditionalGET)
 	return faqhttpHandler
 }
 
 // InitializeTestimonialHandler инициализирует HTTP обработчик Testimonials
 func InitializeTestimonialHandler(db *gorm.DB) *handlers.TestimonialHTTPHandler {
-	genericRepository := CreateTestimonialGenericRepository(db)
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	genericRepository := CreateTestimonialGenericRepository(db, cursorCodec)
 	redisConfig := persistence.NewRedisConfig()
 	client := CreateRedisClient(redisConfig)
 	cacheConfig := cache.NewCacheConfig()
@@ -56,21 +77,141 @@ func InitializeTestimonialHandler(db *gorm.DB) *handlers.TestimonialHTTPHandler
 	invalidationConfig := CreateTestimonialInvalidationConfig()
 	cacheManager := CreateTestimonialCacheManager(cacheCache, keyGenerator, cacheConfig, invalidationConfig)
 	cachedTestimonialRepository := repositories.NewCachedTestimonialRepository(genericRepository, cacheManager, keyGenerator, cacheConfig)
-	testimonialCommandHandlers := handlers3.NewTestimonialCommandHandlers(cachedTestimonialRepository)
-	testimonialQueryHandlers := handlers3.NewTestimonialQueryHandlers(cachedTestimonialRepository)
-	testimonialHTTPHandler := handlers.NewTestimonialHTTPHandler(testimonialCommandHandlers, testimonialQueryHandlers)
+	blobStore := CreateBlobStore()
+	policy := CreateAttachmentPolicy()
+	transactor := persistence.NewTransactor(db)
+	auditRepository := CreateAuditRepository(db, cursorCodec)
+	recorder := audit.NewRecorder(auditRepository)
+	clusterConfig := websocket.NewClusterConfig()
+	hub := websocket.NewHubFromConfig(clusterConfig, client)
+	outboxRepository := repositories.NewOutboxRepository(db)
+	notificationService := events.NewNotificationService(hub, outboxRepository)
+	pipeline := CreateSpamScreeningPipeline(client)
+	tokens := auth.NewEmailTokenSignerFromEnv()
+	testimonialCommandHandlers := handlers3.NewTestimonialCommandHandlers(cachedTestimonialRepository, blobStore, policy, transactor, recorder, notificationService, pipeline, tokens)
+	testimonialQueryHandlers := handlers3.NewTestimonialQueryHandlers(cachedTestimonialRepository, blobStore)
+	testimonialHTTPHandler := handlers.NewTestimonialHTTPHandler(testimonialCommandHandlers, testimonialQueryHandlers, policy)
 	return testimonialHTTPHandler
 }
 
+// InitializeFeatureHTTPHandler инициализирует HTTP обработчик Feature
+func InitializeFeatureHTTPHandler(db *gorm.DB) *handlers.FeatureHTTPHandler {
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	genericRepository := CreateFeatureGenericRepository(db, cursorCodec)
+	featureRepository := CreateFeatureRepository(db, genericRepository)
+	redisConfig := persistence.NewRedisConfig()
+	client := CreateRedisClient(redisConfig)
+	cacheConfig := cache.NewCacheConfig()
+	cacheCache := cache2.NewRedisCache(client, cacheConfig)
+	keyGenerator := CreateFeatureKeyGenerator()
+	invalidationConfig := CreateFeatureInvalidationConfig()
+	cacheManager := CreateFeatureCacheManager(cacheCache, keyGenerator, cacheConfig, invalidationConfig)
+	cachedFeatureRepository := repositories.NewCachedFeatureRepository(genericRepository, featureRepository, cacheManager, keyGenerator, cacheConfig)
+	transactor := persistence.NewTransactor(db)
+	auditRepository := CreateAuditRepository(db, cursorCodec)
+	recorder := audit.NewRecorder(auditRepository)
+	clusterConfig := websocket.NewClusterConfig()
+	hub := websocket.NewHubFromConfig(clusterConfig, client)
+	outboxRepository := repositories.NewOutboxRepository(db)
+	notificationService := events.NewNotificationService(hub, outboxRepository)
+	featureCommandHandlers := handlers4.NewFeatureCommandHandlers(cachedFeatureRepository, transactor, recorder, notificationService)
+	featureQueryHandlers := handlers4.NewFeatureQueryHandlers(cachedFeatureRepository)
+	featureHTTPHandler := handlers.NewFeatureHTTPHandler(featureCommandHandlers, featureQueryHandlers)
+	return featureHTTPHandler
+}
+
+// InitializeAPIKeyHTTPHandler инициализирует HTTP обработчик API ключей
+func InitializeAPIKeyHTTPHandler(db *gorm.DB) *handlers.APIKeyHTTPHandler {
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	apiKeyRepository := CreateAPIKeyRepository(db, cursorCodec)
+	apiKeyCommandHandlers := handlers5.NewAPIKeyCommandHandlers(apiKeyRepository)
+	apiKeyQueryHandlers := handlers5.NewAPIKeyQueryHandlers(apiKeyRepository)
+	apiKeyHTTPHandler := handlers.NewAPIKeyHTTPHandler(apiKeyCommandHandlers, apiKeyQueryHandlers)
+	return apiKeyHTTPHandler
+}
+
+// InitializeAuditHTTPHandler инициализирует HTTP обработчик журнала аудита
+func InitializeAuditHTTPHandler(db *gorm.DB) *handlers.AuditHTTPHandler {
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	auditRepository := CreateAuditRepository(db, cursorCodec)
+	auditQueryHandlers := handlers6.NewAuditQueryHandlers(auditRepository)
+	auditHTTPHandler := handlers.NewAuditHTTPHandler(auditQueryHandlers)
+	return auditHTTPHandler
+}
+
+// InitializeAPIKeyAuthenticator инициализирует проверку API ключей для middleware
+func InitializeAPIKeyAuthenticator(db *gorm.DB) *queries.AuthenticateAPIKeyQueryHandler {
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	apiKeyRepository := CreateAPIKeyRepository(db, cursorCodec)
+	authenticateAPIKeyQueryHandler := queries.NewAuthenticateAPIKeyQueryHandler(apiKeyRepository)
+	return authenticateAPIKeyQueryHandler
+}
+
+// InitializeCachedFAQRepository инициализирует кешированный репозиторий FAQ
+func InitializeCachedFAQRepository(db *gorm.DB) repositories2.CachedFAQRepository {
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	genericRepository := CreateFAQGenericRepository(db, cursorCodec)
+	faqRepository := CreateFAQRepository(db, genericRepository)
+	redisConfig := persistence.NewRedisConfig()
+	client := CreateRedisClient(redisConfig)
+	cacheConfig := cache.NewCacheConfig()
+	cacheCache := cache2.NewRedisCache(client, cacheConfig)
+	keyGenerator := CreateFAQKeyGenerator()
+	invalidationConfig := CreateFAQInvalidationConfig()
+	generationStore := cache2.NewRedisGenerationStore(client)
+	cacheManager := CreateFAQCacheManager(cacheCache, keyGenerator, cacheConfig, invalidationConfig, generationStore)
+	cachedFAQRepository := repositories.NewCachedFAQRepository(genericRepository, faqRepository, cacheManager, keyGenerator, cacheConfig)
+	return cachedFAQRepository
+}
+
+// InitializeTestimonialTrashPurger инициализирует очистку корзины отзывов вместе с их файлами
+func InitializeTestimonialTrashPurger(db *gorm.DB) *commands.TestimonialTrashPurger {
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	genericRepository := CreateTestimonialGenericRepository(db, cursorCodec)
+	redisConfig := persistence.NewRedisConfig()
+	client := CreateRedisClient(redisConfig)
+	cacheConfig := cache.NewCacheConfig()
+	cacheCache := cache2.NewRedisCache(client, cacheConfig)
+	keyGenerator := CreateTestimonialKeyGenerator()
+	invalidationConfig := CreateTestimonialInvalidationConfig()
+	cacheManager := CreateTestimonialCacheManager(cacheCache, keyGenerator, cacheConfig, invalidationConfig)
+	cachedTestimonialRepository := repositories.NewCachedTestimonialRepository(genericRepository, cacheManager, keyGenerator, cacheConfig)
+	blobStore := CreateBlobStore()
+	transactor := persistence.NewTransactor(db)
+	auditRepository := CreateAuditRepository(db, cursorCodec)
+	recorder := audit.NewRecorder(auditRepository)
+	testimonialTrashPurger := commands.NewTestimonialTrashPurger(cachedTestimonialRepository, genericRepository, blobStore, transactor, recorder)
+	return testimonialTrashPurger
+}
+
+// InitializeCachedFeatureRepository инициализирует кешированный репозиторий Feature
+func InitializeCachedFeatureRepository(db *gorm.DB) repositories2.CachedFeatureRepository {
+	cursorCodec := persistence.NewCursorCodecFromEnv()
+	genericRepository := CreateFeatureGenericRepository(db, cursorCodec)
+	featureRepository := CreateFeatureRepository(db, genericRepository)
+	redisConfig := persistence.NewRedisConfig()
+	client := CreateRedisClient(redisConfig)
+	cacheConfig := cache.NewCacheConfig()
+	cacheCache := cache2.NewRedisCache(client, cacheConfig)
+	keyGenerator := CreateFeatureKeyGenerator()
+	invalidationConfig := CreateFeatureInvalidationConfig()
+	cacheManager := CreateFeatureCacheManager(cacheCache, keyGenerator, cacheConfig, invalidationConfig)
+	cachedFeatureRepository := repositories.NewCachedFeatureRepository(genericRepository, featureRepository, cacheManager, keyGenerator, cacheConfig)
+	return cachedFeatureRepository
+}
+
 // InitializeHandlerFactory инициализирует фабрику обработчиков
 func InitializeHandlerFactory(db *gorm.DB) *HandlerFactory {
 	redisConfig := persistence.NewRedisConfig()
 	client := CreateRedisClient(redisConfig)
-	hub := websocket.NewHub()
-	notificationService := events.NewNotificationService(hub)
+	clusterConfig := websocket.NewClusterConfig()
+	hub := websocket.NewHubFromConfig(clusterConfig, client)
+	outboxRepository := repositories.NewOutboxRepository(db)
+	notificationService := events.NewNotificationService(hub, outboxRepository)
 	cacheConfig := cache.NewCacheConfig()
 	cacheCache := cache2.NewRedisCache(client, cacheConfig)
-	dependencyContainer := NewDependencyContainer(db, client, hub, notificationService, cacheCache)
+	blobStore := CreateBlobStore()
+	dependencyContainer := NewDependencyContainer(db, client, hub, notificationService, cacheCache, blobStore)
 	handlerFactory := NewHandlerFactory(dependencyContainer)
 	return handlerFactory
 }
@@ -84,6 +225,7 @@ type DependencyContainer struct {
 	Hub                 *websocket.Hub
 	NotificationService events2.NotificationService
 	Cache               cache.Cache
+	BlobStore           storage.BlobStore
 }
 
 // NewDependencyContainer создает контейнер зависимостей
@@ -93,6 +235,7 @@ func NewDependencyContainer(
 	hub *websocket.Hub,
 	notificationService events2.NotificationService, cache3 cache.Cache,
 
+	blobStore storage.BlobStore,
 ) *DependencyContainer {
 	return &DependencyContainer{
 		DB:                  db,
@@ -100,6 +243,7 @@ func NewDependencyContainer(
 		Hub:                 hub,
 		NotificationService: notificationService,
 		Cache:               cache3,
+		BlobStore:           blobStore,
 	}
 }
 
@@ -113,29 +257,68 @@ func CreateRedisClient(config *persistence.RedisConfig) *redis.Client {
 }
 
 // BaseProviderSet базовый набор провайдеров для всех модулей
-var BaseProviderSet = wire.NewSet(websocket.NewHub, persistence.NewRedisConfig, CreateRedisClient, cache.NewCacheConfig, cache2.NewRedisCache, events.NewNotificationService, NewDependencyContainer)
+var BaseProviderSet = wire.NewSet(persistence.NewRedisConfig, CreateRedisClient, websocket.NewClusterConfig, websocket.NewHubFromConfig, persistence.NewCursorCodecFromEnv, persistence.NewTransactor, cache.NewCacheConfig, cache2.NewRedisCache, cache2.NewRedisGenerationStore, repositories.NewOutboxRepository, events.NewNotificationService, CreateBlobStore,
+
+	NewDependencyContainer,
+)
+
+// AuditLogProviderSet запись журнала аудита из команд
+var AuditLogProviderSet = wire.NewSet(
+	CreateAuditRepository, audit.NewRecorder,
+)
 
 // FAQProviderSet набор провайдеров для FAQ
 var FAQProviderSet = wire.NewSet(
 	BaseProviderSet,
+	AuditLogProviderSet,
 
 	CreateFAQKeyGenerator,
 	CreateFAQInvalidationConfig,
 	CreateFAQCacheManager,
 
 	CreateFAQGenericRepository,
-	CreateFAQRepository, repositories.NewCachedFAQRepository, handlers2.NewFAQCommandHandlers, handlers2.NewFAQQueryHandlers, handlers.NewFAQHTTPHandler,
+	CreateFAQRepository, repositories.NewCachedFAQRepository, CreateFAQRevisionRepository, handlers2.NewFAQCommandHandlers, handlers2.NewFAQQueryHandlers, middlewares.NewHTTPCacheConfig, middlewares.NewConditionalGET, handlers.NewFAQHTTPHandler,
 )
 
 // TestimonialProviderSet набор провайдеров для Testimonials
 var TestimonialProviderSet = wire.NewSet(
 	BaseProviderSet,
+	AuditLogProviderSet,
 
 	CreateTestimonialKeyGenerator,
 	CreateTestimonialInvalidationConfig,
 	CreateTestimonialCacheManager,
 
-	CreateTestimonialGenericRepository, repositories.NewCachedTestimonialRepository, handlers3.NewTestimonialCommandHandlers, handlers3.NewTestimonialQueryHandlers, handlers.NewTestimonialHTTPHandler,
+	CreateTestimonialGenericRepository, repositories.NewCachedTestimonialRepository, CreateAttachmentPolicy,
+
+	CreateSpamScreeningPipeline, auth.NewEmailTokenSignerFromEnv, handlers3.NewTestimonialCommandHandlers, handlers3.NewTestimonialQueryHandlers, commands.NewTestimonialTrashPurger, handlers.NewTestimonialHTTPHandler,
+)
+
+// FeatureProviderSet набор провайдеров для Feature
+var FeatureProviderSet = wire.NewSet(
+	BaseProviderSet,
+	AuditLogProviderSet,
+
+	CreateFeatureKeyGenerator,
+	CreateFeatureInvalidationConfig,
+	CreateFeatureCacheManager,
+
+	CreateFeatureGenericRepository,
+	CreateFeatureRepository, repositories.NewCachedFeatureRepository, handlers4.NewFeatureCommandHandlers, handlers4.NewFeatureQueryHandlers, handlers.NewFeatureHTTPHandler,
+)
+
+// APIKeyProviderSet набор провайдеров для API ключей
+var APIKeyProviderSet = wire.NewSet(
+	BaseProviderSet,
+
+	CreateAPIKeyRepository, handlers5.NewAPIKeyCommandHandlers, handlers5.NewAPIKeyQueryHandlers, queries.NewAuthenticateAPIKeyQueryHandler, handlers.NewAPIKeyHTTPHandler,
+)
+
+// AuditProviderSet набор провайдеров для просмотра журнала аудита
+var AuditProviderSet = wire.NewSet(
+	BaseProviderSet,
+
+	CreateAuditRepository, handlers6.NewAuditQueryHandlers, handlers.NewAuditHTTPHandler,
 )
 
 // HandlerFactory фабрика для создания обработчиков
@@ -155,6 +338,11 @@ func (f *HandlerFactory) CreateWebSocketHandler() *handlers.WebSocketHandler {
 	return handlers.NewWebSocketHandler(f.container.Hub, f.container.NotificationService)
 }
 
+// CreateBlobHandler создает обработчик подписанных ссылок на файлы
+func (f *HandlerFactory) CreateBlobHandler() *handlers.BlobHTTPHandler {
+	return handlers.NewBlobHTTPHandler(f.container.BlobStore)
+}
+
 // CreateFAQHandler создает FAQ обработчик
 func (f *HandlerFactory) CreateFAQHandler() *handlers.FAQHTTPHandler {
 	return InitializeFAQHTTPHandler(f.container.DB)
@@ -164,3 +352,18 @@ func (f *HandlerFactory) CreateFAQHandler() *handlers.FAQHTTPHandler {
 func (f *HandlerFactory) CreateTestimonialHandler() *handlers.TestimonialHTTPHandler {
 	return InitializeTestimonialHandler(f.container.DB)
 }
+
+// CreateFeatureHandler создает Feature обработчик
+func (f *HandlerFactory) CreateFeatureHandler() *handlers.FeatureHTTPHandler {
+	return InitializeFeatureHTTPHandler(f.container.DB)
+}
+
+// CreateAPIKeyHandler создает обработчик управления API ключами
+func (f *HandlerFactory) CreateAPIKeyHandler() *handlers.APIKeyHTTPHandler {
+	return InitializeAPIKeyHTTPHandler(f.container.DB)
+}
+
+// CreateAuditHandler создает обработчик журнала аудита
+func (f *HandlerFactory) CreateAuditHandler() *handlers.AuditHTTPHandler {
+	return InitializeAuditHTTPHandler(f.container.DB)
+}